@@ -0,0 +1,114 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uptrace/bun"
+)
+
+// companyColumns are the columns added to tables that already exist in the company schemas,
+// CreateTable().IfNotExists() only creates new tables and never changes the existing ones.
+var companyColumns = []string{
+	// Addresses: labels and coordinates
+	"ALTER TABLE ?.addresses ADD COLUMN IF NOT EXISTS label varchar",
+	"ALTER TABLE ?.addresses ADD COLUMN IF NOT EXISTS is_default boolean DEFAULT false",
+	"ALTER TABLE ?.addresses ADD COLUMN IF NOT EXISTS latitude double precision",
+	"ALTER TABLE ?.addresses ADD COLUMN IF NOT EXISTS longitude double precision",
+
+	// Delivery orders: zones, routes, settlements and failures
+	"ALTER TABLE ?.delivery_orders ADD COLUMN IF NOT EXISTS delivery_zone_id uuid",
+	"ALTER TABLE ?.delivery_orders ADD COLUMN IF NOT EXISTS route_id uuid",
+	"ALTER TABLE ?.delivery_orders ADD COLUMN IF NOT EXISTS route_stop bigint DEFAULT 0",
+	"ALTER TABLE ?.delivery_orders ADD COLUMN IF NOT EXISTS settlement_id uuid",
+	"ALTER TABLE ?.delivery_orders ADD COLUMN IF NOT EXISTS failure_reason varchar",
+	"ALTER TABLE ?.delivery_orders ADD COLUMN IF NOT EXISTS failed_at timestamptz",
+	"ALTER TABLE ?.delivery_orders ADD COLUMN IF NOT EXISTS returned_at timestamptz",
+
+	// Orders: loyalty discount
+	"ALTER TABLE ?.orders ADD COLUMN IF NOT EXISTS discount double precision DEFAULT 0",
+
+	// Table orders: client of the table and waiter commission
+	"ALTER TABLE ?.table_orders ADD COLUMN IF NOT EXISTS client_id uuid",
+	"ALTER TABLE ?.table_orders ADD COLUMN IF NOT EXISTS commission_id uuid",
+
+	// Tables: capacity and cleaning
+	"ALTER TABLE ?.tables ADD COLUMN IF NOT EXISTS capacity bigint DEFAULT 0",
+	"ALTER TABLE ?.tables ADD COLUMN IF NOT EXISTS needs_clean boolean DEFAULT false",
+
+	// Employees: pin of the terminal
+	"ALTER TABLE ?.employees ADD COLUMN IF NOT EXISTS pin_hash varchar",
+
+	// Company: two factor policy
+	"ALTER TABLE ?.companies ADD COLUMN IF NOT EXISTS two_factor_required_roles jsonb",
+
+	// Client merges: replaced addresses, reservations and credit accounts
+	"ALTER TABLE ?.client_merges ADD COLUMN IF NOT EXISTS replaced_addresses jsonb",
+	"ALTER TABLE ?.client_merges ADD COLUMN IF NOT EXISTS moved_reservations bigint DEFAULT 0",
+	"ALTER TABLE ?.client_merges ADD COLUMN IF NOT EXISTS merged_credit_account_id uuid",
+
+	// Reservations: release of the extra tables
+	"ALTER TABLE ?.reservations ADD COLUMN IF NOT EXISTS released_at timestamptz",
+}
+
+// publicColumns are the columns added to tables that already exist in the public schema.
+var publicColumns = []string{
+	"ALTER TABLE ?.companies ADD COLUMN IF NOT EXISTS two_factor_required_roles jsonb",
+	"ALTER TABLE ?.company_to_users ADD COLUMN IF NOT EXISTS role varchar DEFAULT 'Employee'",
+	"ALTER TABLE ?.company_to_users ALTER COLUMN role SET DEFAULT 'Employee'",
+
+	// Users: password change, email verification and two factor
+	"ALTER TABLE ?.users ADD COLUMN IF NOT EXISTS must_change_password boolean DEFAULT false",
+	"ALTER TABLE ?.users ADD COLUMN IF NOT EXISTS email_verified_at timestamptz",
+	"ALTER TABLE ?.users ADD COLUMN IF NOT EXISTS two_factor_secret varchar",
+	"ALTER TABLE ?.users ADD COLUMN IF NOT EXISTS two_factor_enabled_at timestamptz",
+	"ALTER TABLE ?.users ADD COLUMN IF NOT EXISTS two_factor_last_step bigint DEFAULT 0",
+	"ALTER TABLE ?.users ADD COLUMN IF NOT EXISTS recovery_codes jsonb",
+
+	// User sessions: two factor and terminals
+	"ALTER TABLE ?.user_sessions ADD COLUMN IF NOT EXISTS two_factor_at timestamptz",
+	"ALTER TABLE ?.user_sessions ADD COLUMN IF NOT EXISTS terminal_name varchar",
+	"ALTER TABLE ?.user_sessions ADD COLUMN IF NOT EXISTS terminal_schema varchar",
+	"ALTER TABLE ?.user_sessions ADD COLUMN IF NOT EXISTS acting_employee_id uuid",
+	"ALTER TABLE ?.user_sessions ADD COLUMN IF NOT EXISTS acting_since timestamptz",
+}
+
+// publicBackfills fill the roles of the users added before the column, only the companies without an
+// owner are changed so they run once.
+var publicBackfills = []string{
+	// The user created with the company has its email
+	`UPDATE ?0.company_to_users AS ctu SET role = 'Owner'
+	FROM ?0.companies AS c, ?0.users AS u
+	WHERE c.id = ctu.company_with_users_id AND u.id = ctu.user_id AND lower(u.email) = lower(c.email)
+	AND NOT EXISTS (SELECT 1 FROM ?0.company_to_users AS o WHERE o.company_with_users_id = ctu.company_with_users_id AND o.role = 'Owner')`,
+
+	// Otherwise the first user of the company
+	`UPDATE ?0.company_to_users AS ctu SET role = 'Owner'
+	FROM ?0.users AS u
+	WHERE u.id = ctu.user_id
+	AND u.created_at = (SELECT min(fu.created_at) FROM ?0.company_to_users AS f JOIN ?0.users AS fu ON fu.id = f.user_id WHERE f.company_with_users_id = ctu.company_with_users_id)
+	AND NOT EXISTS (SELECT 1 FROM ?0.company_to_users AS o WHERE o.company_with_users_id = ctu.company_with_users_id AND o.role = 'Owner')`,
+
+	"UPDATE ?0.company_to_users SET role = 'Employee' WHERE role IS NULL OR role = ''",
+}
+
+// addMissingColumns runs the statements qualified by the schema, so it doesn't depend on the search_path of the connection.
+func addMissingColumns(ctx context.Context, db *bun.DB, schemaName string, columns []string) error {
+	for _, column := range columns {
+		if _, err := db.ExecContext(ctx, column, bun.Ident(schemaName)); err != nil {
+			return errors.New("Failed to add column on " + schemaName + ": " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+func runBackfills(ctx context.Context, db *bun.DB, schemaName string, backfills []string) error {
+	for _, backfill := range backfills {
+		if _, err := db.ExecContext(ctx, backfill, bun.Ident(schemaName)); err != nil {
+			return errors.New("Failed to backfill " + schemaName + ": " + err.Error())
+		}
+	}
+
+	return nil
+}
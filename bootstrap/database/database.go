@@ -16,17 +16,26 @@ import (
 	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
 	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	creditaccountentity "github.com/willjrcom/sales-backend-go/internal/domain/credit_account"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+	driversettlemententity "github.com/willjrcom/sales-backend-go/internal/domain/driver_settlement"
 	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
 	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	organizationentity "github.com/willjrcom/sales-backend-go/internal/domain/organization"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
 	processentity "github.com/willjrcom/sales-backend-go/internal/domain/process"
 	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
 	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
 	shiftentity "github.com/willjrcom/sales-backend-go/internal/domain/shift"
 	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+	timeclockentity "github.com/willjrcom/sales-backend-go/internal/domain/time_clock"
+	trackingentity "github.com/willjrcom/sales-backend-go/internal/domain/tracking"
+	waitercommissionentity "github.com/willjrcom/sales-backend-go/internal/domain/waiter_commission"
 )
 
 var (
@@ -131,6 +140,13 @@ func defaultTables(ctx context.Context, db *bun.DB) error {
 	db.RegisterModel((*companyentity.User)(nil))
 	db.RegisterModel((*companyentity.CompanyToUsers)(nil))
 	db.RegisterModel((*companyentity.CompanyWithUsers)(nil))
+	db.RegisterModel((*companyentity.UserSession)(nil))
+	db.RegisterModel((*companyentity.RefreshToken)(nil))
+	db.RegisterModel((*trackingentity.TrackingToken)(nil))
+	db.RegisterModel((*addressentity.CepAddress)(nil))
+	db.RegisterModel((*organizationentity.OrganizationToCompanies)(nil))
+	db.RegisterModel((*organizationentity.Organization)(nil))
+	db.RegisterModel((*organizationentity.CatalogTemplate)(nil))
 
 	if err := RegisterModels(ctx, db); err != nil {
 		return err
@@ -148,10 +164,46 @@ func defaultTables(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*companyentity.UserSession)(nil)).Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*companyentity.RefreshToken)(nil)).Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*trackingentity.TrackingToken)(nil)).Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*addressentity.CepAddress)(nil)).Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*organizationentity.Organization)(nil)).Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*organizationentity.OrganizationToCompanies)(nil)).Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*organizationentity.CatalogTemplate)(nil)).Exec(ctx); err != nil {
+		return err
+	}
+
 	if _, err := db.ExecContext(ctx, "CREATE EXTENSION IF NOT EXISTS pgcrypto;"); err != nil {
 		return err
 	}
 
+	if err := addMissingColumns(ctx, db, schemaentity.DEFAULT_SCHEMA, publicColumns); err != nil {
+		return err
+	}
+
+	if err := runBackfills(ctx, db, schemaentity.DEFAULT_SCHEMA, publicBackfills); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -212,6 +264,8 @@ func RegisterModels(ctx context.Context, db *bun.DB) error {
 	db.RegisterModel((*addressentity.Address)(nil))
 	db.RegisterModel((*personentity.Contact)(nil))
 	db.RegisterModel((*cliententity.Client)(nil))
+	db.RegisterModel((*cliententity.ClientMerge)(nil))
+	db.RegisterModel((*cliententity.PrivacyRequest)(nil))
 	db.RegisterModel((*employeeentity.Employee)(nil))
 
 	db.RegisterModel((*processentity.Process)(nil))
@@ -219,14 +273,34 @@ func RegisterModels(ctx context.Context, db *bun.DB) error {
 	db.RegisterModel((*itementity.Item)(nil))
 	db.RegisterModel((*groupitementity.GroupItem)(nil))
 
+	db.RegisterModel((*deliveryzoneentity.DeliveryZone)(nil))
 	db.RegisterModel((*orderentity.DeliveryOrder)(nil))
+	db.RegisterModel((*orderentity.DeliveryAttempt)(nil))
+	db.RegisterModel((*orderentity.DeliveryRoute)(nil))
+	db.RegisterModel((*driversettlemententity.PayoutRule)(nil))
+	db.RegisterModel((*driversettlemententity.DriverSettlement)(nil))
+	db.RegisterModel((*timeclockentity.TimeClockEntry)(nil))
+	db.RegisterModel((*timeclockentity.TimeClockCorrection)(nil))
+	db.RegisterModel((*timeclockentity.WorkSchedule)(nil))
+	db.RegisterModel((*waitercommissionentity.CommissionRule)(nil))
+	db.RegisterModel((*waitercommissionentity.WaiterCommission)(nil))
 	db.RegisterModel((*orderentity.TableOrder)(nil))
 	db.RegisterModel((*orderentity.PaymentOrder)(nil))
+	db.RegisterModel((*orderentity.TableOrderTransfer)(nil))
 	db.RegisterModel((*orderentity.Order)(nil))
 
+	db.RegisterModel((*loyaltyentity.LoyaltyProgram)(nil))
+	db.RegisterModel((*loyaltyentity.LoyaltyTransaction)(nil))
+	db.RegisterModel((*creditaccountentity.CreditAccount)(nil))
+	db.RegisterModel((*creditaccountentity.CreditEntry)(nil))
+
 	db.RegisterModel((*tableentity.Table)(nil))
+	db.RegisterModel((*tableentity.Place)(nil))
+	db.RegisterModel((*tableentity.PlaceTable)(nil))
+	db.RegisterModel((*reservationentity.Reservation)(nil))
 	db.RegisterModel((*shiftentity.Shift)(nil))
 	db.RegisterModel((*companyentity.Company)(nil))
+	db.RegisterModel((*companyentity.CompanySettings)(nil))
 	return nil
 }
 
@@ -299,6 +373,16 @@ func LoadCompanyModels(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*cliententity.ClientMerge)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*cliententity.PrivacyRequest)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
 	if _, err := db.NewCreateTable().IfNotExists().Model((*employeeentity.Employee)(nil)).Exec(ctx); err != nil {
 		mu.Unlock()
 		return err
@@ -324,11 +408,61 @@ func LoadCompanyModels(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*deliveryzoneentity.DeliveryZone)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
 	if _, err := db.NewCreateTable().IfNotExists().Model((*orderentity.DeliveryOrder)(nil)).Exec(ctx); err != nil {
 		mu.Unlock()
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*orderentity.DeliveryAttempt)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*orderentity.DeliveryRoute)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*driversettlemententity.PayoutRule)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*driversettlemententity.DriverSettlement)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*timeclockentity.TimeClockEntry)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*timeclockentity.TimeClockCorrection)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*timeclockentity.WorkSchedule)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*waitercommissionentity.CommissionRule)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*waitercommissionentity.WaiterCommission)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
 	if _, err := db.NewCreateTable().IfNotExists().Model((*orderentity.TableOrder)(nil)).Exec(ctx); err != nil {
 		mu.Unlock()
 		return err
@@ -339,16 +473,56 @@ func LoadCompanyModels(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*orderentity.TableOrderTransfer)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
 	if _, err := db.NewCreateTable().IfNotExists().Model((*orderentity.Order)(nil)).Exec(ctx); err != nil {
 		mu.Unlock()
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*loyaltyentity.LoyaltyProgram)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*loyaltyentity.LoyaltyTransaction)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*creditaccountentity.CreditAccount)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*creditaccountentity.CreditEntry)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
 	if _, err := db.NewCreateTable().IfNotExists().Model((*tableentity.Table)(nil)).Exec(ctx); err != nil {
 		mu.Unlock()
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*tableentity.Place)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*tableentity.PlaceTable)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if _, err := db.NewCreateTable().IfNotExists().Model((*reservationentity.Reservation)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
 	if _, err := db.NewCreateTable().IfNotExists().Model((*shiftentity.Shift)(nil)).Exec(ctx); err != nil {
 		mu.Unlock()
 		return err
@@ -359,5 +533,21 @@ func LoadCompanyModels(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	if _, err := db.NewCreateTable().IfNotExists().Model((*companyentity.CompanySettings)(nil)).Exec(ctx); err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	schemaName, err := GetSchema(ctx)
+	if err != nil {
+		mu.Unlock()
+		return err
+	}
+
+	if err := addMissingColumns(ctx, db, schemaName, companyColumns); err != nil {
+		mu.Unlock()
+		return err
+	}
+
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
 	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
 	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
@@ -44,7 +45,30 @@ func (c *ServerChi) middlewareAuthUser(next http.Handler) http.Handler {
 				return
 			}
 
-			ctx = context.WithValue(ctx, schemaentity.Schema("schema"), jwtservice.GetSchemaFromToken(token))
+			sessionID, err := jwtservice.GetSessionIDFromToken(token)
+
+			if err != nil {
+				jsonpkg.ResponseJson(w, r, http.StatusUnauthorized, jsonpkg.Error{Message: err.Error()})
+				return
+			}
+
+			schema := jwtservice.GetSchemaFromToken(token)
+
+			if c.SessionValidator != nil {
+				session, err := c.SessionValidator.ValidateSession(ctx, sessionID)
+				if err != nil {
+					jsonpkg.ResponseJson(w, r, http.StatusUnauthorized, jsonpkg.Error{Message: err.Error()})
+					return
+				}
+
+				// Terminais compartilhados agem em nome do funcionário que trocou pelo pin
+				if employeeID, ok := session.ActingEmployeeFor(schema); ok {
+					ctx = context.WithValue(ctx, employeeentity.EmployeeValue("employee"), employeeID)
+				}
+			}
+
+			ctx = context.WithValue(ctx, schemaentity.Schema("schema"), schema)
+			ctx = context.WithValue(ctx, companyentity.SessionValue("session"), sessionID)
 			ctx = context.WithValue(ctx, companyentity.UserValue("user"), jwtservice.GetUserFromToken(token))
 		}
 
@@ -52,3 +76,14 @@ func (c *ServerChi) middlewareAuthUser(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+func (c *ServerChi) middlewareRateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c.RateLimiter == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		c.RateLimiter(next).ServeHTTP(w, r)
+	})
+}
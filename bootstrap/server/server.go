@@ -1,13 +1,16 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
+	"github.com/google/uuid"
 	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 )
 
 type ServerInterface interface {
@@ -16,10 +19,16 @@ type ServerInterface interface {
 	AddHandler(handler *handler.Handler)
 }
 
+type SessionValidator interface {
+	ValidateSession(ctx context.Context, sessionID uuid.UUID) (*companyentity.UserSession, error)
+}
+
 type ServerChi struct {
 	Router            *chi.Mux
 	HttpServer        *http.Server
 	UnprotectedRoutes []string
+	SessionValidator  SessionValidator
+	RateLimiter       func(http.Handler) http.Handler
 }
 
 func NewServerChi() *ServerChi {
@@ -38,6 +47,7 @@ func (c *ServerChi) newServer() {
 	})
 	c.Router.Use(Cors.Handler)
 	c.Router.Use(c.middlewareAuthUser)
+	c.Router.Use(c.middlewareRateLimit)
 }
 
 func (c *ServerChi) StartServer(port string) error {
@@ -60,3 +70,12 @@ func (c *ServerChi) AddHandler(h *handler.Handler) {
 	c.Router.Mount(h.Path, h.Handler)
 	c.UnprotectedRoutes = append(c.UnprotectedRoutes, h.UnprotectedRoutes...)
 }
+
+func (c *ServerChi) SetSessionValidator(v SessionValidator) {
+	c.SessionValidator = v
+}
+
+// SetRateLimiter must be called before the server starts, the limiter runs after the auth to know the user.
+func (c *ServerChi) SetRateLimiter(limiter func(http.Handler) http.Handler) {
+	c.RateLimiter = limiter
+}
@@ -16,38 +16,63 @@ import (
 	clientrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/client"
 	companyrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/company"
 	contactrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/contact"
+	creditaccountrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/credit_account"
+	deliveryzonerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/delivery_zone"
+	driversettlementrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/driver_settlement"
 	employeerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/employee"
 	groupitemrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/group_item"
 	itemrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/item"
+	loyaltyrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/loyalty"
 	orderrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/order"
+	organizationrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/organization"
 	processrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/process"
 	processrulerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/process_rule"
 	productrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/product"
 	quantityrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/quantity_category"
+	reservationrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/reservation"
 	schemarepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/schema"
 	shiftrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/shift"
 	sizerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/size_category"
 	tablerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/table"
+	timeclockrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/time_clock"
+	trackingrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/tracking"
 	userrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/user"
+	waitercommissionrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/waiter_commission"
+	cepservice "github.com/willjrcom/sales-backend-go/internal/infra/service/cep"
 	schemaservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+	mailerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/mailer"
+	ratelimitservice "github.com/willjrcom/sales-backend-go/internal/infra/service/ratelimit"
+	addressusecases "github.com/willjrcom/sales-backend-go/internal/usecases/address"
 	categoryproductusecases "github.com/willjrcom/sales-backend-go/internal/usecases/category_product"
 	clientusecases "github.com/willjrcom/sales-backend-go/internal/usecases/client"
 	companyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/company"
 	contactusecases "github.com/willjrcom/sales-backend-go/internal/usecases/contact"
+	creditaccountusecases "github.com/willjrcom/sales-backend-go/internal/usecases/credit_account"
 	deliveryorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_order"
+	deliveryrouteusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_route"
+	deliveryzoneusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_zone"
+	driversettlementusecases "github.com/willjrcom/sales-backend-go/internal/usecases/driver_settlement"
 	employeeusecases "github.com/willjrcom/sales-backend-go/internal/usecases/employee"
 	groupitemusecases "github.com/willjrcom/sales-backend-go/internal/usecases/group_item"
 	itemusecases "github.com/willjrcom/sales-backend-go/internal/usecases/item"
+	loyaltyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/loyalty"
 	orderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/order"
+	organizationusecases "github.com/willjrcom/sales-backend-go/internal/usecases/organization"
+	placeusecases "github.com/willjrcom/sales-backend-go/internal/usecases/place"
 	processusecases "github.com/willjrcom/sales-backend-go/internal/usecases/process"
 	processRuleusecases "github.com/willjrcom/sales-backend-go/internal/usecases/process_category"
 	productusecases "github.com/willjrcom/sales-backend-go/internal/usecases/product"
 	quantityusecases "github.com/willjrcom/sales-backend-go/internal/usecases/quantity_category"
+	reservationusecases "github.com/willjrcom/sales-backend-go/internal/usecases/reservation"
 	shiftusecases "github.com/willjrcom/sales-backend-go/internal/usecases/shift"
 	sizeusecases "github.com/willjrcom/sales-backend-go/internal/usecases/size_category"
 	tableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/table"
 	tableorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/table_order"
+	timeclockusecases "github.com/willjrcom/sales-backend-go/internal/usecases/time_clock"
+	trackingusecases "github.com/willjrcom/sales-backend-go/internal/usecases/tracking"
 	userusecases "github.com/willjrcom/sales-backend-go/internal/usecases/user"
+	waitercommissionusecases "github.com/willjrcom/sales-backend-go/internal/usecases/waiter_commission"
 )
 
 // httpserverCmd represents the httpserver command
@@ -57,6 +82,12 @@ var HttpserverCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, _ []string) {
 		cmd.Println("httpserver called")
 		port, _ := cmd.Flags().GetString("port")
+		cepFixture, _ := cmd.Flags().GetString("cep-fixture")
+		jwtKeys, _ := cmd.Flags().GetString("jwt-keys")
+		mailerName, _ := cmd.Flags().GetString("mailer")
+		mailerDir, _ := cmd.Flags().GetString("mailer-dir")
+		appURL, _ := cmd.Flags().GetString("app-url")
+		rateLimits, _ := cmd.Flags().GetString("rate-limits")
 
 		flag.Parse()
 		ctx := context.Background()
@@ -79,9 +110,22 @@ var HttpserverCmd = &cobra.Command{
 		clientRepo := clientrepositorybun.NewClientRepositoryBun(db)
 		contactRepo := contactrepositorybun.NewContactRepositoryBun(ctx, db)
 		addressRepo := addressrepositorybun.NewAddressRepositoryBun(db)
+		cepRepo := addressrepositorybun.NewCepRepositoryBun(db)
+		loyaltyProgramRepo := loyaltyrepositorybun.NewLoyaltyProgramRepositoryBun(db)
+		loyaltyTransactionRepo := loyaltyrepositorybun.NewLoyaltyTransactionRepositoryBun(db)
+		creditAccountRepo := creditaccountrepositorybun.NewCreditAccountRepositoryBun(db)
 
 		orderRepo := orderrepositorybun.NewOrderRepositoryBun(db)
 		deliveryOrderRepo := orderrepositorybun.NewDeliveryOrderRepositoryBun(db)
+		deliveryRouteRepo := orderrepositorybun.NewDeliveryRouteRepositoryBun(db)
+		deliveryZoneRepo := deliveryzonerepositorybun.NewDeliveryZoneRepositoryBun(db)
+		payoutRuleRepo := driversettlementrepositorybun.NewPayoutRuleRepositoryBun(db)
+		driverSettlementRepo := driversettlementrepositorybun.NewDriverSettlementRepositoryBun(db)
+		timeClockRepo := timeclockrepositorybun.NewTimeClockRepositoryBun(db)
+		workScheduleRepo := timeclockrepositorybun.NewWorkScheduleRepositoryBun(db)
+		commissionRuleRepo := waitercommissionrepositorybun.NewCommissionRuleRepositoryBun(db)
+		waiterCommissionRepo := waitercommissionrepositorybun.NewWaiterCommissionRepositoryBun(db)
+		trackingTokenRepo := trackingrepositorybun.NewTrackingTokenRepositoryBun(db)
 		tableOrderRepo := orderrepositorybun.NewTableOrderRepositoryBun(db)
 		processRepo := processrepositorybun.NewProcessRepositoryBun(db)
 		itemRepo := itemrepositorybun.NewItemRepositoryBun(db)
@@ -89,11 +133,54 @@ var HttpserverCmd = &cobra.Command{
 
 		employeeRepo := employeerepositorybun.NewEmployeeRepositoryBun(db)
 		tableRepo := tablerepositorybun.NewTableRepositoryBun(db)
+		placeRepo := tablerepositorybun.NewPlaceRepositoryBun(db)
+		reservationRepo := reservationrepositorybun.NewReservationRepositoryBun(db)
 		shiftRepo := shiftrepositorybun.NewShiftRepositoryBun(db)
 
 		schemaRepo := schemarepositorybun.NewSchemaRepositoryBun(db)
 		companyRepo := companyrepositorybun.NewCompanyRepositoryBun(db)
 		userRepo := userrepositorybun.NewUserRepositoryBun(db)
+		userSessionRepo := userrepositorybun.NewUserSessionRepositoryBun(db)
+		organizationRepo := organizationrepositorybun.NewOrganizationRepositoryBun(db)
+		catalogTemplateRepo := organizationrepositorybun.NewCatalogTemplateRepositoryBun(db)
+		organizationReportRepo := organizationrepositorybun.NewReportRepositoryBun(db)
+
+		// Load cep provider, the fixture allows to run offline
+		var cepProvider cepservice.Provider = cepservice.NewViaCepProvider(cepservice.ViaCepUrl)
+		if cepFixture != "" {
+			if cepProvider, err = cepservice.NewFixtureProviderFromFile(cepFixture); err != nil {
+				panic(err)
+			}
+		}
+
+		// Load signing keys, without the file the tokens are signed with the JWT_SECRET
+		if jwtKeys != "" {
+			if err := jwtservice.LoadKeySetFromFile(jwtKeys); err != nil {
+				panic(err)
+			}
+		} else if err := jwtservice.LoadKeySetFromEnv(); err != nil {
+			panic(err)
+		}
+
+		// Load mailer, log and file mailers allow to test the email flows locally
+		mailer, err := mailerservice.NewMailer(mailerName, mailerDir)
+		if err != nil {
+			panic(err)
+		}
+
+		// Load rate limits, the same store keeps the login lockouts
+		rateLimitStore := ratelimitservice.NewMemoryStore()
+		rateLimitRules := ratelimitservice.DefaultRules()
+		if rateLimits != "" {
+			if rateLimitRules, err = ratelimitservice.LoadRulesFromFile(rateLimits); err != nil {
+				panic(err)
+			}
+		}
+
+		server.SetRateLimiter(ratelimitservice.NewRouteLimiter(rateLimitStore, rateLimitRules).Middleware)
+
+		lockout := ratelimitservice.NewDefaultLockout(rateLimitStore)
+		accountLockout := ratelimitservice.NewAccountLockout(rateLimitStore)
 
 		// Load services
 		productService := productusecases.NewService(productRepo, categoryRepo)
@@ -102,23 +189,35 @@ var HttpserverCmd = &cobra.Command{
 		quantityService := quantityusecases.NewService(quantityRepo)
 		processRuleService := processRuleusecases.NewService(processRuleRepo)
 
-		clientService := clientusecases.NewService(clientRepo, contactRepo)
-		employeeService := employeeusecases.NewService(employeeRepo, contactRepo)
+		addressService := addressusecases.NewService(cepRepo, cepProvider)
+		clientService := clientusecases.NewService(clientRepo, contactRepo, orderRepo, loyaltyTransactionRepo, creditAccountRepo, reservationRepo, addressService)
+		employeeService := employeeusecases.NewService(employeeRepo, contactRepo, companyRepo, userSessionRepo, lockout)
+		timeClockService := timeclockusecases.NewService(timeClockRepo, workScheduleRepo, employeeRepo, companyRepo, employeeService)
 		contactService := contactusecases.NewService(contactRepo)
 
-		orderService := orderusecases.NewService(orderRepo, shiftRepo)
-		deliveryOrderService := deliveryorderusecases.NewService(deliveryOrderRepo, addressRepo, clientRepo, orderRepo, employeeRepo, orderService)
+		loyaltyService := loyaltyusecases.NewService(loyaltyProgramRepo, loyaltyTransactionRepo, clientRepo, orderRepo, deliveryOrderRepo)
+		creditAccountService := creditaccountusecases.NewService(creditAccountRepo, clientRepo, orderRepo, deliveryOrderRepo, tableOrderRepo, companyRepo)
+		orderService := orderusecases.NewService(orderRepo, shiftRepo, loyaltyService, creditAccountService, tableRepo, reservationRepo, tableOrderRepo)
+		deliveryZoneService := deliveryzoneusecases.NewService(deliveryZoneRepo, addressRepo, companyRepo)
+		deliveryOrderService := deliveryorderusecases.NewService(deliveryOrderRepo, addressRepo, clientRepo, orderRepo, employeeRepo, orderService, deliveryZoneService, trackingTokenRepo)
+		deliveryRouteService := deliveryrouteusecases.NewService(deliveryRouteRepo, deliveryOrderRepo, orderRepo, employeeRepo, companyRepo)
+		driverSettlementService := driversettlementusecases.NewService(driverSettlementRepo, payoutRuleRepo, deliveryOrderRepo, orderRepo, employeeRepo, shiftRepo)
+		trackingService := trackingusecases.NewService(trackingTokenRepo, deliveryOrderRepo, orderRepo)
+		waiterCommissionService := waitercommissionusecases.NewService(waiterCommissionRepo, commissionRuleRepo, tableOrderRepo, orderRepo, employeeRepo, companyRepo)
 		tableOrderService := tableorderusecases.NewService(tableOrderRepo, tableRepo, orderService)
+		reservationService := reservationusecases.NewService(reservationRepo, tableRepo, tableOrderRepo, clientRepo, tableOrderService)
 		processService := processusecases.NewService(processRepo)
 		itemService := itemusecases.NewService(itemRepo, groupItemRepo, orderRepo, productRepo, quantityRepo)
 		groupService := groupitemusecases.NewService(itemRepo, groupItemRepo, productRepo)
 
 		tableService := tableusecases.NewService(tableRepo)
-		shiftService := shiftusecases.NewService(shiftRepo)
+		placeService := placeusecases.NewService(placeRepo, tableRepo, tableOrderRepo, orderRepo, reservationRepo)
+		shiftService := shiftusecases.NewService(shiftRepo, companyRepo)
 
 		schemaService := schemaservice.NewService(schemaRepo)
-		userService := userusecases.NewService(userRepo)
+		userService := userusecases.NewService(userRepo, userSessionRepo, mailer, mailerservice.NewTemplates(appURL), lockout, accountLockout)
 		companyService := companyusecases.NewService(companyRepo, addressRepo, *schemaService, userRepo, *userService)
+		organizationService := organizationusecases.NewService(organizationRepo, catalogTemplateRepo, organizationReportRepo, categoryRepo, userRepo)
 
 		// Load handlers
 		productHandler := handlerimpl.NewHandlerProduct(productService)
@@ -127,22 +226,34 @@ var HttpserverCmd = &cobra.Command{
 		quantityHandler := handlerimpl.NewHandlerQuantityCategory(quantityService)
 		processRuleHandler := handlerimpl.NewHandlerProcessRuleCategory(processRuleService)
 
-		clientHandler := handlerimpl.NewHandlerClient(clientService)
+		clientHandler := handlerimpl.NewHandlerClient(clientService, loyaltyService)
+		loyaltyProgramHandler := handlerimpl.NewHandlerLoyaltyProgram(loyaltyService)
+		creditAccountHandler := handlerimpl.NewHandlerCreditAccount(creditAccountService)
 		employeeHandler := handlerimpl.NewHandlerEmployee(employeeService)
 		contactHandler := handlerimpl.NewHandlerContactPerson(contactService)
+		addressHandler := handlerimpl.NewHandlerAddress(addressService)
 
 		orderHandler := handlerimpl.NewHandlerOrder(orderService)
 		deliveryOrderHandler := handlerimpl.NewHandlerDeliveryOrder(deliveryOrderService)
+		deliveryZoneHandler := handlerimpl.NewHandlerDeliveryZone(deliveryZoneService)
+		deliveryRouteHandler := handlerimpl.NewHandlerDeliveryRoute(deliveryRouteService)
+		driverSettlementHandler := handlerimpl.NewHandlerDriverSettlement(driverSettlementService)
+		timeClockHandler := handlerimpl.NewHandlerTimeClock(timeClockService)
+		waiterCommissionHandler := handlerimpl.NewHandlerWaiterCommission(waiterCommissionService)
+		trackingHandler := handlerimpl.NewHandlerTracking(trackingService)
 		tableOrderHandler := handlerimpl.NewHandlerTableOrder(tableOrderService)
+		reservationHandler := handlerimpl.NewHandlerReservation(reservationService)
 		processHandler := handlerimpl.NewHandlerProcess(processService)
 		itemHandler := handlerimpl.NewHandlerItem(itemService)
 		groupHandler := handlerimpl.NewHandlerGroupItem(groupService)
 
 		tableHandler := handlerimpl.NewHandlerTable(tableService)
+		placeHandler := handlerimpl.NewHandlerPlace(placeService)
 		shiftHandler := handlerimpl.NewHandlerShift(shiftService)
 
 		companyHandler := handlerimpl.NewHandlerCompany(companyService)
 		userHandler := handlerimpl.NewHandlerUser(userService)
+		organizationHandler := handlerimpl.NewHandlerOrganization(organizationService)
 
 		server.AddHandler(productHandler)
 		server.AddHandler(categoryHandler)
@@ -151,21 +262,35 @@ var HttpserverCmd = &cobra.Command{
 		server.AddHandler(processRuleHandler)
 
 		server.AddHandler(clientHandler)
+		server.AddHandler(loyaltyProgramHandler)
+		server.AddHandler(creditAccountHandler)
 		server.AddHandler(employeeHandler)
 		server.AddHandler(contactHandler)
+		server.AddHandler(addressHandler)
 
 		server.AddHandler(orderHandler)
 		server.AddHandler(deliveryOrderHandler)
+		server.AddHandler(deliveryZoneHandler)
+		server.AddHandler(deliveryRouteHandler)
+		server.AddHandler(driverSettlementHandler)
+		server.AddHandler(timeClockHandler)
+		server.AddHandler(waiterCommissionHandler)
+		server.AddHandler(trackingHandler)
 		server.AddHandler(tableOrderHandler)
+		server.AddHandler(reservationHandler)
 		server.AddHandler(processHandler)
 		server.AddHandler(itemHandler)
 		server.AddHandler(groupHandler)
 
 		server.AddHandler(tableHandler)
+		server.AddHandler(placeHandler)
 		server.AddHandler(shiftHandler)
 
 		server.AddHandler(companyHandler)
 		server.AddHandler(userHandler)
+		server.AddHandler(organizationHandler)
+		server.AddHandler(handlerimpl.NewHandlerJwks())
+		server.SetSessionValidator(userService)
 
 		if err := server.StartServer(port); err != nil {
 			panic(err)
@@ -16,6 +16,8 @@ type Address struct {
 
 type AddressCommonAttributes struct {
 	ObjectID     uuid.UUID `bun:"object_id,type:uuid,notnull" json:"object_id"`
+	Label        string    `bun:"label" json:"label,omitempty"`
+	IsDefault    bool      `bun:"is_default" json:"is_default"`
 	Street       string    `bun:"street,notnull" json:"street"`
 	Number       string    `bun:"number,notnull" json:"number"`
 	Complement   string    `bun:"complement" json:"complement"`
@@ -25,9 +27,13 @@ type AddressCommonAttributes struct {
 	State        string    `bun:"state,notnull" json:"state"`
 	Cep          string    `bun:"cep" json:"cep"`
 	DeliveryTax  float64   `bun:"delivery_tax,notnull" json:"delivery_tax"`
+	Latitude     *float64  `bun:"latitude" json:"latitude,omitempty"`
+	Longitude    *float64  `bun:"longitude" json:"longitude,omitempty"`
 }
 
 type PatchAddress struct {
+	Label        *string  `json:"label"`
+	IsDefault    *bool    `json:"is_default"`
 	Street       *string  `json:"street"`
 	Number       *string  `json:"number"`
 	Complement   *string  `json:"complement"`
@@ -37,6 +43,8 @@ type PatchAddress struct {
 	State        *string  `json:"state"`
 	Cep          *string  `json:"cep"`
 	DeliveryTax  *float64 `json:"delivery_tax"`
+	Latitude     *float64 `json:"latitude"`
+	Longitude    *float64 `json:"longitude"`
 }
 
 func (a *Address) Validate() error {
@@ -58,6 +66,10 @@ func (a *Address) Validate() error {
 	return nil
 }
 
+func (a *Address) HasCoordinates() bool {
+	return a.Latitude != nil && a.Longitude != nil
+}
+
 func NewAddress(addressCommonAttributes *AddressCommonAttributes) *Address {
 	return &Address{
 		Entity:                  entity.NewEntity(),
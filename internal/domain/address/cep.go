@@ -0,0 +1,85 @@
+package addressentity
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrInvalidCep = errors.New("cep must have 8 digits")
+)
+
+// CepCacheDuration keeps the cached cep before asking the provider again, streets are rarely renamed.
+const CepCacheDuration = 90 * 24 * time.Hour
+
+var notDigits = regexp.MustCompile("[^0-9]")
+
+// CepAddress is a cep cached in the public schema, it is shared by every company.
+type CepAddress struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:cep_addresses"`
+	CepAddressCommonAttributes
+}
+
+type CepAddressCommonAttributes struct {
+	Cep          string `bun:"cep,unique,notnull" json:"cep"`
+	Street       string `bun:"street" json:"street"`
+	Neighborhood string `bun:"neighborhood" json:"neighborhood"`
+	City         string `bun:"city,notnull" json:"city"`
+	State        string `bun:"state,notnull" json:"state"`
+}
+
+func NewCepAddress(cepAddressCommonAttributes CepAddressCommonAttributes) *CepAddress {
+	return &CepAddress{
+		Entity:                     entity.NewEntity(),
+		CepAddressCommonAttributes: cepAddressCommonAttributes,
+	}
+}
+
+func (c *CepAddress) IsExpired() bool {
+	return time.Since(c.UpdatedAt) > CepCacheDuration
+}
+
+// NormalizeCep removes the mask of the cep, 01001-000 becomes 01001000.
+func NormalizeCep(cep string) (string, error) {
+	digits := notDigits.ReplaceAllString(cep, "")
+
+	if len(digits) != 8 {
+		return "", ErrInvalidCep
+	}
+
+	return digits, nil
+}
+
+// SetCep normalizes the cep, it is only checked when changed so stored ceps never block other updates.
+func (a *AddressCommonAttributes) SetCep(cep string) error {
+	cep, err := NormalizeCep(cep)
+	if err != nil {
+		return err
+	}
+
+	a.Cep = cep
+	return nil
+}
+
+// FillFromCep completes the empty fields of the address with the cep.
+func (a *AddressCommonAttributes) FillFromCep(cep *CepAddress) {
+	a.Cep = cep.Cep
+
+	if a.Street == "" {
+		a.Street = cep.Street
+	}
+	if a.Neighborhood == "" {
+		a.Neighborhood = cep.Neighborhood
+	}
+	if a.City == "" {
+		a.City = cep.City
+	}
+	if a.State == "" {
+		a.State = cep.State
+	}
+}
@@ -8,3 +8,8 @@ type Repository interface {
 	DeleteAddress(ctx context.Context, id string) error
 	GetAddressById(ctx context.Context, id string) (*Address, error)
 }
+
+type CepRepository interface {
+	SaveCepAddress(ctx context.Context, cep *CepAddress) error
+	GetCepAddress(ctx context.Context, cep string) (*CepAddress, error)
+}
@@ -1,11 +1,112 @@
 package cliententity
 
 import (
+	"errors"
+
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
 )
 
+var (
+	ErrAddressNotInClient = errors.New("address not in client")
+)
+
 type Client struct {
 	bun.BaseModel `bun:"table:clients"`
 	personentity.Person
+	Contacts  []personentity.Contact  `bun:"rel:has-many,join:id=object_id" json:"contacts,omitempty"`
+	Addresses []addressentity.Address `bun:"rel:has-many,join:id=object_id" json:"addresses,omitempty"`
+}
+
+// AddAddress saves a new labeled address, the first address of the client is always the default.
+func (c *Client) AddAddress(address *addressentity.Address) error {
+	if err := address.Validate(); err != nil {
+		return err
+	}
+
+	address.ObjectID = c.ID
+	c.Addresses = append(c.Addresses, *address)
+
+	if address.IsDefault || len(c.Addresses) == 1 {
+		return c.SetDefaultAddress(address.ID)
+	}
+
+	c.LoadDefaultAddress()
+	return nil
+}
+
+func (c *Client) GetAddress(id uuid.UUID) (*addressentity.Address, error) {
+	for i := range c.Addresses {
+		if c.Addresses[i].ID == id {
+			return &c.Addresses[i], nil
+		}
+	}
+
+	return nil, ErrAddressNotInClient
+}
+
+func (c *Client) SetDefaultAddress(id uuid.UUID) error {
+	if _, err := c.GetAddress(id); err != nil {
+		return err
+	}
+
+	for i := range c.Addresses {
+		c.Addresses[i].IsDefault = c.Addresses[i].ID == id
+	}
+
+	c.LoadDefaultAddress()
+	return nil
+}
+
+// RemoveAddress deletes the address, if it was the default the first remaining address takes its place.
+func (c *Client) RemoveAddress(id uuid.UUID) error {
+	address, err := c.GetAddress(id)
+	if err != nil {
+		return err
+	}
+
+	wasDefault := address.IsDefault
+
+	addresses := []addressentity.Address{}
+	for _, address := range c.Addresses {
+		if address.ID != id {
+			addresses = append(addresses, address)
+		}
+	}
+
+	c.Addresses = addresses
+
+	if wasDefault && len(c.Addresses) > 0 {
+		return c.SetDefaultAddress(c.Addresses[0].ID)
+	}
+
+	c.LoadDefaultAddress()
+	return nil
+}
+
+// LoadDefaultAddress points the address of the person to the default address of the client.
+func (c *Client) LoadDefaultAddress() {
+	c.Address = nil
+
+	for i := range c.Addresses {
+		if c.Addresses[i].IsDefault {
+			c.Address = &c.Addresses[i]
+			return
+		}
+	}
+
+	if len(c.Addresses) > 0 {
+		c.Address = &c.Addresses[0]
+	}
+}
+
+// LoadDefaultContact points the contact of the person to the first contact of the client.
+func (c *Client) LoadDefaultContact() {
+	c.Contact = nil
+
+	if len(c.Contacts) > 0 {
+		c.Contact = &c.Contacts[0]
+	}
 }
@@ -0,0 +1,131 @@
+package cliententity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+)
+
+var (
+	ErrMergeSameClient     = errors.New("client cannot be merged with itself")
+	ErrClientAlreadyMerged = errors.New("client already merged")
+)
+
+// ClientMerge is the audit record of a merge, it keeps a snapshot of the merged client.
+type ClientMerge struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:client_merges"`
+	ClientMergeCommonAttributes
+}
+
+type ClientMergeCommonAttributes struct {
+	SurvivorID               uuid.UUID                           `bun:"column:survivor_id,type:uuid,notnull" json:"survivor_id"`
+	MergedID                 uuid.UUID                           `bun:"column:merged_id,type:uuid,notnull" json:"merged_id"`
+	MergedByID               *uuid.UUID                          `bun:"column:merged_by_id,type:uuid" json:"merged_by_id,omitempty"`
+	MergedClient             personentity.PersonCommonAttributes `bun:"merged_client,type:jsonb" json:"merged_client"`
+	MovedContacts            []uuid.UUID                         `bun:"moved_contacts,type:jsonb" json:"moved_contacts"`
+	MovedAddresses           []uuid.UUID                         `bun:"moved_addresses,type:jsonb" json:"moved_addresses"`
+	ReplacedAddresses        map[uuid.UUID]uuid.UUID             `bun:"replaced_addresses,type:jsonb" json:"replaced_addresses"`
+	MovedDeliveryOrders      int                                 `bun:"moved_delivery_orders" json:"moved_delivery_orders"`
+	MovedTableOrders         int                                 `bun:"moved_table_orders" json:"moved_table_orders"`
+	MovedLoyaltyTransactions int                                 `bun:"moved_loyalty_transactions" json:"moved_loyalty_transactions"`
+	MovedReservations        int                                 `bun:"moved_reservations" json:"moved_reservations"`
+	MergedCreditAccountID    *uuid.UUID                          `bun:"column:merged_credit_account_id,type:uuid" json:"merged_credit_account_id,omitempty"`
+}
+
+// Merge moves the contacts and addresses of the duplicate to the client, the duplicated ones
+// are left behind and replaced by the equal address of the client, and fills the empty personal data.
+// The duplicate is soft deleted.
+func (c *Client) Merge(duplicate *Client, userID *uuid.UUID) (*ClientMerge, error) {
+	if c.ID == duplicate.ID {
+		return nil, ErrMergeSameClient
+	}
+
+	if c.DeletedAt != nil || duplicate.DeletedAt != nil {
+		return nil, ErrClientAlreadyMerged
+	}
+
+	merge := &ClientMerge{
+		Entity: entity.NewEntity(),
+		ClientMergeCommonAttributes: ClientMergeCommonAttributes{
+			SurvivorID:        c.ID,
+			MergedID:          duplicate.ID,
+			MergedByID:        userID,
+			MergedClient:      duplicate.PersonCommonAttributes,
+			MovedContacts:     []uuid.UUID{},
+			MovedAddresses:    []uuid.UUID{},
+			ReplacedAddresses: map[uuid.UUID]uuid.UUID{},
+		},
+	}
+
+	// Snapshot without relations
+	merge.MergedClient.Contact = nil
+	merge.MergedClient.Address = nil
+
+	contacts := map[string]bool{}
+	for _, contact := range c.Contacts {
+		contacts[NormalizeContact(contact.Ddd, contact.Number)] = true
+	}
+
+	for _, contact := range duplicate.Contacts {
+		key := NormalizeContact(contact.Ddd, contact.Number)
+		if contacts[key] {
+			continue
+		}
+
+		contacts[key] = true
+		contact.ObjectID = c.ID
+		c.Contacts = append(c.Contacts, contact)
+		merge.MovedContacts = append(merge.MovedContacts, contact.ID)
+	}
+
+	addresses := map[string]uuid.UUID{}
+	for _, address := range c.Addresses {
+		addresses[addressKey(&address)] = address.ID
+	}
+
+	for _, address := range duplicate.Addresses {
+		key := addressKey(&address)
+		if id, ok := addresses[key]; ok {
+			merge.ReplacedAddresses[address.ID] = id
+			continue
+		}
+
+		addresses[key] = address.ID
+		address.ObjectID = c.ID
+		address.IsDefault = false
+		c.Addresses = append(c.Addresses, address)
+		merge.MovedAddresses = append(merge.MovedAddresses, address.ID)
+	}
+
+	if c.Email == "" {
+		c.Email = duplicate.Email
+	}
+	if c.Cpf == "" {
+		c.Cpf = duplicate.Cpf
+	}
+	if c.Birthday == nil {
+		c.Birthday = duplicate.Birthday
+	}
+
+	now := time.Now()
+	duplicate.DeletedAt = &now
+
+	c.LoadDefaultContact()
+	c.LoadDefaultAddress()
+	return merge, nil
+}
+
+func addressKey(address *addressentity.Address) string {
+	return strings.Join([]string{
+		NormalizeName(address.Street),
+		notDigits.ReplaceAllString(address.Number, ""),
+		NormalizeName(address.Complement),
+	}, "|")
+}
@@ -0,0 +1,138 @@
+package cliententity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+)
+
+func newAddress(label string) *addressentity.Address {
+	return addressentity.NewAddress(&addressentity.AddressCommonAttributes{
+		Label:        label,
+		Street:       "Rua 1",
+		Number:       "10",
+		Neighborhood: "Centro",
+		City:         "Sao Paulo",
+		State:        "SP",
+	})
+}
+
+func TestClientAddresses(t *testing.T) {
+	client := &Client{}
+	client.ID = uuid.New()
+
+	home, work := newAddress("home"), newAddress("work")
+	assert.Nil(t, client.AddAddress(home))
+	assert.Nil(t, client.AddAddress(work))
+	assert.Equal(t, home.ID, client.Address.ID)
+	assert.Equal(t, client.ID, client.Addresses[1].ObjectID)
+
+	assert.Nil(t, client.SetDefaultAddress(work.ID))
+	assert.Equal(t, work.ID, client.Address.ID)
+	assert.False(t, client.Addresses[0].IsDefault)
+
+	assert.Equal(t, ErrAddressNotInClient, client.SetDefaultAddress(uuid.New()))
+
+	// Removing the default promotes the remaining address
+	assert.Nil(t, client.RemoveAddress(work.ID))
+	assert.Len(t, client.Addresses, 1)
+	assert.Equal(t, home.ID, client.Address.ID)
+	assert.True(t, client.Address.IsDefault)
+}
+
+func newClient(name string, ddd string, number string, createdAt time.Time) Client {
+	client := Client{}
+	client.ID = uuid.New()
+	client.Name = name
+	client.CreatedAt = createdAt
+	client.Contacts = []personentity.Contact{*personentity.NewContact(personentity.ContactCommonAttributes{ObjectID: client.ID, Ddd: ddd, Number: number})}
+	return client
+}
+
+func TestFindDuplicates(t *testing.T) {
+	now := time.Now()
+	joao := newClient("João da Silva", "11", "96384-9111", now.Add(-time.Hour))
+	joaoPhone := newClient("Joao Silva", "11", "963849111", now)
+	joaoTypo := newClient("joao  da silvaa", "21", "988887777", now)
+	maria := newClient("Maria Souza", "31", "977776666", now)
+
+	candidates := FindDuplicates([]Client{joaoPhone, maria, joao, joaoTypo})
+	assert.Len(t, candidates, 2)
+
+	// The oldest client is suggested as survivor
+	byDuplicate := map[uuid.UUID]DuplicateCandidate{}
+	for _, candidate := range candidates {
+		assert.Equal(t, joao.ID, candidate.ClientID)
+		byDuplicate[candidate.DuplicateID] = candidate
+	}
+
+	assert.Equal(t, []DuplicateReason{DuplicateReasonContact}, byDuplicate[joaoPhone.ID].Reasons)
+	assert.Equal(t, []DuplicateReason{DuplicateReasonName}, byDuplicate[joaoTypo.ID].Reasons)
+	assert.GreaterOrEqual(t, byDuplicate[joaoTypo.ID].NameSimilarity, MinNameSimilarity)
+}
+
+func TestMergeClients(t *testing.T) {
+	survivor := newClient("João da Silva", "11", "963849111", time.Now())
+	duplicate := newClient("Joao Silva", "11", "963849111", time.Now())
+	duplicate.Email = "joao@email.com"
+	duplicate.Contacts = append(duplicate.Contacts, *personentity.NewContact(personentity.ContactCommonAttributes{Ddd: "11", Number: "40028922"}))
+
+	assert.Nil(t, survivor.AddAddress(newAddress("home")))
+	assert.Nil(t, duplicate.AddAddress(newAddress("home")))
+	assert.Nil(t, duplicate.AddAddress(newAddress("work")))
+	duplicate.Addresses[1].Number = "20"
+
+	userID := uuid.New()
+	merge, err := survivor.Merge(&duplicate, &userID)
+	assert.Nil(t, err)
+
+	// Only the new contact and the new address are moved
+	assert.Len(t, merge.MovedContacts, 1)
+	assert.Len(t, merge.MovedAddresses, 1)
+	assert.Equal(t, map[uuid.UUID]uuid.UUID{duplicate.Addresses[0].ID: survivor.Addresses[0].ID}, merge.ReplacedAddresses)
+	assert.Len(t, survivor.Contacts, 2)
+	assert.Len(t, survivor.Addresses, 2)
+	assert.Equal(t, survivor.ID, survivor.Addresses[1].ObjectID)
+	assert.False(t, survivor.Addresses[1].IsDefault)
+	assert.Equal(t, "joao@email.com", survivor.Email)
+	assert.NotNil(t, duplicate.DeletedAt)
+
+	_, err = survivor.Merge(&duplicate, &userID)
+	assert.Equal(t, ErrClientAlreadyMerged, err)
+	_, err = survivor.Merge(&survivor, &userID)
+	assert.Equal(t, ErrMergeSameClient, err)
+}
+
+func TestAnonymizeClient(t *testing.T) {
+	client := newClient("João da Silva", "11", "963849111", time.Now())
+	client.Cpf = "12345678900"
+	client.Email = "joao@email.com"
+	assert.Nil(t, client.AddAddress(newAddress("home")))
+	client.LoadDefaultContact()
+
+	assert.Nil(t, client.Anonymize())
+	assert.Equal(t, AnonymizedName, client.Name)
+	assert.Empty(t, client.Cpf)
+	assert.Empty(t, client.Email)
+	assert.Nil(t, client.Contact)
+	assert.Nil(t, client.Address)
+	assert.Empty(t, client.Addresses)
+	assert.NotNil(t, client.DeletedAt)
+
+	assert.Equal(t, ErrClientAlreadyAnonymized, client.Anonymize())
+
+	// A merged client keeps the date of the merge
+	survivor := newClient("João da Silva", "11", "963849111", time.Now())
+	duplicate := newClient("Joao Silva", "11", "963849111", time.Now())
+	_, err := survivor.Merge(&duplicate, nil)
+	assert.Nil(t, err)
+
+	mergedAt := *duplicate.DeletedAt
+	assert.Nil(t, duplicate.Anonymize())
+	assert.Equal(t, AnonymizedName, duplicate.Name)
+	assert.Equal(t, mergedAt, *duplicate.DeletedAt)
+}
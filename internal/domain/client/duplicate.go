@@ -0,0 +1,206 @@
+package cliententity
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type DuplicateReason string
+
+const (
+	DuplicateReasonContact DuplicateReason = "Contact"
+	DuplicateReasonCpf     DuplicateReason = "Cpf"
+	DuplicateReasonEmail   DuplicateReason = "Email"
+	DuplicateReasonName    DuplicateReason = "Name"
+)
+
+// MinNameSimilarity is the minimum similarity between two names to consider them the same person.
+const MinNameSimilarity = 0.85
+
+var (
+	notDigits  = regexp.MustCompile("[^0-9]")
+	notLetters = regexp.MustCompile("[^a-z ]")
+	accents    = strings.NewReplacer(
+		"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+		"é", "e", "è", "e", "ê", "e", "ë", "e",
+		"í", "i", "ì", "i", "î", "i", "ï", "i",
+		"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+		"ú", "u", "ù", "u", "û", "u", "ü", "u",
+		"ç", "c", "ñ", "n",
+	)
+)
+
+// DuplicateCandidate suggests the oldest client as the survivor of the merge.
+type DuplicateCandidate struct {
+	ClientID       uuid.UUID         `json:"client_id"`
+	DuplicateID    uuid.UUID         `json:"duplicate_id"`
+	Reasons        []DuplicateReason `json:"reasons"`
+	NameSimilarity float64           `json:"name_similarity"`
+}
+
+// FindDuplicates compares clients by contact, cpf and email, names are only compared
+// between clients that share a word of the name to avoid comparing every pair.
+func FindDuplicates(clients []Client) []DuplicateCandidate {
+	candidates := map[[2]int]*DuplicateCandidate{}
+
+	addReason := func(i, j int, reason DuplicateReason) {
+		if i == j {
+			return
+		}
+
+		if clients[j].CreatedAt.Before(clients[i].CreatedAt) {
+			i, j = j, i
+		}
+
+		key := [2]int{i, j}
+		candidate, ok := candidates[key]
+
+		if !ok {
+			candidate = &DuplicateCandidate{
+				ClientID:       clients[i].ID,
+				DuplicateID:    clients[j].ID,
+				NameSimilarity: NameSimilarity(clients[i].Name, clients[j].Name),
+			}
+			candidates[key] = candidate
+		}
+
+		for _, r := range candidate.Reasons {
+			if r == reason {
+				return
+			}
+		}
+
+		candidate.Reasons = append(candidate.Reasons, reason)
+	}
+
+	matchBy := func(reason DuplicateReason, keysOf func(c *Client) []string) {
+		first := map[string]int{}
+
+		for i := range clients {
+			for _, key := range keysOf(&clients[i]) {
+				if key == "" {
+					continue
+				}
+
+				if j, ok := first[key]; ok {
+					addReason(j, i, reason)
+				} else {
+					first[key] = i
+				}
+			}
+		}
+	}
+
+	matchBy(DuplicateReasonContact, func(c *Client) []string {
+		keys := []string{}
+		for _, contact := range c.Contacts {
+			keys = append(keys, NormalizeContact(contact.Ddd, contact.Number))
+		}
+		if c.Contact != nil {
+			keys = append(keys, NormalizeContact(c.Contact.Ddd, c.Contact.Number))
+		}
+		return keys
+	})
+
+	matchBy(DuplicateReasonCpf, func(c *Client) []string {
+		return []string{notDigits.ReplaceAllString(c.Cpf, "")}
+	})
+
+	matchBy(DuplicateReasonEmail, func(c *Client) []string {
+		return []string{strings.ToLower(strings.TrimSpace(c.Email))}
+	})
+
+	// Block names by word
+	blocks := map[string][]int{}
+	for i := range clients {
+		words := map[string]bool{}
+		for _, word := range strings.Fields(NormalizeName(clients[i].Name)) {
+			if len(word) >= 3 && !words[word] {
+				words[word] = true
+				blocks[word] = append(blocks[word], i)
+			}
+		}
+	}
+
+	for _, block := range blocks {
+		for a := 0; a < len(block); a++ {
+			for b := a + 1; b < len(block); b++ {
+				if NameSimilarity(clients[block[a]].Name, clients[block[b]].Name) >= MinNameSimilarity {
+					addReason(block[a], block[b], DuplicateReasonName)
+				}
+			}
+		}
+	}
+
+	result := make([]DuplicateCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		result = append(result, *candidate)
+	}
+
+	// Most reasons first
+	sort.Slice(result, func(i, j int) bool {
+		if len(result[i].Reasons) != len(result[j].Reasons) {
+			return len(result[i].Reasons) > len(result[j].Reasons)
+		}
+
+		return result[i].NameSimilarity > result[j].NameSimilarity
+	})
+
+	return result
+}
+
+func NormalizeContact(ddd string, number string) string {
+	return notDigits.ReplaceAllString(ddd+number, "")
+}
+
+// NormalizeName removes accents, case and extra spaces: "  José  da Silva" becomes "jose da silva".
+func NormalizeName(name string) string {
+	name = accents.Replace(strings.ToLower(name))
+	name = notLetters.ReplaceAllString(name, "")
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// NameSimilarity returns 1 for equal names and 0 for completely different ones, based on the levenshtein distance.
+func NameSimilarity(a string, b string) float64 {
+	ra, rb := []rune(NormalizeName(a)), []rune(NormalizeName(b))
+
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+
+	if longest == 0 {
+		return 0
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a []rune, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,76 @@
+package cliententity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+)
+
+var (
+	ErrClientAlreadyAnonymized = errors.New("client already anonymized")
+)
+
+const AnonymizedName = "Cliente anonimizado"
+
+type PrivacyRequestType string
+
+const (
+	PrivacyRequestTypeExport  PrivacyRequestType = "Export"
+	PrivacyRequestTypeErasure PrivacyRequestType = "Erasure"
+)
+
+func GetAllPrivacyRequestTypes() []PrivacyRequestType {
+	return []PrivacyRequestType{
+		PrivacyRequestTypeExport,
+		PrivacyRequestTypeErasure,
+	}
+}
+
+// PrivacyRequest logs every lgpd request answered, it is never updated or deleted.
+type PrivacyRequest struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:client_privacy_requests"`
+	PrivacyRequestCommonAttributes
+}
+
+type PrivacyRequestCommonAttributes struct {
+	ClientID      uuid.UUID          `bun:"column:client_id,type:uuid,notnull" json:"client_id"`
+	Type          PrivacyRequestType `bun:"type,notnull" json:"type"`
+	RequestedByID *uuid.UUID         `bun:"column:requested_by_id,type:uuid" json:"requested_by_id,omitempty"`
+	Observation   string             `bun:"observation" json:"observation,omitempty"`
+}
+
+func NewPrivacyRequest(clientID uuid.UUID, requestType PrivacyRequestType, requestedByID *uuid.UUID, observation string) *PrivacyRequest {
+	return &PrivacyRequest{
+		Entity: entity.NewEntity(),
+		PrivacyRequestCommonAttributes: PrivacyRequestCommonAttributes{
+			ClientID:      clientID,
+			Type:          requestType,
+			RequestedByID: requestedByID,
+			Observation:   observation,
+		},
+	}
+}
+
+// Anonymize scrubs the personal data, the client is kept so the orders still point to it.
+// A client already merged keeps the date it was deleted.
+func (c *Client) Anonymize() error {
+	if c.Name == AnonymizedName && c.DeletedAt != nil {
+		return ErrClientAlreadyAnonymized
+	}
+
+	c.PersonCommonAttributes = personentity.PersonCommonAttributes{Name: AnonymizedName}
+	c.Contacts = nil
+	c.Addresses = nil
+
+	if c.DeletedAt == nil {
+		now := time.Now()
+		c.DeletedAt = &now
+	}
+
+	return nil
+}
@@ -10,4 +10,11 @@ type Repository interface {
 	DeleteClient(ctx context.Context, id string) error
 	GetClientById(ctx context.Context, id string) (*Client, error)
 	GetAllClients(ctx context.Context) ([]Client, error)
+	SaveClientAddresses(ctx context.Context, c *Client) error
+	MergeClients(ctx context.Context, survivor *Client, duplicate *Client, merge *ClientMerge) error
+	GetAllClientMerges(ctx context.Context) ([]ClientMerge, error)
+	GetMergedClients(ctx context.Context, survivorID string) ([]Client, error)
+	AnonymizeClient(ctx context.Context, c *Client, merged []Client, request *PrivacyRequest) error
+	CreatePrivacyRequest(ctx context.Context, request *PrivacyRequest) error
+	GetAllPrivacyRequests(ctx context.Context) ([]PrivacyRequest, error)
 }
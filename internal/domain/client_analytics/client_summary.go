@@ -0,0 +1,94 @@
+package clientanalyticsentity
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+const maxFavoriteProducts = 5
+
+type ClientSummary struct {
+	ClientID         uuid.UUID         `json:"client_id"`
+	TotalOrders      int               `json:"total_orders"`
+	LifetimeValue    float64           `json:"lifetime_value"`
+	AverageTicket    float64           `json:"average_ticket"`
+	FirstOrderAt     *time.Time        `json:"first_order_at,omitempty"`
+	LastOrderAt      *time.Time        `json:"last_order_at,omitempty"`
+	FavoriteProducts []FavoriteProduct `json:"favorite_products"`
+}
+
+type FavoriteProduct struct {
+	Name     string  `json:"name"`
+	Quantity float64 `json:"quantity"`
+}
+
+// NewClientSummary aggregates the purchases of the client, canceled and open orders are ignored.
+func NewClientSummary(clientID uuid.UUID, orders []orderentity.Order) *ClientSummary {
+	summary := &ClientSummary{
+		ClientID:         clientID,
+		FavoriteProducts: []FavoriteProduct{},
+	}
+
+	quantities := map[string]float64{}
+
+	for _, order := range orders {
+		if !order.IsPurchase() {
+			continue
+		}
+
+		summary.TotalOrders++
+		summary.LifetimeValue += order.TotalPayable
+
+		if summary.FirstOrderAt == nil || order.FinishedAt.Before(*summary.FirstOrderAt) {
+			summary.FirstOrderAt = order.FinishedAt
+		}
+
+		if summary.LastOrderAt == nil || order.FinishedAt.After(*summary.LastOrderAt) {
+			summary.LastOrderAt = order.FinishedAt
+		}
+
+		for _, group := range order.Groups {
+			for _, item := range group.Items {
+				if item.CanceledAt != nil {
+					continue
+				}
+
+				quantities[item.Name] += item.Quantity
+			}
+		}
+	}
+
+	if summary.TotalOrders > 0 {
+		summary.AverageTicket = summary.LifetimeValue / float64(summary.TotalOrders)
+	}
+
+	for name, quantity := range quantities {
+		summary.FavoriteProducts = append(summary.FavoriteProducts, FavoriteProduct{Name: name, Quantity: quantity})
+	}
+
+	sort.Slice(summary.FavoriteProducts, func(i, j int) bool {
+		if summary.FavoriteProducts[i].Quantity == summary.FavoriteProducts[j].Quantity {
+			return summary.FavoriteProducts[i].Name < summary.FavoriteProducts[j].Name
+		}
+
+		return summary.FavoriteProducts[i].Quantity > summary.FavoriteProducts[j].Quantity
+	})
+
+	if len(summary.FavoriteProducts) > maxFavoriteProducts {
+		summary.FavoriteProducts = summary.FavoriteProducts[:maxFavoriteProducts]
+	}
+
+	return summary
+}
+
+// DaysSinceLastOrder returns -1 when the client never bought.
+func (s *ClientSummary) DaysSinceLastOrder(now time.Time) int {
+	if s.LastOrderAt == nil {
+		return -1
+	}
+
+	return int(now.Sub(*s.LastOrderAt).Hours() / 24)
+}
@@ -0,0 +1,105 @@
+package clientanalyticsentity
+
+import (
+	"time"
+)
+
+// Recency is scored by fixed windows in days, a client that did not come back in months is lost
+// regardless of the other clients. Frequency and monetary are scored by percentile between the clients.
+var recencyScoreDays = []int{7, 30, 90, 180}
+
+type RFMScore struct {
+	Recency   int `json:"recency"`
+	Frequency int `json:"frequency"`
+	Monetary  int `json:"monetary"`
+}
+
+type ClientRFM struct {
+	ClientSummary
+	RFMScore
+	Segment Segment `json:"segment"`
+}
+
+// ScoreClients returns the rfm of every client with at least one purchase.
+func ScoreClients(summaries []ClientSummary, now time.Time) []ClientRFM {
+	buyers := []ClientSummary{}
+	for _, summary := range summaries {
+		if summary.TotalOrders > 0 {
+			buyers = append(buyers, summary)
+		}
+	}
+
+	frequencies := make([]float64, len(buyers))
+	monetaries := make([]float64, len(buyers))
+	for i, buyer := range buyers {
+		frequencies[i] = float64(buyer.TotalOrders)
+		monetaries[i] = buyer.LifetimeValue
+	}
+
+	rfms := make([]ClientRFM, len(buyers))
+	for i, buyer := range buyers {
+		score := RFMScore{
+			Recency:   scoreRecency(buyer.DaysSinceLastOrder(now)),
+			Frequency: scorePercentile(frequencies[i], frequencies),
+			Monetary:  scorePercentile(monetaries[i], monetaries),
+		}
+
+		rfms[i] = ClientRFM{
+			ClientSummary: buyer,
+			RFMScore:      score,
+			Segment:       score.Segment(),
+		}
+	}
+
+	return rfms
+}
+
+func scoreRecency(days int) int {
+	for i, limit := range recencyScoreDays {
+		if days <= limit {
+			return 5 - i
+		}
+	}
+
+	return 1
+}
+
+// scorePercentile uses the mid rank, so equal values share the same score and a single client scores 3.
+func scorePercentile(value float64, values []float64) int {
+	below, equal := 0, 0
+	for _, v := range values {
+		if v < value {
+			below++
+		} else if v == value {
+			equal++
+		}
+	}
+
+	percentile := (float64(below) + float64(equal)/2) / float64(len(values))
+	score := 1 + int(percentile*5)
+
+	if score > 5 {
+		return 5
+	}
+
+	return score
+}
+
+func (s RFMScore) Segment() Segment {
+	switch {
+	case s.Recency >= 4 && s.Frequency >= 4 && s.Monetary >= 4:
+		return SegmentChampions
+	case s.Recency >= 3 && s.Frequency >= 4:
+		return SegmentLoyal
+	case s.Recency >= 4 && s.Frequency <= 2:
+		return SegmentNew
+	case s.Recency >= 3:
+		return SegmentPotentialLoyal
+	case s.Frequency >= 3:
+		return SegmentAtRisk
+	case s.Recency == 2:
+		return SegmentHibernating
+	default:
+		return SegmentLost
+	}
+}
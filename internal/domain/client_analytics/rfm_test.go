@@ -0,0 +1,66 @@
+package clientanalyticsentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+func newFinishedOrder(total float64, finishedAt time.Time, items ...itementity.Item) orderentity.Order {
+	order := orderentity.Order{}
+	order.TotalPayable = total
+	order.FinishedAt = &finishedAt
+	order.Groups = []groupitementity.GroupItem{{GroupCommonAttributes: groupitementity.GroupCommonAttributes{Items: items}}}
+	return order
+}
+
+func newItem(name string, quantity float64) itementity.Item {
+	return itementity.Item{ItemCommonAttributes: itementity.ItemCommonAttributes{Name: name, Quantity: quantity}}
+}
+
+func TestNewClientSummary(t *testing.T) {
+	now := time.Now()
+	canceled := newFinishedOrder(500, now)
+	canceled.CanceledAt = &now
+
+	orders := []orderentity.Order{
+		newFinishedOrder(40, now.AddDate(0, 0, -10), newItem("Pizza", 1), newItem("Soda", 2)),
+		newFinishedOrder(60, now.AddDate(0, 0, -2), newItem("Pizza", 2)),
+		canceled,
+		{},
+	}
+
+	summary := NewClientSummary(uuid.New(), orders)
+	assert.Equal(t, 2, summary.TotalOrders)
+	assert.Equal(t, 100.0, summary.LifetimeValue)
+	assert.Equal(t, 50.0, summary.AverageTicket)
+	assert.Equal(t, now.AddDate(0, 0, -2), *summary.LastOrderAt)
+	assert.Equal(t, now.AddDate(0, 0, -10), *summary.FirstOrderAt)
+	assert.Equal(t, []FavoriteProduct{{Name: "Pizza", Quantity: 3}, {Name: "Soda", Quantity: 2}}, summary.FavoriteProducts)
+	assert.Equal(t, 2, summary.DaysSinceLastOrder(now))
+}
+
+func TestScoreClients(t *testing.T) {
+	now := time.Now()
+	recent, old := now.AddDate(0, 0, -1), now.AddDate(0, -8, 0)
+
+	summaries := []ClientSummary{
+		{ClientID: uuid.New(), TotalOrders: 20, LifetimeValue: 2000, LastOrderAt: &recent},
+		{ClientID: uuid.New(), TotalOrders: 18, LifetimeValue: 1500, LastOrderAt: &old},
+		{ClientID: uuid.New(), TotalOrders: 1, LifetimeValue: 30, LastOrderAt: &recent},
+		{ClientID: uuid.New(), TotalOrders: 1, LifetimeValue: 20, LastOrderAt: &old},
+		{ClientID: uuid.New()},
+	}
+
+	rfms := ScoreClients(summaries, now)
+	assert.Len(t, rfms, 4)
+	assert.Equal(t, SegmentChampions, rfms[0].Segment)
+	assert.Equal(t, SegmentAtRisk, rfms[1].Segment)
+	assert.Equal(t, SegmentNew, rfms[2].Segment)
+	assert.Equal(t, SegmentLost, rfms[3].Segment)
+}
@@ -0,0 +1,35 @@
+package clientanalyticsentity
+
+type Segment string
+
+const (
+	SegmentChampions      Segment = "Champions"
+	SegmentLoyal          Segment = "Loyal"
+	SegmentPotentialLoyal Segment = "PotentialLoyal"
+	SegmentNew            Segment = "New"
+	SegmentAtRisk         Segment = "AtRisk"
+	SegmentHibernating    Segment = "Hibernating"
+	SegmentLost           Segment = "Lost"
+)
+
+func GetAllSegments() []Segment {
+	return []Segment{
+		SegmentChampions,
+		SegmentLoyal,
+		SegmentPotentialLoyal,
+		SegmentNew,
+		SegmentAtRisk,
+		SegmentHibernating,
+		SegmentLost,
+	}
+}
+
+func (s Segment) IsValid() bool {
+	for _, segment := range GetAllSegments() {
+		if s == segment {
+			return true
+		}
+	}
+
+	return false
+}
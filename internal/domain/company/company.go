@@ -18,13 +18,14 @@ type Company struct {
 }
 
 type CompanyCommonAttributes struct {
-	SchemaName   string                 `bun:"schema_name,notnull"`
-	BusinessName string                 `bun:"business_name,notnull" json:"business_name"`
-	TradeName    string                 `bun:"trade_name,notnull" json:"trade_name"`
-	Cnpj         string                 `bun:"cnpj,notnull" json:"cnpj"`
-	Email        string                 `bun:"email" json:"email"`
-	Contacts     []string               `bun:"contacts,type:jsonb" json:"contacts,omitempty"`
-	Address      *addressentity.Address `bun:"rel:has-one,join:id=object_id,notnull" json:"address,omitempty"`
+	SchemaName             string                 `bun:"schema_name,notnull"`
+	BusinessName           string                 `bun:"business_name,notnull" json:"business_name"`
+	TradeName              string                 `bun:"trade_name,notnull" json:"trade_name"`
+	Cnpj                   string                 `bun:"cnpj,notnull" json:"cnpj"`
+	Email                  string                 `bun:"email" json:"email"`
+	Contacts               []string               `bun:"contacts,type:jsonb" json:"contacts,omitempty"`
+	Address                *addressentity.Address `bun:"rel:has-one,join:id=object_id,notnull" json:"address,omitempty"`
+	TwoFactorRequiredRoles []UserRole             `bun:"two_factor_required_roles,type:jsonb" json:"two_factor_required_roles,omitempty"`
 }
 
 type CompanyWithUsers struct {
@@ -39,6 +40,7 @@ type CompanyToUsers struct {
 	CompanyWithUsers   *CompanyWithUsers `bun:"rel:belongs-to,join:company_with_users_id=id" json:"company,omitempty"`
 	UserID             uuid.UUID         `bun:"type:uuid,pk"`
 	User               *User             `bun:"rel:belongs-to,join:user_id=id" json:"user,omitempty"`
+	Role               UserRole          `bun:"role,default:'Employee'" json:"role"`
 }
 
 func NewCompany(cnpjData *cnpj.Cnpj) *Company {
@@ -0,0 +1,240 @@
+package companyentity
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	// The server may run without the zoneinfo of the system
+	_ "time/tzdata"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrTimezoneInvalid              = errors.New("timezone must be a valid IANA name")
+	ErrCurrencyInvalid              = errors.New("currency must be an ISO 4217 code")
+	ErrLocaleInvalid                = errors.New("locale must be in the format ll or ll-CC")
+	ErrBusinessHoursInvalid         = errors.New("business hours must be in the format HH:MM")
+	ErrBusinessHoursDuplicated      = errors.New("business hours duplicated for the weekday")
+	ErrWeekdayInvalid               = errors.New("weekday must be between 0 (sunday) and 6 (saturday)")
+	ErrServiceChargeInvalid         = errors.New("service charge must be between 0 and 100")
+	ErrOrderNumberResetInvalid      = errors.New("order number reset invalid")
+	ErrOnlyManagerCanChangeSettings = errors.New("only the owner or a manager can change the settings")
+)
+
+const (
+	DefaultTimezone = "America/Sao_Paulo"
+	DefaultCurrency = "BRL"
+	DefaultLocale   = "pt-BR"
+	hourLayout      = "15:04"
+)
+
+// CompanySettingsID is the id of the single row, so saving the default settings never inserts a second one.
+var CompanySettingsID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+var (
+	currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+	localeRegex   = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+)
+
+type OrderNumberReset string
+
+const (
+	OrderNumberResetShift OrderNumberReset = "Shift"
+	OrderNumberResetDaily OrderNumberReset = "Daily"
+	OrderNumberResetNever OrderNumberReset = "Never"
+)
+
+func GetAllOrderNumberResets() []OrderNumberReset {
+	return []OrderNumberReset{
+		OrderNumberResetShift,
+		OrderNumberResetDaily,
+		OrderNumberResetNever,
+	}
+}
+
+// CompanySettings is a single row in the schema of the company.
+type CompanySettings struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:company_settings"`
+	CompanySettingsCommonAttributes
+}
+
+type CompanySettingsCommonAttributes struct {
+	Timezone         string           `bun:"timezone,notnull" json:"timezone"`
+	Currency         string           `bun:"currency,notnull" json:"currency"`
+	Locale           string           `bun:"locale,notnull" json:"locale"`
+	BusinessHours    []BusinessHours  `bun:"business_hours,type:jsonb" json:"business_hours"`
+	ServiceCharge    float64          `bun:"service_charge" json:"service_charge"`
+	OrderNumberReset OrderNumberReset `bun:"order_number_reset,notnull" json:"order_number_reset"`
+}
+
+// BusinessHours of a weekday, a close before the open ends on the next day.
+type BusinessHours struct {
+	Weekday time.Weekday `json:"weekday"`
+	OpenAt  string       `json:"open_at"`
+	CloseAt string       `json:"close_at"`
+}
+
+func NewDefaultCompanySettings() *CompanySettings {
+	settings := &CompanySettings{
+		Entity: entity.NewEntity(),
+		CompanySettingsCommonAttributes: CompanySettingsCommonAttributes{
+			Timezone:         DefaultTimezone,
+			Currency:         DefaultCurrency,
+			Locale:           DefaultLocale,
+			BusinessHours:    []BusinessHours{},
+			OrderNumberReset: OrderNumberResetShift,
+		},
+	}
+
+	settings.ID = CompanySettingsID
+	return settings
+}
+
+func (s *CompanySettings) Validate() error {
+	if _, err := time.LoadLocation(s.Timezone); err != nil || s.Timezone == "" {
+		return ErrTimezoneInvalid
+	}
+
+	if !currencyRegex.MatchString(s.Currency) {
+		return ErrCurrencyInvalid
+	}
+
+	if !localeRegex.MatchString(s.Locale) {
+		return ErrLocaleInvalid
+	}
+
+	if s.ServiceCharge < 0 || s.ServiceCharge > 100 {
+		return ErrServiceChargeInvalid
+	}
+
+	if !isValidOrderNumberReset(s.OrderNumberReset) {
+		return ErrOrderNumberResetInvalid
+	}
+
+	weekdays := map[time.Weekday]bool{}
+	for _, hours := range s.BusinessHours {
+		if hours.Weekday < time.Sunday || hours.Weekday > time.Saturday {
+			return ErrWeekdayInvalid
+		}
+
+		if weekdays[hours.Weekday] {
+			return ErrBusinessHoursDuplicated
+		}
+
+		if _, _, err := hours.minutes(); err != nil {
+			return err
+		}
+
+		weekdays[hours.Weekday] = true
+	}
+
+	return nil
+}
+
+// Location returns the timezone of the company, UTC when it can't be loaded.
+func (s *CompanySettings) Location() *time.Location {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+func (s *CompanySettings) Now() time.Time {
+	return time.Now().In(s.Location())
+}
+
+// Date returns the midnight, in the company timezone, of the calendar date of the time given.
+func (s *CompanySettings) Date(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.Location())
+}
+
+// BusinessDay returns the day the instant belongs to, the hours after midnight of an overnight day count for the previous day.
+func (s *CompanySettings) BusinessDay(t time.Time) time.Time {
+	t = t.In(s.Location())
+	day := s.Date(t)
+
+	previous := day.AddDate(0, 0, -1)
+	if hours := s.FindBusinessHours(previous.Weekday()); hours != nil && hours.isOvernight() {
+		_, closeAt, _ := hours.minutes()
+		if minutesOfDay(t) < closeAt {
+			return previous
+		}
+	}
+
+	return day
+}
+
+// IsOpenAt checks the business hours, a company without business hours is always open.
+func (s *CompanySettings) IsOpenAt(t time.Time) bool {
+	if len(s.BusinessHours) == 0 {
+		return true
+	}
+
+	t = t.In(s.Location())
+	minutes := minutesOfDay(t)
+
+	if hours := s.FindBusinessHours(t.Weekday()); hours != nil {
+		openAt, closeAt, _ := hours.minutes()
+		if minutes >= openAt && (hours.isOvernight() || minutes < closeAt) {
+			return true
+		}
+	}
+
+	previous := t.AddDate(0, 0, -1)
+	if hours := s.FindBusinessHours(previous.Weekday()); hours != nil && hours.isOvernight() {
+		_, closeAt, _ := hours.minutes()
+		return minutes < closeAt
+	}
+
+	return false
+}
+
+func (s *CompanySettings) FindBusinessHours(weekday time.Weekday) *BusinessHours {
+	for i := range s.BusinessHours {
+		if s.BusinessHours[i].Weekday == weekday {
+			return &s.BusinessHours[i]
+		}
+	}
+
+	return nil
+}
+
+func (h *BusinessHours) minutes() (openAt int, closeAt int, err error) {
+	openTime, err := time.Parse(hourLayout, h.OpenAt)
+	if err != nil {
+		return 0, 0, ErrBusinessHoursInvalid
+	}
+
+	closeTime, err := time.Parse(hourLayout, h.CloseAt)
+	if err != nil {
+		return 0, 0, ErrBusinessHoursInvalid
+	}
+
+	return minutesOfDay(openTime), minutesOfDay(closeTime), nil
+}
+
+func (h *BusinessHours) isOvernight() bool {
+	openAt, closeAt, err := h.minutes()
+	return err == nil && closeAt <= openAt
+}
+
+func minutesOfDay(t time.Time) int {
+	return t.Hour()*60 + t.Minute()
+}
+
+func isValidOrderNumberReset(reset OrderNumberReset) bool {
+	for _, r := range GetAllOrderNumberResets() {
+		if r == reset {
+			return true
+		}
+	}
+
+	return false
+}
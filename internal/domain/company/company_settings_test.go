@@ -0,0 +1,65 @@
+package companyentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompanySettingsValidate(t *testing.T) {
+	settings := NewDefaultCompanySettings()
+	assert.Nil(t, settings.Validate())
+
+	// The defaults always have the same id, saving them updates the single row
+	assert.Equal(t, CompanySettingsID, settings.ID)
+	assert.Equal(t, settings.ID, NewDefaultCompanySettings().ID)
+
+	settings.Timezone = "Mars/Olympus"
+	assert.ErrorIs(t, settings.Validate(), ErrTimezoneInvalid)
+
+	settings = NewDefaultCompanySettings()
+	settings.Currency = "real"
+	assert.ErrorIs(t, settings.Validate(), ErrCurrencyInvalid)
+
+	settings = NewDefaultCompanySettings()
+	settings.Locale = "pt_BR"
+	assert.ErrorIs(t, settings.Validate(), ErrLocaleInvalid)
+
+	settings = NewDefaultCompanySettings()
+	settings.OrderNumberReset = "Weekly"
+	assert.ErrorIs(t, settings.Validate(), ErrOrderNumberResetInvalid)
+
+	settings = NewDefaultCompanySettings()
+	settings.BusinessHours = []BusinessHours{{Weekday: time.Monday, OpenAt: "18:00", CloseAt: "2am"}}
+	assert.ErrorIs(t, settings.Validate(), ErrBusinessHoursInvalid)
+
+	settings.BusinessHours = []BusinessHours{{Weekday: time.Monday, OpenAt: "18:00", CloseAt: "02:00"}, {Weekday: time.Monday, OpenAt: "11:00", CloseAt: "15:00"}}
+	assert.ErrorIs(t, settings.Validate(), ErrBusinessHoursDuplicated)
+}
+
+func TestCompanySettingsBusinessDay(t *testing.T) {
+	settings := NewDefaultCompanySettings()
+	settings.BusinessHours = []BusinessHours{
+		{Weekday: time.Friday, OpenAt: "18:00", CloseAt: "02:00"},
+		{Weekday: time.Saturday, OpenAt: "11:00", CloseAt: "15:00"},
+	}
+
+	loc := settings.Location()
+
+	// 2026-10-17 03:30 UTC is saturday 00:30 in Sao Paulo, still the friday night
+	at := time.Date(2026, 10, 17, 3, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 16, 0, 0, 0, 0, loc), settings.BusinessDay(at))
+	assert.True(t, settings.IsOpenAt(at))
+
+	// Saturday 03:00 in Sao Paulo, closed and already saturday
+	at = time.Date(2026, 10, 17, 6, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 17, 0, 0, 0, 0, loc), settings.BusinessDay(at))
+	assert.False(t, settings.IsOpenAt(at))
+
+	// Saturday 12:00 in Sao Paulo
+	assert.True(t, settings.IsOpenAt(time.Date(2026, 10, 17, 15, 0, 0, 0, time.UTC)))
+
+	// The date sent by the client keeps its calendar day
+	assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, loc), settings.Date(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)))
+}
@@ -9,8 +9,13 @@ import (
 type CompanyRepository interface {
 	NewCompany(ctx context.Context, company *Company) error
 	GetCompany(ctx context.Context) (*Company, error)
-	AddUserToPublicCompany(ctx context.Context, userID uuid.UUID) error
+	UpdateTwoFactorPolicy(ctx context.Context, company *Company) error
+	GetUserRole(ctx context.Context, userID uuid.UUID) (UserRole, error)
+	CountUsersByRole(ctx context.Context, role UserRole) (int, error)
+	AddUserToPublicCompany(ctx context.Context, userID uuid.UUID, role UserRole) error
 	RemoveUserFromPublicCompany(ctx context.Context, userID uuid.UUID) error
+	GetCompanySettings(ctx context.Context) (*CompanySettings, error)
+	UpdateCompanySettings(ctx context.Context, settings *CompanySettings) error
 }
 
 type UserRepository interface {
@@ -19,4 +24,19 @@ type UserRepository interface {
 	DeleteUser(ctx context.Context, user *User) error
 	LoginUser(ctx context.Context, user *User) (*User, error)
 	GetIDByEmail(ctx context.Context, email string) (uuid.UUID, error)
+	GetUserById(ctx context.Context, id uuid.UUID) (*User, error)
+	GetUserByEmail(ctx context.Context, email string) (*User, error)
+	UpdateUserPassword(ctx context.Context, user *User) error
+	UpdateUserEmailVerified(ctx context.Context, user *User) error
+	UpdateUserTwoFactor(ctx context.Context, user *User) error
+}
+
+type UserSessionRepository interface {
+	CreateSession(ctx context.Context, session *UserSession, token *RefreshToken) error
+	RotateRefreshToken(ctx context.Context, session *UserSession, used *RefreshToken, next *RefreshToken) error
+	UpdateSession(ctx context.Context, session *UserSession) error
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID, reason string) error
+	GetSessionById(ctx context.Context, id uuid.UUID) (*UserSession, error)
+	GetActiveSessionsByUserId(ctx context.Context, userID uuid.UUID) ([]UserSession, error)
+	GetRefreshTokenByHash(ctx context.Context, hash string) (*RefreshToken, error)
 }
@@ -0,0 +1,157 @@
+package companyentity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrTwoFactorAlreadyEnabled  = errors.New("two factor already enabled")
+	ErrTwoFactorNotEnabled      = errors.New("two factor not enabled")
+	ErrTwoFactorNotEnrolling    = errors.New("two factor enrollment not started")
+	ErrTwoFactorCodeInvalid     = errors.New("two factor code invalid")
+	ErrTwoFactorCodeReused      = errors.New("two factor code already used")
+	ErrTwoFactorRequired        = errors.New("two factor required for this company")
+	ErrTwoFactorRequiredByRole  = errors.New("two factor required by a company of the user")
+	ErrRecoveryCodeInvalid      = errors.New("recovery code invalid")
+	ErrOnlyOwnerCanChangePolicy = errors.New("only the owner can change the two factor policy")
+)
+
+const RecoveryCodesCount = 10
+
+// TwoFactor keeps the TOTP secret of the user, the secret is only active after the first valid code.
+type TwoFactor struct {
+	TwoFactorSecret    string     `bun:"column:two_factor_secret" json:"-"`
+	TwoFactorEnabledAt *time.Time `bun:"column:two_factor_enabled_at" json:"two_factor_enabled_at,omitempty"`
+	TwoFactorLastStep  int64      `bun:"column:two_factor_last_step" json:"-"`
+	RecoveryCodes      []string   `bun:"column:recovery_codes,type:jsonb" json:"-"`
+}
+
+func (u *User) IsTwoFactorEnabled() bool {
+	return u.TwoFactorEnabledAt != nil
+}
+
+func (u *User) StartTwoFactorEnrollment(secret string) error {
+	if u.IsTwoFactorEnabled() {
+		return ErrTwoFactorAlreadyEnabled
+	}
+
+	u.TwoFactorSecret = secret
+	u.TwoFactorLastStep = 0
+	return nil
+}
+
+// UseTwoFactorStep refuses a code of a step already used, a code can't be replayed inside its window.
+func (u *User) UseTwoFactorStep(step int64) error {
+	if step <= u.TwoFactorLastStep {
+		return ErrTwoFactorCodeReused
+	}
+
+	u.TwoFactorLastStep = step
+	return nil
+}
+
+// ConfirmTwoFactor enables the factor and returns the recovery codes, they are shown only once.
+func (u *User) ConfirmTwoFactor(step int64) ([]string, error) {
+	if u.IsTwoFactorEnabled() {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	if u.TwoFactorSecret == "" {
+		return nil, ErrTwoFactorNotEnrolling
+	}
+
+	if err := u.UseTwoFactorStep(step); err != nil {
+		return nil, err
+	}
+
+	u.TwoFactorEnabledAt = &time.Time{}
+	*u.TwoFactorEnabledAt = time.Now()
+
+	return u.GenerateRecoveryCodes()
+}
+
+func (u *User) DisableTwoFactor() {
+	u.TwoFactorSecret = ""
+	u.TwoFactorEnabledAt = nil
+	u.TwoFactorLastStep = 0
+	u.RecoveryCodes = nil
+}
+
+// GenerateRecoveryCodes replaces the previous codes, only the hashes are stored.
+func (u *User) GenerateRecoveryCodes() ([]string, error) {
+	codes := []string{}
+	hashes := []string{}
+
+	for i := 0; i < RecoveryCodesCount; i++ {
+		bytes := make([]byte, 5)
+		if _, err := rand.Read(bytes); err != nil {
+			return nil, err
+		}
+
+		code := hex.EncodeToString(bytes)
+		code = code[:5] + "-" + code[5:]
+
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	u.RecoveryCodes = hashes
+	return codes, nil
+}
+
+// UseRecoveryCode removes the code, each one works a single time.
+func (u *User) UseRecoveryCode(code string) error {
+	hash := hashRecoveryCode(code)
+
+	for i, recoveryCode := range u.RecoveryCodes {
+		if recoveryCode == hash {
+			u.RecoveryCodes = append(u.RecoveryCodes[:i], u.RecoveryCodes[i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrRecoveryCodeInvalid
+}
+
+// RequiresTwoFactor checks the policy of every company of the user for the role they have there.
+func (u *User) RequiresTwoFactor() bool {
+	for _, company := range u.Companies {
+		if u.RequiresTwoFactorFor(company.SchemaName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (u *User) RequiresTwoFactorFor(schema string) bool {
+	for _, ctu := range u.CompanyToUsers {
+		for _, company := range u.Companies {
+			if company.ID == ctu.CompanyWithUsersID && strings.EqualFold(company.SchemaName, schema) {
+				return company.RequiresTwoFactor(ctu.Role)
+			}
+		}
+	}
+
+	return false
+}
+
+func (c *CompanyCommonAttributes) RequiresTwoFactor(role UserRole) bool {
+	for _, r := range c.TwoFactorRequiredRoles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
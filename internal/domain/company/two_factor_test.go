@@ -0,0 +1,54 @@
+package companyentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+func TestUserTwoFactorEnrollment(t *testing.T) {
+	user := NewUser(UserCommonAttributes{Email: "owner@test.com"})
+
+	_, err := user.ConfirmTwoFactor(10)
+	assert.Equal(t, ErrTwoFactorNotEnrolling, err)
+
+	assert.Nil(t, user.StartTwoFactorEnrollment("SECRET"))
+	codes, err := user.ConfirmTwoFactor(10)
+	assert.Nil(t, err)
+	assert.True(t, user.IsTwoFactorEnabled())
+	assert.Len(t, codes, RecoveryCodesCount)
+	assert.NotContains(t, user.RecoveryCodes, codes[0])
+
+	assert.Equal(t, ErrTwoFactorAlreadyEnabled, user.StartTwoFactorEnrollment("OTHER"))
+
+	// The same code can't be used twice
+	assert.Equal(t, ErrTwoFactorCodeReused, user.UseTwoFactorStep(10))
+	assert.Nil(t, user.UseTwoFactorStep(11))
+
+	assert.Nil(t, user.UseRecoveryCode(codes[0]))
+	assert.Equal(t, ErrRecoveryCodeInvalid, user.UseRecoveryCode(codes[0]))
+	assert.Len(t, user.RecoveryCodes, RecoveryCodesCount-1)
+}
+
+func TestUserRequiresTwoFactorFor(t *testing.T) {
+	company := CompanyWithUsers{Entity: entity.NewEntity()}
+	company.SchemaName = "loja_test"
+	company.TwoFactorRequiredRoles = []UserRole{RoleOwner, RoleManager}
+
+	user := NewUser(UserCommonAttributes{Email: "employee@test.com"})
+	user.Companies = []CompanyWithUsers{company}
+	user.CompanyToUsers = []CompanyToUsers{{CompanyWithUsersID: company.ID, UserID: user.ID, Role: RoleEmployee}}
+
+	assert.False(t, user.RequiresTwoFactorFor("loja_test"))
+	assert.False(t, user.RequiresTwoFactor())
+
+	user.CompanyToUsers[0].Role = RoleManager
+	assert.True(t, user.RequiresTwoFactorFor("loja_test"))
+	assert.False(t, user.RequiresTwoFactorFor("other"))
+	assert.True(t, user.RequiresTwoFactor())
+
+	user.CompanyToUsers[0].CompanyWithUsersID = uuid.New()
+	assert.False(t, user.RequiresTwoFactor())
+}
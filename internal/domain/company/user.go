@@ -1,10 +1,20 @@
 package companyentity
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
 	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 )
 
+var (
+	ErrPasswordChangeRequired = errors.New("password change required")
+	ErrUserTokenInvalid       = errors.New("user token invalid")
+)
+
 type UserValue string
 type User struct {
 	entity.Entity
@@ -13,9 +23,12 @@ type User struct {
 }
 
 type UserCommonAttributes struct {
-	Email          string             `bun:"column:email,unique,notnull" json:"email"`
-	Password       string             `bun:"-" json:"password"`
-	Hash           string             `bun:"column:hash,notnull" json:"hash"`
+	Email              string     `bun:"column:email,unique,notnull" json:"email"`
+	Password           string     `bun:"-" json:"password"`
+	Hash               string     `bun:"column:hash,notnull" json:"hash"`
+	MustChangePassword bool       `bun:"column:must_change_password" json:"must_change_password"`
+	EmailVerifiedAt    *time.Time `bun:"column:email_verified_at" json:"email_verified_at,omitempty"`
+	TwoFactor
 	CompanyToUsers []CompanyToUsers   `bun:"rel:has-many,join:id=user_id"`
 	Companies      []CompanyWithUsers `bun:"-" json:"companies"`
 }
@@ -36,3 +49,28 @@ func (u *User) GetSchemas() []string {
 
 	return schemas
 }
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+func (u *User) VerifyEmail() {
+	if u.IsEmailVerified() {
+		return
+	}
+
+	u.EmailVerifiedAt = &time.Time{}
+	*u.EmailVerifiedAt = time.Now()
+}
+
+// SetPassword receives the new hash, a password set by the user clears the forced change.
+func (u *User) SetPassword(hash string) {
+	u.Hash = hash
+	u.MustChangePassword = false
+}
+
+// PasswordFingerprint changes with the password, so reset tokens stop working once used.
+func (u *User) PasswordFingerprint() string {
+	sum := sha256.Sum256([]byte(u.Hash))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,48 @@
+package companyentity
+
+import "errors"
+
+var (
+	ErrRoleNotAllowed = errors.New("role not allowed to manage users with this role")
+	ErrLastOwner      = errors.New("company must keep at least one owner")
+)
+
+type UserRole string
+
+const (
+	RoleOwner    UserRole = "Owner"
+	RoleManager  UserRole = "Manager"
+	RoleEmployee UserRole = "Employee"
+	RoleDriver   UserRole = "Driver"
+)
+
+func GetAllUserRoles() []UserRole {
+	return []UserRole{
+		RoleOwner,
+		RoleManager,
+		RoleEmployee,
+		RoleDriver,
+	}
+}
+
+func IsValidUserRole(role UserRole) bool {
+	for _, r := range GetAllUserRoles() {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanManage tells if the role adds and removes users of the role given, managers only handle the staff.
+func (r UserRole) CanManage(role UserRole) bool {
+	switch r {
+	case RoleOwner:
+		return true
+	case RoleManager:
+		return role == RoleEmployee || role == RoleDriver
+	default:
+		return false
+	}
+}
@@ -0,0 +1,222 @@
+package companyentity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrSessionRevoked        = errors.New("session revoked")
+	ErrSessionNotFromUser    = errors.New("session does not belong to user")
+	ErrRefreshTokenInvalid   = errors.New("refresh token invalid")
+	ErrRefreshTokenExpired   = errors.New("refresh token expired")
+	ErrRefreshTokenReused    = errors.New("refresh token already used, session revoked")
+	ErrRefreshTokenNotActive = errors.New("refresh token not from an active session")
+	ErrSessionNotTerminal    = errors.New("session is not bound to a terminal")
+	ErrTerminalFromOther     = errors.New("terminal bound to another company")
+)
+
+const (
+	RevokedByLogout    = "logout"
+	RevokedByLogoutAll = "logout_all"
+	RevokedByUser      = "revoked_by_user"
+	RevokedByReuse     = "refresh_token_reused"
+	RevokedByReset     = "password_reset"
+)
+
+// RefreshTokenExpiration is the lifetime of each refresh token, every rotation starts a new one.
+const RefreshTokenExpiration = 30 * 24 * time.Hour
+
+const refreshTokenBytes = 32
+
+type SessionValue string
+
+// UserSession is stored in the public schema, it groups the family of refresh tokens issued since the login.
+type UserSession struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:user_sessions,alias:us"`
+	UserSessionCommonAttributes
+	SessionTimeLogs
+}
+
+type UserSessionCommonAttributes struct {
+	UserID        uuid.UUID `bun:"column:user_id,type:uuid,notnull" json:"user_id"`
+	UserAgent     string    `bun:"user_agent" json:"user_agent"`
+	IPAddress     string    `bun:"ip_address" json:"ip_address"`
+	RevokedReason string    `bun:"revoked_reason" json:"revoked_reason,omitempty"`
+	SessionTerminal
+}
+
+// SessionTerminal binds the session to a shared device of a company, where employees switch by pin.
+type SessionTerminal struct {
+	TerminalName     string     `bun:"terminal_name" json:"terminal_name,omitempty"`
+	TerminalSchema   string     `bun:"terminal_schema" json:"-"`
+	ActingEmployeeID *uuid.UUID `bun:"column:acting_employee_id,type:uuid" json:"acting_employee_id,omitempty"`
+	ActingSince      *time.Time `bun:"acting_since" json:"acting_since,omitempty"`
+}
+
+type SessionTimeLogs struct {
+	LastUsedAt *time.Time `bun:"last_used_at" json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `bun:"revoked_at" json:"revoked_at,omitempty"`
+	// TwoFactorAt is set when the login used the second factor
+	TwoFactorAt *time.Time `bun:"two_factor_at" json:"two_factor_at,omitempty"`
+}
+
+// RefreshToken only keeps the hash of the token sent to the client.
+type RefreshToken struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:refresh_tokens,alias:rt"`
+	RefreshTokenCommonAttributes
+}
+
+type RefreshTokenCommonAttributes struct {
+	SessionID    uuid.UUID  `bun:"column:session_id,type:uuid,notnull" json:"session_id"`
+	TokenHash    string     `bun:"token_hash,unique,notnull" json:"-"`
+	ExpiresAt    time.Time  `bun:"expires_at,notnull" json:"expires_at"`
+	UsedAt       *time.Time `bun:"used_at" json:"used_at,omitempty"`
+	ReplacedByID *uuid.UUID `bun:"column:replaced_by_id,type:uuid" json:"replaced_by_id,omitempty"`
+}
+
+func NewUserSession(userID uuid.UUID, userAgent string, ipAddress string) *UserSession {
+	return &UserSession{
+		Entity: entity.NewEntity(),
+		UserSessionCommonAttributes: UserSessionCommonAttributes{
+			UserID:    userID,
+			UserAgent: userAgent,
+			IPAddress: ipAddress,
+		},
+	}
+}
+
+func (s *UserSession) IsActive() bool {
+	return s.RevokedAt == nil
+}
+
+func (s *UserSession) VerifyTwoFactor() {
+	s.TwoFactorAt = &time.Time{}
+	*s.TwoFactorAt = time.Now()
+}
+
+func (s *UserSession) IsTwoFactorVerified() bool {
+	return s.TwoFactorAt != nil
+}
+
+func (s *UserSession) IsTerminal() bool {
+	return s.TerminalSchema != ""
+}
+
+func (s *UserSession) BindTerminal(name string, schema string) {
+	s.TerminalName = name
+	s.TerminalSchema = schema
+	s.ReleaseEmployee()
+}
+
+func (s *UserSession) SwitchEmployee(schema string, employeeID uuid.UUID) error {
+	if !s.IsTerminal() {
+		return ErrSessionNotTerminal
+	}
+
+	if s.TerminalSchema != schema {
+		return ErrTerminalFromOther
+	}
+
+	s.ActingEmployeeID = &employeeID
+	s.ActingSince = &time.Time{}
+	*s.ActingSince = time.Now()
+	return nil
+}
+
+func (s *UserSession) ReleaseEmployee() {
+	s.ActingEmployeeID = nil
+	s.ActingSince = nil
+}
+
+// ActingEmployeeFor returns the employee switched on the terminal when it belongs to the schema.
+func (s *UserSession) ActingEmployeeFor(schema string) (uuid.UUID, bool) {
+	if !s.IsTerminal() || s.TerminalSchema != schema || s.ActingEmployeeID == nil {
+		return uuid.Nil, false
+	}
+
+	return *s.ActingEmployeeID, true
+}
+
+func (s *UserSession) Revoke(reason string) {
+	if !s.IsActive() {
+		return
+	}
+
+	s.RevokedAt = &time.Time{}
+	*s.RevokedAt = time.Now()
+	s.RevokedReason = reason
+}
+
+// NewRefreshToken returns the token to persist and the plain value to send to the client.
+func (s *UserSession) NewRefreshToken() (*RefreshToken, string, error) {
+	bytes := make([]byte, refreshTokenBytes)
+
+	if _, err := rand.Read(bytes); err != nil {
+		return nil, "", err
+	}
+
+	plain := hex.EncodeToString(bytes)
+
+	s.LastUsedAt = &time.Time{}
+	*s.LastUsedAt = time.Now()
+
+	return &RefreshToken{
+		Entity: entity.NewEntity(),
+		RefreshTokenCommonAttributes: RefreshTokenCommonAttributes{
+			SessionID: s.ID,
+			TokenHash: HashRefreshToken(plain),
+			ExpiresAt: time.Now().Add(RefreshTokenExpiration),
+		},
+	}, plain, nil
+}
+
+// Rotate marks the token as used and issues the next one of the family.
+// A token used twice means it leaked, so the whole session is revoked.
+func (s *UserSession) Rotate(token *RefreshToken) (*RefreshToken, string, error) {
+	if token.SessionID != s.ID {
+		return nil, "", ErrRefreshTokenInvalid
+	}
+
+	if !s.IsActive() {
+		return nil, "", ErrRefreshTokenNotActive
+	}
+
+	if token.UsedAt != nil {
+		s.Revoke(RevokedByReuse)
+		return nil, "", ErrRefreshTokenReused
+	}
+
+	if token.IsExpired() {
+		return nil, "", ErrRefreshTokenExpired
+	}
+
+	next, plain, err := s.NewRefreshToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	token.UsedAt = &time.Time{}
+	*token.UsedAt = time.Now()
+	token.ReplacedByID = &next.ID
+
+	return next, plain, nil
+}
+
+func (t *RefreshToken) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
+func HashRefreshToken(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,71 @@
+package companyentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserSessionRotate(t *testing.T) {
+	session := NewUserSession(uuid.New(), "test", "127.0.0.1")
+
+	first, plain, err := session.NewRefreshToken()
+	assert.Nil(t, err)
+	assert.Equal(t, HashRefreshToken(plain), first.TokenHash)
+	assert.NotEqual(t, plain, first.TokenHash)
+
+	second, plainSecond, err := session.Rotate(first)
+	assert.Nil(t, err)
+	assert.NotEqual(t, plain, plainSecond)
+	assert.NotNil(t, first.UsedAt)
+	assert.Equal(t, second.ID, *first.ReplacedByID)
+
+	// Reusing a rotated token revokes the whole family
+	_, _, err = session.Rotate(first)
+	assert.Equal(t, ErrRefreshTokenReused, err)
+	assert.False(t, session.IsActive())
+	assert.Equal(t, RevokedByReuse, session.RevokedReason)
+
+	_, _, err = session.Rotate(second)
+	assert.Equal(t, ErrRefreshTokenNotActive, err)
+}
+
+func TestUserSessionRotateExpired(t *testing.T) {
+	session := NewUserSession(uuid.New(), "test", "127.0.0.1")
+
+	token, _, err := session.NewRefreshToken()
+	assert.Nil(t, err)
+
+	token.ExpiresAt = time.Now().Add(-time.Minute)
+	_, _, err = session.Rotate(token)
+	assert.Equal(t, ErrRefreshTokenExpired, err)
+
+	other := NewUserSession(uuid.New(), "test", "127.0.0.1")
+	_, _, err = other.Rotate(token)
+	assert.Equal(t, ErrRefreshTokenInvalid, err)
+}
+
+func TestUserSessionTerminal(t *testing.T) {
+	session := NewUserSession(uuid.New(), "test", "127.0.0.1")
+	employeeID := uuid.New()
+
+	assert.Equal(t, ErrSessionNotTerminal, session.SwitchEmployee("company_a", employeeID))
+
+	session.BindTerminal("caixa 1", "company_a")
+	assert.Equal(t, ErrTerminalFromOther, session.SwitchEmployee("company_b", employeeID))
+	assert.Nil(t, session.SwitchEmployee("company_a", employeeID))
+
+	id, ok := session.ActingEmployeeFor("company_a")
+	assert.True(t, ok)
+	assert.Equal(t, employeeID, id)
+
+	// The acting employee never leaks to another company of the same user
+	_, ok = session.ActingEmployeeFor("company_b")
+	assert.False(t, ok)
+
+	session.ReleaseEmployee()
+	_, ok = session.ActingEmployeeFor("company_a")
+	assert.False(t, ok)
+}
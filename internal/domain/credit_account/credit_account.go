@@ -0,0 +1,234 @@
+package creditaccountentity
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrCreditLimitInvalid     = errors.New("credit limit must be positive")
+	ErrDueDayInvalid          = errors.New("due day must be between 1 and 28")
+	ErrAmountMustBePositive   = errors.New("amount must be positive")
+	ErrCreditAccountBlocked   = errors.New("credit account is blocked")
+	ErrCreditLimitExceeded    = errors.New("credit limit exceeded")
+	ErrOrderAlreadyCharged    = errors.New("order already charged to the credit account")
+	ErrSettlementAboveBalance = errors.New("settlement above the balance")
+	ErrNoChargeToReverseFound = errors.New("no charge to reverse for this order")
+)
+
+// CreditAccount lets the client pay later, the charges are due on the due day of the next month.
+type CreditAccount struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:credit_accounts"`
+	CreditAccountCommonAttributes
+	created map[uuid.UUID]bool
+	updated map[uuid.UUID]bool
+}
+
+type CreditAccountCommonAttributes struct {
+	ClientID    uuid.UUID            `bun:"column:client_id,type:uuid,unique,notnull" json:"client_id"`
+	Client      *cliententity.Client `bun:"rel:belongs-to" json:"client,omitempty"`
+	CreditLimit float64              `bun:"credit_limit,notnull" json:"credit_limit"`
+	Balance     float64              `bun:"balance,notnull" json:"balance"`
+	DueDay      int                  `bun:"due_day,notnull" json:"due_day"`
+	IsBlocked   bool                 `bun:"is_blocked" json:"is_blocked"`
+	Entries     []CreditEntry        `bun:"rel:has-many,join:id=account_id" json:"entries,omitempty"`
+}
+
+type PatchCreditAccount struct {
+	CreditLimit *float64 `json:"credit_limit"`
+	DueDay      *int     `json:"due_day"`
+	IsBlocked   *bool    `json:"is_blocked"`
+}
+
+func NewCreditAccount(clientID uuid.UUID, creditLimit float64, dueDay int) *CreditAccount {
+	return &CreditAccount{
+		Entity: entity.NewEntity(),
+		CreditAccountCommonAttributes: CreditAccountCommonAttributes{
+			ClientID:    clientID,
+			CreditLimit: creditLimit,
+			DueDay:      dueDay,
+		},
+	}
+}
+
+func (a *CreditAccount) Validate() error {
+	if a.CreditLimit <= 0 {
+		return ErrCreditLimitInvalid
+	}
+
+	if a.DueDay < 1 || a.DueDay > 28 {
+		return ErrDueDayInvalid
+	}
+
+	return nil
+}
+
+func (a *CreditAccount) AvailableCredit() float64 {
+	return math.Max(a.CreditLimit-a.Balance, 0)
+}
+
+// DueDate returns the due day of the month after the charge, in the location of the time given, so it
+// must be the time of the company.
+func (a *CreditAccount) DueDate(chargedAt time.Time) time.Time {
+	year, month, _ := chargedAt.Date()
+	return time.Date(year, month+1, a.DueDay, 23, 59, 59, 0, chargedAt.Location())
+}
+
+func (a *CreditAccount) Charge(orderID uuid.UUID, amount float64, now time.Time) (*CreditEntry, error) {
+	if amount <= 0 {
+		return nil, ErrAmountMustBePositive
+	}
+
+	if a.IsBlocked {
+		return nil, ErrCreditAccountBlocked
+	}
+
+	if amount > a.AvailableCredit() {
+		return nil, ErrCreditLimitExceeded
+	}
+
+	if charge := a.findCharge(orderID); charge != nil && charge.Remaining > 0 {
+		return nil, ErrOrderAlreadyCharged
+	}
+
+	dueAt := a.DueDate(now)
+	charge := newCreditEntry(a.ID, EntryTypeCharge, amount, now)
+	charge.OrderID = &orderID
+	charge.Remaining = amount
+	charge.DueAt = &dueAt
+
+	a.add(charge)
+	return charge, nil
+}
+
+// Settle pays the open charges, the ones due first are paid first.
+func (a *CreditAccount) Settle(amount float64, method orderentity.PayMethod, now time.Time) (*CreditEntry, error) {
+	if amount <= 0 {
+		return nil, ErrAmountMustBePositive
+	}
+
+	if amount > roundCents(a.Balance) {
+		return nil, ErrSettlementAboveBalance
+	}
+
+	a.consume(amount)
+
+	settlement := newCreditEntry(a.ID, EntryTypeSettlement, -amount, now)
+	settlement.Method = method
+
+	a.add(settlement)
+	return settlement, nil
+}
+
+// ReverseOrder removes the debt left by the charge of a canceled order.
+func (a *CreditAccount) ReverseOrder(orderID uuid.UUID, now time.Time) (*CreditEntry, error) {
+	charge := a.findCharge(orderID)
+
+	if charge == nil || charge.Remaining == 0 {
+		return nil, ErrNoChargeToReverseFound
+	}
+
+	reversal := newCreditEntry(a.ID, EntryTypeReversal, -charge.Remaining, now)
+	reversal.OrderID = &orderID
+	reversal.Description = "order canceled"
+
+	charge.Remaining = 0
+	a.markUpdated(charge.ID)
+	a.add(reversal)
+	return reversal, nil
+}
+
+func (a *CreditAccount) OverdueAmount(now time.Time) float64 {
+	overdue := 0.0
+
+	for _, e := range a.Entries {
+		if e.IsOverdue(now) {
+			overdue += e.Remaining
+		}
+	}
+
+	return roundCents(overdue)
+}
+
+func (a *CreditAccount) IsOverdue(now time.Time) bool {
+	return a.OverdueAmount(now) > 0
+}
+
+// Changes returns the entries to insert and to update since the account was loaded.
+func (a *CreditAccount) Changes() (created []CreditEntry, updated []CreditEntry) {
+	for _, e := range a.Entries {
+		if a.created[e.ID] {
+			created = append(created, e)
+		} else if a.updated[e.ID] {
+			updated = append(updated, e)
+		}
+	}
+
+	return created, updated
+}
+
+func (a *CreditAccount) consume(amount float64) {
+	charges := []*CreditEntry{}
+	for i := range a.Entries {
+		if a.Entries[i].Type == EntryTypeCharge && a.Entries[i].Remaining > 0 {
+			charges = append(charges, &a.Entries[i])
+		}
+	}
+
+	sort.SliceStable(charges, func(i, j int) bool {
+		return charges[i].DueAt.Before(*charges[j].DueAt)
+	})
+
+	for _, charge := range charges {
+		if amount <= 0 {
+			break
+		}
+
+		paid := math.Min(charge.Remaining, amount)
+		charge.Remaining = roundCents(charge.Remaining - paid)
+		amount = roundCents(amount - paid)
+		a.markUpdated(charge.ID)
+	}
+}
+
+func (a *CreditAccount) findCharge(orderID uuid.UUID) *CreditEntry {
+	for i := range a.Entries {
+		e := &a.Entries[i]
+		if e.Type == EntryTypeCharge && e.OrderID != nil && *e.OrderID == orderID {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func (a *CreditAccount) add(e *CreditEntry) {
+	if a.created == nil {
+		a.created = map[uuid.UUID]bool{}
+	}
+
+	a.created[e.ID] = true
+	a.Entries = append(a.Entries, *e)
+	a.Balance = roundCents(a.Balance + e.Amount)
+}
+
+func (a *CreditAccount) markUpdated(id uuid.UUID) {
+	if a.updated == nil {
+		a.updated = map[uuid.UUID]bool{}
+	}
+
+	a.updated[id] = true
+}
+
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
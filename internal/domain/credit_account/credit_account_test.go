@@ -0,0 +1,90 @@
+package creditaccountentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+func TestCreditAccountChargeSettleAndReverse(t *testing.T) {
+	account := NewCreditAccount(uuid.New(), 100, 10)
+	assert.Nil(t, account.Validate())
+
+	now := time.Date(2024, time.January, 20, 12, 0, 0, 0, time.UTC)
+	firstOrder, secondOrder := uuid.New(), uuid.New()
+
+	charge, err := account.Charge(firstOrder, 60, now)
+	assert.Nil(t, err)
+	assert.Equal(t, time.February, charge.DueAt.Month())
+	assert.Equal(t, 10, charge.DueAt.Day())
+
+	_, err = account.Charge(firstOrder, 10, now)
+	assert.Equal(t, ErrOrderAlreadyCharged, err)
+
+	_, err = account.Charge(secondOrder, 50, now)
+	assert.Equal(t, ErrCreditLimitExceeded, err)
+
+	_, err = account.Charge(secondOrder, 30, now.AddDate(0, 1, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, 90.0, account.Balance)
+
+	// The first charge is overdue after its due date
+	assert.Equal(t, 60.0, account.OverdueAmount(now.AddDate(0, 1, 0)))
+
+	_, err = account.Settle(100, orderentity.Dinheiro, now)
+	assert.Equal(t, ErrSettlementAboveBalance, err)
+
+	// Settles the charge due first
+	_, err = account.Settle(70, orderentity.Visa, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, account.Entries[0].Remaining)
+	assert.Equal(t, 20.0, account.Entries[1].Remaining)
+	assert.Equal(t, 20.0, account.Balance)
+
+	_, err = account.ReverseOrder(secondOrder, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, account.Balance)
+
+	_, err = account.ReverseOrder(secondOrder, now)
+	assert.Equal(t, ErrNoChargeToReverseFound, err)
+
+	created, _ := account.Changes()
+	assert.Len(t, created, 4)
+
+	account.IsBlocked = true
+	_, err = account.Charge(uuid.New(), 10, now)
+	assert.Equal(t, ErrCreditAccountBlocked, err)
+}
+
+func TestCreditAccountStatement(t *testing.T) {
+	account := NewCreditAccount(uuid.New(), 500, 5)
+	january := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	february := time.Date(2024, time.February, 15, 12, 0, 0, 0, time.UTC)
+
+	_, _ = account.Charge(uuid.New(), 100, january)
+	_, _ = account.Charge(uuid.New(), 50, february)
+	_, _ = account.Settle(80, orderentity.Dinheiro, february)
+
+	statement := account.Statement(time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 100.0, statement.OpeningBalance)
+	assert.Equal(t, 50.0, statement.TotalCharged)
+	assert.Equal(t, 80.0, statement.TotalSettled)
+	assert.Equal(t, 70.0, statement.ClosingBalance)
+	assert.Len(t, statement.Entries, 2)
+}
+
+func TestCreditAccountDueDateInCompanyTime(t *testing.T) {
+	account := NewCreditAccount(uuid.New(), 100, 10)
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	assert.Nil(t, err)
+
+	// Still january in the company, already february in UTC
+	chargedAt := time.Date(2024, time.January, 31, 23, 30, 0, 0, loc)
+
+	dueAt := account.DueDate(chargedAt)
+	assert.Equal(t, time.Date(2024, time.February, 10, 23, 59, 59, 0, loc), dueAt)
+	assert.Equal(t, time.March, account.DueDate(chargedAt.UTC()).Month())
+}
@@ -0,0 +1,47 @@
+package creditaccountentity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+// CreditEntry is a movement of the account, charges are positive and keep the remaining
+// amount to be paid, settlements and reversals are negative.
+type CreditEntry struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:credit_entries"`
+	CreditEntryCommonAttributes
+}
+
+type CreditEntryCommonAttributes struct {
+	AccountID   uuid.UUID             `bun:"column:account_id,type:uuid,notnull" json:"account_id"`
+	Type        EntryType             `bun:"type,notnull" json:"type"`
+	Amount      float64               `bun:"amount,notnull" json:"amount"`
+	Remaining   float64               `bun:"remaining" json:"remaining,omitempty"`
+	OrderID     *uuid.UUID            `bun:"column:order_id,type:uuid" json:"order_id,omitempty"`
+	Method      orderentity.PayMethod `bun:"method" json:"method,omitempty"`
+	DueAt       *time.Time            `bun:"due_at" json:"due_at,omitempty"`
+	Description string                `bun:"description" json:"description,omitempty"`
+}
+
+func newCreditEntry(accountID uuid.UUID, entryType EntryType, amount float64, now time.Time) *CreditEntry {
+	e := entity.NewEntity()
+	e.CreatedAt = now
+
+	return &CreditEntry{
+		Entity: e,
+		CreditEntryCommonAttributes: CreditEntryCommonAttributes{
+			AccountID: accountID,
+			Type:      entryType,
+			Amount:    amount,
+		},
+	}
+}
+
+func (e *CreditEntry) IsOverdue(now time.Time) bool {
+	return e.Type == EntryTypeCharge && e.Remaining > 0 && e.DueAt != nil && e.DueAt.Before(now)
+}
@@ -0,0 +1,17 @@
+package creditaccountentity
+
+type EntryType string
+
+const (
+	EntryTypeCharge     EntryType = "Charge"
+	EntryTypeSettlement EntryType = "Settlement"
+	EntryTypeReversal   EntryType = "Reversal"
+)
+
+func GetAllEntryTypes() []EntryType {
+	return []EntryType{
+		EntryTypeCharge,
+		EntryTypeSettlement,
+		EntryTypeReversal,
+	}
+}
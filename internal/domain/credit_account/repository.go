@@ -0,0 +1,18 @@
+package creditaccountentity
+
+import (
+	"context"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type Repository interface {
+	CreateCreditAccount(ctx context.Context, account *CreditAccount) error
+	UpdateCreditAccount(ctx context.Context, account *CreditAccount) error
+	GetCreditAccountById(ctx context.Context, id string) (*CreditAccount, error)
+	GetCreditAccountByClientId(ctx context.Context, clientID string) (*CreditAccount, error)
+	GetCreditAccountByOrderId(ctx context.Context, orderID string) (*CreditAccount, error)
+	GetAllCreditAccounts(ctx context.Context) ([]CreditAccount, error)
+	SaveCreditEntries(ctx context.Context, account *CreditAccount, created []CreditEntry, updated []CreditEntry) error
+	ChargeOrder(ctx context.Context, account *CreditAccount, charge *CreditEntry, order *orderentity.Order, payment *orderentity.PaymentOrder) error
+}
@@ -0,0 +1,54 @@
+package creditaccountentity
+
+import (
+	"time"
+)
+
+type Statement struct {
+	StartAt        time.Time     `json:"start_at"`
+	EndAt          time.Time     `json:"end_at"`
+	OpeningBalance float64       `json:"opening_balance"`
+	TotalCharged   float64       `json:"total_charged"`
+	TotalSettled   float64       `json:"total_settled"`
+	TotalReversed  float64       `json:"total_reversed"`
+	ClosingBalance float64       `json:"closing_balance"`
+	Entries        []CreditEntry `json:"entries"`
+}
+
+// Statement lists the entries of the period, the opening balance sums everything before it.
+func (a *CreditAccount) Statement(startAt time.Time, endAt time.Time) *Statement {
+	statement := &Statement{
+		StartAt: startAt,
+		EndAt:   endAt,
+		Entries: []CreditEntry{},
+	}
+
+	for _, e := range a.Entries {
+		if e.CreatedAt.Before(startAt) {
+			statement.OpeningBalance += e.Amount
+			continue
+		}
+
+		if e.CreatedAt.After(endAt) {
+			continue
+		}
+
+		switch e.Type {
+		case EntryTypeCharge:
+			statement.TotalCharged += e.Amount
+		case EntryTypeSettlement:
+			statement.TotalSettled -= e.Amount
+		case EntryTypeReversal:
+			statement.TotalReversed -= e.Amount
+		}
+
+		statement.Entries = append(statement.Entries, e)
+	}
+
+	statement.OpeningBalance = roundCents(statement.OpeningBalance)
+	statement.TotalCharged = roundCents(statement.TotalCharged)
+	statement.TotalSettled = roundCents(statement.TotalSettled)
+	statement.TotalReversed = roundCents(statement.TotalReversed)
+	statement.ClosingBalance = roundCents(statement.OpeningBalance + statement.TotalCharged - statement.TotalSettled - statement.TotalReversed)
+	return statement
+}
@@ -0,0 +1,204 @@
+package deliveryzoneentity
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/uptrace/bun"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrAddressOutOfDeliveryZone = errors.New("address is outside of any delivery zone")
+	ErrZoneTypeInvalid          = errors.New("delivery zone type is invalid")
+	ErrNeighborhoodsRequired    = errors.New("neighborhoods are required")
+	ErrCepStartRequired         = errors.New("cep start is required")
+	ErrRadiusMustBePositive     = errors.New("radius must be positive")
+	ErrFeeMustBePositive        = errors.New("fee must be positive")
+)
+
+const earthRadiusKm = 6371.0
+
+var nonDigitsRegex = regexp.MustCompile("[^0-9]")
+
+type DeliveryZone struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:delivery_zones"`
+	DeliveryZoneCommonAttributes
+}
+
+type DeliveryZoneCommonAttributes struct {
+	Name          string   `bun:"name,notnull" json:"name"`
+	Type          ZoneType `bun:"type,notnull" json:"type"`
+	Neighborhoods []string `bun:"neighborhoods,type:jsonb" json:"neighborhoods,omitempty"`
+	CepStart      string   `bun:"cep_start" json:"cep_start,omitempty"`
+	CepEnd        string   `bun:"cep_end" json:"cep_end,omitempty"`
+	RadiusKm      float64  `bun:"radius_km" json:"radius_km,omitempty"`
+	Fee           float64  `bun:"fee,notnull" json:"fee"`
+	MinOrderValue float64  `bun:"min_order_value" json:"min_order_value"`
+	EtaMinutes    int      `bun:"eta_minutes" json:"eta_minutes"`
+	IsActive      bool     `bun:"is_active" json:"is_active"`
+}
+
+type PatchDeliveryZone struct {
+	Name          *string   `json:"name"`
+	Type          *ZoneType `json:"type"`
+	Neighborhoods []string  `json:"neighborhoods"`
+	CepStart      *string   `json:"cep_start"`
+	CepEnd        *string   `json:"cep_end"`
+	RadiusKm      *float64  `json:"radius_km"`
+	Fee           *float64  `json:"fee"`
+	MinOrderValue *float64  `json:"min_order_value"`
+	EtaMinutes    *int      `json:"eta_minutes"`
+	IsActive      *bool     `json:"is_active"`
+}
+
+func NewDeliveryZone(deliveryZoneCommonAttributes DeliveryZoneCommonAttributes) (*DeliveryZone, error) {
+	zone := &DeliveryZone{
+		Entity:                       entity.NewEntity(),
+		DeliveryZoneCommonAttributes: deliveryZoneCommonAttributes,
+	}
+
+	if err := zone.Validate(); err != nil {
+		return nil, err
+	}
+
+	return zone, nil
+}
+
+func (z *DeliveryZone) Validate() error {
+	if z.Fee < 0 {
+		return ErrFeeMustBePositive
+	}
+
+	switch z.Type {
+	case ZoneTypeNeighborhood:
+		if len(z.Neighborhoods) == 0 {
+			return ErrNeighborhoodsRequired
+		}
+	case ZoneTypeCep:
+		if OnlyDigits(z.CepStart) == "" {
+			return ErrCepStartRequired
+		}
+	case ZoneTypeRadius:
+		if z.RadiusKm <= 0 {
+			return ErrRadiusMustBePositive
+		}
+	default:
+		return ErrZoneTypeInvalid
+	}
+
+	return nil
+}
+
+// Matches checks if the address is covered by the zone, store is used as center for radius zones.
+func (z *DeliveryZone) Matches(address *addressentity.Address, store *addressentity.Address) bool {
+	if !z.IsActive || address == nil {
+		return false
+	}
+
+	switch z.Type {
+	case ZoneTypeNeighborhood:
+		neighborhood := normalizeName(address.Neighborhood)
+		for _, n := range z.Neighborhoods {
+			if normalizeName(n) == neighborhood {
+				return true
+			}
+		}
+	case ZoneTypeCep:
+		return z.matchesCep(OnlyDigits(address.Cep))
+	case ZoneTypeRadius:
+		if store == nil || !store.HasCoordinates() || !address.HasCoordinates() {
+			return false
+		}
+
+		return DistanceKm(*store.Latitude, *store.Longitude, *address.Latitude, *address.Longitude) <= z.RadiusKm
+	}
+
+	return false
+}
+
+func (z *DeliveryZone) matchesCep(cep string) bool {
+	if cep == "" {
+		return false
+	}
+
+	start := OnlyDigits(z.CepStart)
+	end := OnlyDigits(z.CepEnd)
+
+	// Without end the start works as prefix
+	if end == "" {
+		return strings.HasPrefix(cep, start)
+	}
+
+	return padCep(start, "0") <= cep && cep <= padCep(end, "9")
+}
+
+// FindDeliveryZone returns the most specific zone covering the address: neighborhood, then cep, then the smallest radius.
+func FindDeliveryZone(zones []DeliveryZone, address *addressentity.Address, store *addressentity.Address) (*DeliveryZone, error) {
+	var found *DeliveryZone
+
+	for i := range zones {
+		zone := &zones[i]
+
+		if !zone.Matches(address, store) {
+			continue
+		}
+
+		if found == nil || zone.isMoreSpecificThan(found) {
+			found = zone
+		}
+	}
+
+	if found == nil {
+		return nil, ErrAddressOutOfDeliveryZone
+	}
+
+	return found, nil
+}
+
+func (z *DeliveryZone) isMoreSpecificThan(other *DeliveryZone) bool {
+	if z.Type.priority() != other.Type.priority() {
+		return z.Type.priority() < other.Type.priority()
+	}
+
+	if z.Type == ZoneTypeRadius {
+		return z.RadiusKm < other.RadiusKm
+	}
+
+	return false
+}
+
+// DistanceKm calculates the haversine distance between two coordinates.
+func DistanceKm(lat1, lon1, lat2, lon2 float64) float64 {
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return earthRadiusKm * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+func OnlyDigits(text string) string {
+	return nonDigitsRegex.ReplaceAllString(text, "")
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func padCep(cep string, fill string) string {
+	for len(cep) < 8 {
+		cep += fill
+	}
+
+	return cep
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
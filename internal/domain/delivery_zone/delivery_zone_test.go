@@ -0,0 +1,60 @@
+package deliveryzoneentity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+)
+
+func newAddress(neighborhood string, cep string, lat *float64, lng *float64) *addressentity.Address {
+	return &addressentity.Address{
+		AddressCommonAttributes: addressentity.AddressCommonAttributes{
+			Neighborhood: neighborhood,
+			Cep:          cep,
+			Latitude:     lat,
+			Longitude:    lng,
+		},
+	}
+}
+
+func float(f float64) *float64 {
+	return &f
+}
+
+func TestFindDeliveryZone(t *testing.T) {
+	neighborhood, err := NewDeliveryZone(DeliveryZoneCommonAttributes{Name: "Centro", Type: ZoneTypeNeighborhood, Neighborhoods: []string{"Centro"}, Fee: 5, IsActive: true})
+	assert.Nil(t, err)
+
+	cep, err := NewDeliveryZone(DeliveryZoneCommonAttributes{Name: "Zona Sul", Type: ZoneTypeCep, CepStart: "04000-000", CepEnd: "04999-999", Fee: 8, IsActive: true})
+	assert.Nil(t, err)
+
+	radius, err := NewDeliveryZone(DeliveryZoneCommonAttributes{Name: "3km", Type: ZoneTypeRadius, RadiusKm: 3, Fee: 10, IsActive: true})
+	assert.Nil(t, err)
+
+	zones := []DeliveryZone{*radius, *cep, *neighborhood}
+	store := newAddress("Centro", "01001-000", float(-23.5505), float(-46.6333))
+
+	zone, err := FindDeliveryZone(zones, newAddress("  centro ", "04100-000", nil, nil), store)
+	assert.Nil(t, err)
+	assert.Equal(t, neighborhood.ID, zone.ID)
+
+	zone, err = FindDeliveryZone(zones, newAddress("Vila Mariana", "04100-000", nil, nil), store)
+	assert.Nil(t, err)
+	assert.Equal(t, cep.ID, zone.ID)
+
+	zone, err = FindDeliveryZone(zones, newAddress("Liberdade", "01500-000", float(-23.5587), float(-46.6350)), store)
+	assert.Nil(t, err)
+	assert.Equal(t, radius.ID, zone.ID)
+
+	_, err = FindDeliveryZone(zones, newAddress("Santo Amaro", "06000-000", float(-23.6500), float(-46.7000)), store)
+	assert.Equal(t, ErrAddressOutOfDeliveryZone, err)
+}
+
+func TestCepPrefix(t *testing.T) {
+	zone := &DeliveryZone{DeliveryZoneCommonAttributes: DeliveryZoneCommonAttributes{Type: ZoneTypeCep, CepStart: "013", IsActive: true}}
+
+	assert.True(t, zone.Matches(newAddress("", "01310-100", nil, nil), nil))
+	assert.False(t, zone.Matches(newAddress("", "01410-100", nil, nil), nil))
+	assert.False(t, zone.Matches(newAddress("", "", nil, nil), nil))
+}
@@ -0,0 +1,28 @@
+package deliveryzoneentity
+
+type ZoneType string
+
+const (
+	ZoneTypeNeighborhood ZoneType = "Neighborhood"
+	ZoneTypeCep          ZoneType = "Cep"
+	ZoneTypeRadius       ZoneType = "Radius"
+)
+
+func GetAllZoneTypes() []ZoneType {
+	return []ZoneType{
+		ZoneTypeNeighborhood,
+		ZoneTypeCep,
+		ZoneTypeRadius,
+	}
+}
+
+func (t ZoneType) priority() int {
+	switch t {
+	case ZoneTypeNeighborhood:
+		return 0
+	case ZoneTypeCep:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,111 @@
+package driversettlemententity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrSettlementAlreadyClosed = errors.New("settlement already closed")
+	ErrSettlementWithoutItems  = errors.New("settlement must have at least one delivery")
+	ErrDeliveryAlreadySettled  = errors.New("delivery already in another settlement")
+)
+
+type DriverSettlement struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:driver_settlements"`
+	SettlementTimeLogs
+	DriverSettlementCommonAttributes
+}
+
+type DriverSettlementCommonAttributes struct {
+	Status           StatusSettlement           `bun:"status,notnull" json:"status"`
+	DriverID         uuid.UUID                  `bun:"column:driver_id,type:uuid,notnull" json:"driver_id"`
+	Driver           *employeeentity.Employee   `bun:"rel:belongs-to" json:"driver,omitempty"`
+	ShiftID          *uuid.UUID                 `bun:"column:shift_id,type:uuid" json:"shift_id,omitempty"`
+	StartAt          time.Time                  `bun:"start_at,notnull" json:"start_at"`
+	EndAt            time.Time                  `bun:"end_at,notnull" json:"end_at"`
+	Rule             PayoutRuleCommonAttributes `bun:"rule,type:jsonb" json:"rule"`
+	Deliveries       []SettlementDelivery       `bun:"deliveries,type:jsonb" json:"deliveries"`
+	TotalDeliveries  int                        `bun:"total_deliveries" json:"total_deliveries"`
+	TotalDeliveryTax float64                    `bun:"total_delivery_tax" json:"total_delivery_tax"`
+	TotalPayout      float64                    `bun:"total_payout" json:"total_payout"`
+	CashCollected    float64                    `bun:"cash_collected" json:"cash_collected"`
+	CashReturned     *float64                   `bun:"cash_returned" json:"cash_returned,omitempty"`
+	CashDifference   float64                    `bun:"cash_difference" json:"cash_difference"`
+	Observation      string                     `bun:"observation" json:"observation,omitempty"`
+	ClosedByID       *uuid.UUID                 `bun:"column:closed_by_id,type:uuid" json:"closed_by_id,omitempty"`
+}
+
+// SettlementDelivery is a snapshot of a delivery at the moment of the settlement.
+type SettlementDelivery struct {
+	DeliveryID     uuid.UUID  `json:"delivery_id"`
+	OrderID        uuid.UUID  `json:"order_id"`
+	DeliveryZoneID *uuid.UUID `json:"delivery_zone_id,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	DeliveryTax    float64    `json:"delivery_tax"`
+	Payout         float64    `json:"payout"`
+	CashCollected  float64    `json:"cash_collected"`
+}
+
+type SettlementTimeLogs struct {
+	ClosedAt *time.Time `bun:"closed_at" json:"closed_at,omitempty"`
+}
+
+func NewDriverSettlement(driverID uuid.UUID, shiftID *uuid.UUID, startAt time.Time, endAt time.Time, rule *PayoutRule) *DriverSettlement {
+	return &DriverSettlement{
+		Entity: entity.NewEntity(),
+		DriverSettlementCommonAttributes: DriverSettlementCommonAttributes{
+			Status:     SettlementStatusOpen,
+			DriverID:   driverID,
+			ShiftID:    shiftID,
+			StartAt:    startAt,
+			EndAt:      endAt,
+			Rule:       rule.PayoutRuleCommonAttributes,
+			Deliveries: []SettlementDelivery{},
+		},
+	}
+}
+
+func (s *DriverSettlement) AddDelivery(delivery *orderentity.DeliveryOrder, cashCollected float64) {
+	item := SettlementDelivery{
+		DeliveryID:     delivery.ID,
+		OrderID:        delivery.OrderID,
+		DeliveryZoneID: delivery.DeliveryZoneID,
+		DeliveredAt:    delivery.DeliveredAt,
+		Payout:         s.Rule.Payout(delivery),
+		CashCollected:  cashCollected,
+	}
+
+	if delivery.DeliveryTax != nil {
+		item.DeliveryTax = *delivery.DeliveryTax
+	}
+
+	s.Deliveries = append(s.Deliveries, item)
+	s.TotalDeliveries++
+	s.TotalDeliveryTax += item.DeliveryTax
+	s.TotalPayout += item.Payout
+	s.CashCollected += item.CashCollected
+}
+
+// Close signs off the settlement with the cash returned by the driver.
+func (s *DriverSettlement) Close(closedByID uuid.UUID, cashReturned float64, observation string) error {
+	if s.Status == SettlementStatusClosed {
+		return ErrSettlementAlreadyClosed
+	}
+
+	s.Status = SettlementStatusClosed
+	s.ClosedByID = &closedByID
+	s.CashReturned = &cashReturned
+	s.CashDifference = cashReturned - s.CashCollected
+	s.Observation = observation
+	s.ClosedAt = &time.Time{}
+	*s.ClosedAt = time.Now()
+	return nil
+}
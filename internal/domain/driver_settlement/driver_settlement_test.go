@@ -0,0 +1,70 @@
+package driversettlemententity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+func newDelivery(tax float64, zoneID *uuid.UUID) *orderentity.DeliveryOrder {
+	return &orderentity.DeliveryOrder{
+		Entity: entity.NewEntity(),
+		DeliveryOrderCommonAttributes: orderentity.DeliveryOrderCommonAttributes{
+			DeliveryTax:    &tax,
+			DeliveryZoneID: zoneID,
+		},
+	}
+}
+
+func TestPayoutRules(t *testing.T) {
+	zoneID := uuid.New()
+
+	fixed, err := NewPayoutRule(PayoutRuleCommonAttributes{Type: PayoutTypeFixed, Amount: 4})
+	assert.Nil(t, err)
+	assert.Equal(t, 4.0, fixed.Payout(newDelivery(10, nil)))
+
+	percentage, err := NewPayoutRule(PayoutRuleCommonAttributes{Type: PayoutTypePercentage, Amount: 50})
+	assert.Nil(t, err)
+	assert.Equal(t, 5.0, percentage.Payout(newDelivery(10, nil)))
+
+	perZone, err := NewPayoutRule(PayoutRuleCommonAttributes{Type: PayoutTypePerZone, Amount: 3, ZoneAmounts: map[string]float64{zoneID.String(): 7}})
+	assert.Nil(t, err)
+	assert.Equal(t, 7.0, perZone.Payout(newDelivery(10, &zoneID)))
+	assert.Equal(t, 3.0, perZone.Payout(newDelivery(10, nil)))
+
+	_, err = NewPayoutRule(PayoutRuleCommonAttributes{Type: PayoutTypePercentage, Amount: 120})
+	assert.Equal(t, ErrPercentageAbove100, err)
+}
+
+func TestDriverSettlement(t *testing.T) {
+	driverID := uuid.New()
+	otherDriverID := uuid.New()
+
+	defaultRule, _ := NewPayoutRule(PayoutRuleCommonAttributes{Type: PayoutTypeFixed, Amount: 4})
+	driverRule, _ := NewPayoutRule(PayoutRuleCommonAttributes{DriverID: &driverID, Type: PayoutTypeFixed, Amount: 6})
+
+	rule, err := FindPayoutRule([]PayoutRule{*defaultRule, *driverRule}, driverID)
+	assert.Nil(t, err)
+	assert.Equal(t, driverRule.ID, rule.ID)
+
+	rule, err = FindPayoutRule([]PayoutRule{*defaultRule, *driverRule}, otherDriverID)
+	assert.Nil(t, err)
+	assert.Equal(t, defaultRule.ID, rule.ID)
+
+	settlement := NewDriverSettlement(driverID, nil, time.Now().Add(-time.Hour), time.Now(), driverRule)
+	settlement.AddDelivery(newDelivery(8, nil), 50)
+	settlement.AddDelivery(newDelivery(10, nil), 0)
+
+	assert.Equal(t, 2, settlement.TotalDeliveries)
+	assert.Equal(t, 18.0, settlement.TotalDeliveryTax)
+	assert.Equal(t, 12.0, settlement.TotalPayout)
+	assert.Equal(t, 50.0, settlement.CashCollected)
+
+	assert.Nil(t, settlement.Close(uuid.New(), 45, "missing change"))
+	assert.Equal(t, -5.0, settlement.CashDifference)
+	assert.Equal(t, ErrSettlementAlreadyClosed, settlement.Close(uuid.New(), 50, ""))
+}
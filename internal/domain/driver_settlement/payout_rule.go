@@ -0,0 +1,119 @@
+package driversettlemententity
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrPayoutTypeInvalid        = errors.New("payout type is invalid")
+	ErrPayoutAmountMustPositive = errors.New("payout amount must be positive")
+	ErrPercentageAbove100       = errors.New("payout percentage must be up to 100")
+	ErrPayoutRuleNotFound       = errors.New("payout rule not found")
+)
+
+// PayoutRule defines how much a driver earns per delivery, a rule without driver is the default of the company.
+type PayoutRule struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:driver_payout_rules"`
+	PayoutRuleCommonAttributes
+}
+
+type PayoutRuleCommonAttributes struct {
+	DriverID    *uuid.UUID         `bun:"column:driver_id,type:uuid" json:"driver_id,omitempty"`
+	Type        PayoutType         `bun:"type,notnull" json:"type"`
+	Amount      float64            `bun:"amount" json:"amount"`
+	ZoneAmounts map[string]float64 `bun:"zone_amounts,type:jsonb" json:"zone_amounts,omitempty"`
+}
+
+type PatchPayoutRule struct {
+	Type        *PayoutType        `json:"type"`
+	Amount      *float64           `json:"amount"`
+	ZoneAmounts map[string]float64 `json:"zone_amounts"`
+}
+
+func NewPayoutRule(payoutRuleCommonAttributes PayoutRuleCommonAttributes) (*PayoutRule, error) {
+	rule := &PayoutRule{
+		Entity:                     entity.NewEntity(),
+		PayoutRuleCommonAttributes: payoutRuleCommonAttributes,
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (p *PayoutRule) Validate() error {
+	if p.Amount < 0 {
+		return ErrPayoutAmountMustPositive
+	}
+
+	switch p.Type {
+	case PayoutTypeFixed, PayoutTypePerZone:
+	case PayoutTypePercentage:
+		if p.Amount > 100 {
+			return ErrPercentageAbove100
+		}
+	default:
+		return ErrPayoutTypeInvalid
+	}
+
+	for _, amount := range p.ZoneAmounts {
+		if amount < 0 {
+			return ErrPayoutAmountMustPositive
+		}
+	}
+
+	return nil
+}
+
+// Payout returns the value owed to the driver for the delivery,
+// per zone rules fall back to the amount when the zone has no value.
+func (p *PayoutRuleCommonAttributes) Payout(delivery *orderentity.DeliveryOrder) float64 {
+	switch p.Type {
+	case PayoutTypePercentage:
+		if delivery.DeliveryTax == nil {
+			return 0
+		}
+
+		return *delivery.DeliveryTax * p.Amount / 100
+	case PayoutTypePerZone:
+		if delivery.DeliveryZoneID != nil {
+			if amount, ok := p.ZoneAmounts[delivery.DeliveryZoneID.String()]; ok {
+				return amount
+			}
+		}
+
+		return p.Amount
+	default:
+		return p.Amount
+	}
+}
+
+// FindPayoutRule returns the rule of the driver or the default rule of the company.
+func FindPayoutRule(rules []PayoutRule, driverID uuid.UUID) (*PayoutRule, error) {
+	var defaultRule *PayoutRule
+
+	for i := range rules {
+		if rules[i].DriverID == nil {
+			defaultRule = &rules[i]
+			continue
+		}
+
+		if *rules[i].DriverID == driverID {
+			return &rules[i], nil
+		}
+	}
+
+	if defaultRule == nil {
+		return nil, ErrPayoutRuleNotFound
+	}
+
+	return defaultRule, nil
+}
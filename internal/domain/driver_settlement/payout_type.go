@@ -0,0 +1,17 @@
+package driversettlemententity
+
+type PayoutType string
+
+const (
+	PayoutTypeFixed      PayoutType = "Fixed"
+	PayoutTypePercentage PayoutType = "Percentage"
+	PayoutTypePerZone    PayoutType = "PerZone"
+)
+
+func GetAllPayoutTypes() []PayoutType {
+	return []PayoutType{
+		PayoutTypeFixed,
+		PayoutTypePercentage,
+		PayoutTypePerZone,
+	}
+}
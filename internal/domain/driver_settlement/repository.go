@@ -0,0 +1,19 @@
+package driversettlemententity
+
+import "context"
+
+type PayoutRuleRepository interface {
+	CreatePayoutRule(ctx context.Context, rule *PayoutRule) error
+	UpdatePayoutRule(ctx context.Context, rule *PayoutRule) error
+	DeletePayoutRule(ctx context.Context, id string) error
+	GetPayoutRuleById(ctx context.Context, id string) (*PayoutRule, error)
+	GetAllPayoutRules(ctx context.Context) ([]PayoutRule, error)
+}
+
+type DriverSettlementRepository interface {
+	CreateDriverSettlement(ctx context.Context, settlement *DriverSettlement) error
+	UpdateDriverSettlement(ctx context.Context, settlement *DriverSettlement) error
+	GetDriverSettlementById(ctx context.Context, id string) (*DriverSettlement, error)
+	GetAllDriverSettlements(ctx context.Context) ([]DriverSettlement, error)
+	GetDriverSettlementsByDriverId(ctx context.Context, driverID string) ([]DriverSettlement, error)
+}
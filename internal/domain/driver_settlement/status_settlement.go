@@ -0,0 +1,15 @@
+package driversettlemententity
+
+type StatusSettlement string
+
+const (
+	SettlementStatusOpen   StatusSettlement = "Open"
+	SettlementStatusClosed StatusSettlement = "Closed"
+)
+
+func GetAllSettlementStatus() []StatusSettlement {
+	return []StatusSettlement{
+		SettlementStatusOpen,
+		SettlementStatusClosed,
+	}
+}
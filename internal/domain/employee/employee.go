@@ -12,4 +12,6 @@ type Employee struct {
 	personentity.Person
 	UserID *uuid.UUID          `bun:"column:user_id,type:uuid" json:"user_id,omitempty"`
 	User   *companyentity.User `bun:"rel:belongs-to" json:"category,omitempty"`
+	// PinHash is the bcrypt of the pin used to switch employees on a shared terminal
+	PinHash string `bun:"column:pin_hash" json:"-"`
 }
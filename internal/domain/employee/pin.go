@@ -0,0 +1,37 @@
+package employeeentity
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPinInvalid         = errors.New("pin must have 4 to 6 digits")
+	ErrPinNotSet          = errors.New("employee has no pin")
+	ErrPinWrong           = errors.New("pin wrong")
+	ErrActingEmployeeNone = errors.New("no acting employee on this terminal")
+	ErrPinNotAllowed      = errors.New("only managers or the employee can change the pin")
+	ErrTerminalNotAllowed = errors.New("only managers can bind a terminal")
+)
+
+var pinRegex = regexp.MustCompile(`^[0-9]{4,6}$`)
+
+// EmployeeValue is the context key of the acting employee, set by the terminal sessions.
+type EmployeeValue string
+
+func IsValidPin(pin string) bool {
+	return pinRegex.MatchString(pin)
+}
+
+func (e *Employee) HasPin() bool {
+	return e.PinHash != ""
+}
+
+// GetActingEmployeeID returns the employee switched by pin on the terminal of the request.
+func GetActingEmployeeID(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(EmployeeValue("employee")).(uuid.UUID)
+	return id, ok && id != uuid.Nil
+}
@@ -5,7 +5,9 @@ import "context"
 type Repository interface {
 	RegisterEmployee(ctx context.Context, p *Employee) error
 	UpdateEmployee(ctx context.Context, p *Employee) error
+	UpdateEmployeePin(ctx context.Context, p *Employee) error
 	DeleteEmployee(ctx context.Context, id string) error
 	GetEmployeeById(ctx context.Context, id string) (*Employee, error)
+	GetEmployeeByUserId(ctx context.Context, userID string) (*Employee, error)
 	GetAllEmployees(ctx context.Context) ([]Employee, error)
 }
@@ -115,17 +115,18 @@ func (i *GroupItem) CancelGroupItem() {
 	i.CanceledAt = &time.Time{}
 	*i.CanceledAt = time.Now()
 
-	i.ComplementItem.CancelItem()
+	if i.ComplementItem != nil {
+		i.ComplementItem.CancelItem()
+	}
 }
 
 func (i *GroupItem) CalculateTotalPrice() {
 	qtdItems := 0.0
 	totalPrice := 0.0
 
-	for _, item := range i.Items {
-		totalPrice = item.CalculateTotalPrice()
-		qtdItems += item.Quantity
-		totalPrice += item.Price
+	for index := range i.Items {
+		totalPrice += i.Items[index].CalculateTotalPrice()
+		qtdItems += i.Items[index].Quantity
 	}
 
 	if i.ComplementItem != nil {
@@ -143,3 +144,21 @@ func (i *GroupItem) CanAddItems() bool {
 
 	return true
 }
+
+// CopyToOrder creates an empty group in another order with the same details, the complement stays in this group.
+func (i *GroupItem) CopyToOrder(orderID uuid.UUID) *GroupItem {
+	groupCommonAttributes := GroupCommonAttributes{
+		GroupDetails:      i.GroupDetails,
+		GroupItemTimeLogs: i.GroupItemTimeLogs,
+		Items:             []itementity.Item{},
+		OrderID:           orderID,
+	}
+
+	groupCommonAttributes.ComplementItemID = nil
+	groupCommonAttributes.ComplementItem = nil
+
+	return &GroupItem{
+		Entity:                entity.NewEntity(),
+		GroupCommonAttributes: groupCommonAttributes,
+	}
+}
@@ -0,0 +1,31 @@
+package groupitementity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+)
+
+func TestCalculateTotalPrice(t *testing.T) {
+	additional := itementity.NewItem(itementity.ItemCommonAttributes{Name: "Bacon", Price: 3, Quantity: 1})
+
+	pizza := itementity.NewItem(itementity.ItemCommonAttributes{Name: "Pizza", Price: 40, Quantity: 1})
+	pizza.AdditionalItems = []itementity.Item{*additional}
+
+	soda := itementity.NewItem(itementity.ItemCommonAttributes{Name: "Soda", Price: 10, Quantity: 2})
+	complement := itementity.NewItem(itementity.ItemCommonAttributes{Name: "Border", Price: 5, Quantity: 1})
+
+	group := NewGroupItem(GroupCommonAttributes{Items: []itementity.Item{*pizza, *soda}})
+	group.ComplementItem = complement
+
+	// The price of each item is counted once, with its additionals and the complement of the group
+	group.CalculateTotalPrice()
+	assert.Equal(t, 58.0, group.Total)
+	assert.Equal(t, 3.0, group.Quantity)
+	assert.Equal(t, 43.0, group.Items[0].TotalPrice)
+
+	// Calculating again gives the same total
+	group.CalculateTotalPrice()
+	assert.Equal(t, 58.0, group.Total)
+}
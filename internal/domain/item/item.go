@@ -105,12 +105,14 @@ func (i *Item) CanAddAdditionalItems() bool {
 	return true
 }
 
+// CalculateTotalPrice sums the price of the item, already multiplied by the quantity, and its additionals.
 func (i *Item) CalculateTotalPrice() float64 {
-	totalPrice := i.TotalPrice
+	totalPrice := i.Price
 
 	for _, additionalItem := range i.AdditionalItems {
 		totalPrice += additionalItem.Price
 	}
 
+	i.TotalPrice = totalPrice
 	return totalPrice
 }
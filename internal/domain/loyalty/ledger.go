@@ -0,0 +1,210 @@
+package loyaltyentity
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPointsMustBePositive   = errors.New("points must be positive")
+	ErrPointsBelowMinimum     = errors.New("points below the minimum to redeem")
+	ErrInsufficientPoints     = errors.New("insufficient points")
+	ErrPointsAlreadyEarned    = errors.New("points already earned for this order")
+	ErrOrderAlreadyReversed   = errors.New("points of this order already reversed")
+	ErrNoPointsToReverseFound = errors.New("no points to reverse for this order")
+)
+
+// Ledger applies the rules over the transactions of a client and keeps track of what must be saved.
+type Ledger struct {
+	ClientID     uuid.UUID
+	Transactions []LoyaltyTransaction
+	created      map[uuid.UUID]bool
+	updated      map[uuid.UUID]bool
+}
+
+func NewLedger(clientID uuid.UUID, transactions []LoyaltyTransaction) *Ledger {
+	return &Ledger{
+		ClientID:     clientID,
+		Transactions: transactions,
+		created:      map[uuid.UUID]bool{},
+		updated:      map[uuid.UUID]bool{},
+	}
+}
+
+func (l *Ledger) Balance() int {
+	balance := 0
+
+	for _, transaction := range l.Transactions {
+		balance += transaction.Points
+	}
+
+	return balance
+}
+
+// ExpirePoints debits the remaining points of the expired credits.
+func (l *Ledger) ExpirePoints(now time.Time) {
+	for i := range l.Transactions {
+		credit := &l.Transactions[i]
+
+		if !credit.isCredit() || credit.Remaining == 0 || !credit.isExpired(now) {
+			continue
+		}
+
+		expiration := newLoyaltyTransaction(l.ClientID, credit.OrderID, TransactionTypeExpiration, -credit.Remaining)
+		expiration.Description = "points expired"
+
+		credit.Remaining = 0
+		l.updated[credit.ID] = true
+		l.add(expiration)
+	}
+}
+
+func (l *Ledger) Earn(program *LoyaltyProgram, orderID uuid.UUID, points int, now time.Time) error {
+	if points <= 0 {
+		return ErrPointsMustBePositive
+	}
+
+	for _, transaction := range l.Transactions {
+		if transaction.isFromOrder(orderID, TransactionTypeEarn) {
+			return ErrPointsAlreadyEarned
+		}
+	}
+
+	l.addCredit(program, &orderID, TransactionTypeEarn, points, now)
+	return nil
+}
+
+func (l *Ledger) Redeem(program *LoyaltyProgram, orderID uuid.UUID, points int) error {
+	if points <= 0 {
+		return ErrPointsMustBePositive
+	}
+
+	if points < program.MinRedeemPoints {
+		return ErrPointsBelowMinimum
+	}
+
+	if points > l.Balance() {
+		return ErrInsufficientPoints
+	}
+
+	l.consume(points)
+	redeem := newLoyaltyTransaction(l.ClientID, &orderID, TransactionTypeRedeem, -points)
+	redeem.Description = "points redeemed as discount"
+	l.add(redeem)
+	return nil
+}
+
+// ReverseOrder takes back the points earned by a canceled order and gives back the points redeemed on it,
+// earned points already spent are taken from the other credits of the client.
+func (l *Ledger) ReverseOrder(program *LoyaltyProgram, orderID uuid.UUID, now time.Time) error {
+	earned, available, expired, redeemed := 0, 0, 0, 0
+
+	for i := range l.Transactions {
+		transaction := &l.Transactions[i]
+
+		switch {
+		case transaction.isFromOrder(orderID, TransactionTypeReversal):
+			return ErrOrderAlreadyReversed
+		case transaction.isFromOrder(orderID, TransactionTypeEarn):
+			earned += transaction.Points
+			available += transaction.Remaining
+			l.consumeCredit(transaction, transaction.Remaining)
+		case transaction.isFromOrder(orderID, TransactionTypeExpiration):
+			expired -= transaction.Points
+		case transaction.isFromOrder(orderID, TransactionTypeRedeem):
+			redeemed -= transaction.Points
+		}
+	}
+
+	if earned == 0 && redeemed == 0 {
+		return ErrNoPointsToReverseFound
+	}
+
+	if earned-expired > 0 {
+		l.consume(earned - available - expired)
+		reversal := newLoyaltyTransaction(l.ClientID, &orderID, TransactionTypeReversal, -(earned - expired))
+		reversal.Description = "earned points reversed"
+		l.add(reversal)
+	}
+
+	if redeemed > 0 {
+		l.addCredit(program, &orderID, TransactionTypeReversal, redeemed, now)
+	}
+
+	return nil
+}
+
+// Changes returns the transactions to insert and the credits to update.
+func (l *Ledger) Changes() (created []LoyaltyTransaction, updated []LoyaltyTransaction) {
+	for _, transaction := range l.Transactions {
+		if l.created[transaction.ID] {
+			created = append(created, transaction)
+		} else if l.updated[transaction.ID] {
+			updated = append(updated, transaction)
+		}
+	}
+
+	return created, updated
+}
+
+func (l *Ledger) addCredit(program *LoyaltyProgram, orderID *uuid.UUID, transactionType TransactionType, points int, now time.Time) {
+	credit := newLoyaltyTransaction(l.ClientID, orderID, transactionType, points)
+	credit.Remaining = points
+
+	if program.ExpirationDays > 0 {
+		credit.ExpiresAt = &time.Time{}
+		*credit.ExpiresAt = now.AddDate(0, 0, program.ExpirationDays)
+	}
+
+	l.add(credit)
+}
+
+func (l *Ledger) add(transaction *LoyaltyTransaction) {
+	l.Transactions = append(l.Transactions, *transaction)
+	l.created[transaction.ID] = true
+}
+
+// consume uses the credits that expire first.
+func (l *Ledger) consume(points int) {
+	credits := []*LoyaltyTransaction{}
+
+	for i := range l.Transactions {
+		if l.Transactions[i].isCredit() && l.Transactions[i].Remaining > 0 {
+			credits = append(credits, &l.Transactions[i])
+		}
+	}
+
+	sort.SliceStable(credits, func(i, j int) bool {
+		if credits[i].ExpiresAt == nil || credits[j].ExpiresAt == nil {
+			return credits[j].ExpiresAt == nil && credits[i].ExpiresAt != nil
+		}
+
+		return credits[i].ExpiresAt.Before(*credits[j].ExpiresAt)
+	})
+
+	for _, credit := range credits {
+		if points <= 0 {
+			return
+		}
+
+		used := points
+		if credit.Remaining < used {
+			used = credit.Remaining
+		}
+
+		l.consumeCredit(credit, used)
+		points -= used
+	}
+}
+
+func (l *Ledger) consumeCredit(credit *LoyaltyTransaction, points int) {
+	if points == 0 {
+		return
+	}
+
+	credit.Remaining -= points
+	l.updated[credit.ID] = true
+}
@@ -0,0 +1,59 @@
+package loyaltyentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLedgerEarnRedeemAndReverse(t *testing.T) {
+	program := &LoyaltyProgram{LoyaltyProgramCommonAttributes: LoyaltyProgramCommonAttributes{PointsPerCurrency: 1, PointValue: 0.1, MinRedeemPoints: 10, ExpirationDays: 30}}
+	now := time.Now()
+	firstOrder, secondOrder := uuid.New(), uuid.New()
+
+	ledger := NewLedger(uuid.New(), nil)
+	assert.Nil(t, ledger.Earn(program, firstOrder, 100, now))
+	assert.Equal(t, ErrPointsAlreadyEarned, ledger.Earn(program, firstOrder, 100, now))
+	assert.Nil(t, ledger.Earn(program, secondOrder, 50, now.Add(time.Hour)))
+
+	assert.Equal(t, ErrPointsBelowMinimum, ledger.Redeem(program, secondOrder, 5))
+	assert.Equal(t, ErrInsufficientPoints, ledger.Redeem(program, secondOrder, 200))
+
+	// Redeems from the credit that expires first
+	assert.Nil(t, ledger.Redeem(program, secondOrder, 120))
+	assert.Equal(t, 30, ledger.Balance())
+	assert.Equal(t, 0, ledger.Transactions[0].Remaining)
+	assert.Equal(t, 30, ledger.Transactions[1].Remaining)
+
+	// The points spent from the first order are taken from the second credit
+	assert.Nil(t, ledger.ReverseOrder(program, firstOrder, now))
+	assert.Equal(t, -70, ledger.Balance())
+	assert.Equal(t, 0, ledger.Transactions[1].Remaining)
+	assert.Equal(t, ErrOrderAlreadyReversed, ledger.ReverseOrder(program, firstOrder, now))
+
+	// Canceling the second order takes back the earned points and gives back the redeemed ones
+	assert.Nil(t, ledger.ReverseOrder(program, secondOrder, now))
+	assert.Equal(t, 0, ledger.Balance())
+}
+
+func TestLedgerExpirePoints(t *testing.T) {
+	program := &LoyaltyProgram{LoyaltyProgramCommonAttributes: LoyaltyProgramCommonAttributes{PointsPerCurrency: 1, PointValue: 0.1, ExpirationDays: 30}}
+	now := time.Now()
+
+	ledger := NewLedger(uuid.New(), nil)
+	assert.Nil(t, ledger.Earn(program, uuid.New(), 40, now.AddDate(0, 0, -40)))
+	assert.Nil(t, ledger.Earn(program, uuid.New(), 10, now))
+
+	created, _ := ledger.Changes()
+	ledger = NewLedger(ledger.ClientID, created)
+	ledger.ExpirePoints(now)
+	assert.Equal(t, 10, ledger.Balance())
+
+	created, updated := ledger.Changes()
+	assert.Len(t, created, 1)
+	assert.Equal(t, TransactionTypeExpiration, created[0].Type)
+	assert.Len(t, updated, 1)
+	assert.Equal(t, 0, updated[0].Remaining)
+}
@@ -0,0 +1,109 @@
+package loyaltyentity
+
+import (
+	"errors"
+	"math"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrPointsPerCurrencyMustBePositive = errors.New("points per currency must be positive")
+	ErrPointValueMustBePositive        = errors.New("point value must be positive")
+	ErrMultiplierMustBePositive        = errors.New("category multiplier must be positive")
+	ErrExpirationDaysMustBePositive    = errors.New("expiration days must be positive")
+	ErrLoyaltyProgramInactive          = errors.New("loyalty program is inactive")
+)
+
+// LoyaltyProgram holds the rules of the company, there is only one program per company.
+type LoyaltyProgram struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:loyalty_programs"`
+	LoyaltyProgramCommonAttributes
+}
+
+type LoyaltyProgramCommonAttributes struct {
+	PointsPerCurrency   float64            `bun:"points_per_currency,notnull" json:"points_per_currency"`
+	CategoryMultipliers map[string]float64 `bun:"category_multipliers,type:jsonb" json:"category_multipliers,omitempty"`
+	PointValue          float64            `bun:"point_value,notnull" json:"point_value"`
+	MinRedeemPoints     int                `bun:"min_redeem_points" json:"min_redeem_points"`
+	ExpirationDays      int                `bun:"expiration_days" json:"expiration_days"`
+	IsActive            bool               `bun:"is_active" json:"is_active"`
+}
+
+type PatchLoyaltyProgram struct {
+	PointsPerCurrency   *float64           `json:"points_per_currency"`
+	CategoryMultipliers map[string]float64 `json:"category_multipliers"`
+	PointValue          *float64           `json:"point_value"`
+	MinRedeemPoints     *int               `json:"min_redeem_points"`
+	ExpirationDays      *int               `json:"expiration_days"`
+	IsActive            *bool              `json:"is_active"`
+}
+
+func NewDefaultLoyaltyProgram() *LoyaltyProgram {
+	return &LoyaltyProgram{
+		Entity: entity.NewEntity(),
+		LoyaltyProgramCommonAttributes: LoyaltyProgramCommonAttributes{
+			PointsPerCurrency: 1,
+			PointValue:        0.05,
+			ExpirationDays:    365,
+		},
+	}
+}
+
+func (p *LoyaltyProgram) Validate() error {
+	if p.PointsPerCurrency <= 0 {
+		return ErrPointsPerCurrencyMustBePositive
+	}
+
+	if p.PointValue <= 0 {
+		return ErrPointValueMustBePositive
+	}
+
+	if p.ExpirationDays < 0 {
+		return ErrExpirationDaysMustBePositive
+	}
+
+	for _, multiplier := range p.CategoryMultipliers {
+		if multiplier < 0 {
+			return ErrMultiplierMustBePositive
+		}
+	}
+
+	return nil
+}
+
+// CalculatePoints returns the points earned by the order, groups are weighted by the multiplier of the category
+// and the discount of the order is spread over the groups.
+func (p *LoyaltyProgram) CalculatePoints(order *orderentity.Order) int {
+	itemsTotal := 0.0
+	weightedTotal := 0.0
+
+	for _, group := range order.Groups {
+		if group.Status == groupitementity.StatusGroupCanceled {
+			continue
+		}
+
+		multiplier := 1.0
+		if value, ok := p.CategoryMultipliers[group.CategoryID.String()]; ok {
+			multiplier = value
+		}
+
+		itemsTotal += group.Total
+		weightedTotal += group.Total * multiplier
+	}
+
+	if itemsTotal <= 0 {
+		return 0
+	}
+
+	paidRatio := math.Max(itemsTotal-order.Discount, 0) / itemsTotal
+	return int(math.Floor(weightedTotal * paidRatio * p.PointsPerCurrency))
+}
+
+func (p *LoyaltyProgram) PointsToDiscount(points int) float64 {
+	return math.Round(float64(points)*p.PointValue*100) / 100
+}
@@ -0,0 +1,51 @@
+package loyaltyentity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+// LoyaltyTransaction is an entry of the points ledger of the client, credits keep
+// the remaining points to be consumed by redemptions and expirations.
+type LoyaltyTransaction struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:loyalty_transactions"`
+	LoyaltyTransactionCommonAttributes
+}
+
+type LoyaltyTransactionCommonAttributes struct {
+	ClientID    uuid.UUID       `bun:"column:client_id,type:uuid,notnull" json:"client_id"`
+	OrderID     *uuid.UUID      `bun:"column:order_id,type:uuid" json:"order_id,omitempty"`
+	Type        TransactionType `bun:"type,notnull" json:"type"`
+	Points      int             `bun:"points,notnull" json:"points"`
+	Remaining   int             `bun:"remaining" json:"remaining,omitempty"`
+	ExpiresAt   *time.Time      `bun:"expires_at" json:"expires_at,omitempty"`
+	Description string          `bun:"description" json:"description,omitempty"`
+}
+
+func newLoyaltyTransaction(clientID uuid.UUID, orderID *uuid.UUID, transactionType TransactionType, points int) *LoyaltyTransaction {
+	return &LoyaltyTransaction{
+		Entity: entity.NewEntity(),
+		LoyaltyTransactionCommonAttributes: LoyaltyTransactionCommonAttributes{
+			ClientID: clientID,
+			OrderID:  orderID,
+			Type:     transactionType,
+			Points:   points,
+		},
+	}
+}
+
+func (t *LoyaltyTransaction) isCredit() bool {
+	return t.Points > 0
+}
+
+func (t *LoyaltyTransaction) isExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && t.ExpiresAt.Before(now)
+}
+
+func (t *LoyaltyTransaction) isFromOrder(orderID uuid.UUID, transactionType TransactionType) bool {
+	return t.OrderID != nil && *t.OrderID == orderID && t.Type == transactionType
+}
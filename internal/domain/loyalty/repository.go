@@ -0,0 +1,14 @@
+package loyaltyentity
+
+import "context"
+
+type LoyaltyProgramRepository interface {
+	CreateLoyaltyProgram(ctx context.Context, program *LoyaltyProgram) error
+	UpdateLoyaltyProgram(ctx context.Context, program *LoyaltyProgram) error
+	GetLoyaltyProgram(ctx context.Context) (*LoyaltyProgram, error)
+}
+
+type LoyaltyTransactionRepository interface {
+	SaveLoyaltyTransactions(ctx context.Context, created []LoyaltyTransaction, updated []LoyaltyTransaction) error
+	GetLoyaltyTransactionsByClientId(ctx context.Context, clientID string) ([]LoyaltyTransaction, error)
+}
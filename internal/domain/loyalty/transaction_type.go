@@ -0,0 +1,19 @@
+package loyaltyentity
+
+type TransactionType string
+
+const (
+	TransactionTypeEarn       TransactionType = "Earn"
+	TransactionTypeRedeem     TransactionType = "Redeem"
+	TransactionTypeReversal   TransactionType = "Reversal"
+	TransactionTypeExpiration TransactionType = "Expiration"
+)
+
+func GetAllTransactionTypes() []TransactionType {
+	return []TransactionType{
+		TransactionTypeEarn,
+		TransactionTypeRedeem,
+		TransactionTypeReversal,
+		TransactionTypeExpiration,
+	}
+}
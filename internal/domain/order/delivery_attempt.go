@@ -0,0 +1,30 @@
+package orderentity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+// DeliveryAttempt keeps the result of each time the delivery left the store.
+type DeliveryAttempt struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:delivery_attempts"`
+	DeliveryAttemptCommonAttributes
+}
+
+type DeliveryAttemptCommonAttributes struct {
+	DeliveryID    uuid.UUID                `bun:"column:delivery_id,type:uuid,notnull" json:"delivery_id"`
+	Number        int                      `bun:"number,notnull" json:"number"`
+	Status        StatusDeliveryOrder      `bun:"status,notnull" json:"status"`
+	FailureReason DeliveryFailureReason    `bun:"failure_reason" json:"failure_reason,omitempty"`
+	Observation   string                   `bun:"observation" json:"observation,omitempty"`
+	DriverID      *uuid.UUID               `bun:"column:driver_id,type:uuid" json:"driver_id,omitempty"`
+	Driver        *employeeentity.Employee `bun:"rel:belongs-to" json:"driver,omitempty"`
+	RouteID       *uuid.UUID               `bun:"column:route_id,type:uuid" json:"route_id,omitempty"`
+	LaunchedAt    *time.Time               `bun:"launched_at" json:"launched_at,omitempty"`
+	FinishedAt    *time.Time               `bun:"finished_at" json:"finished_at,omitempty"`
+}
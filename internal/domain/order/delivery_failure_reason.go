@@ -0,0 +1,29 @@
+package orderentity
+
+type DeliveryFailureReason string
+
+const (
+	DeliveryFailureCustomerAbsent DeliveryFailureReason = "CustomerAbsent"
+	DeliveryFailureWrongAddress   DeliveryFailureReason = "WrongAddress"
+	DeliveryFailureRefused        DeliveryFailureReason = "Refused"
+	DeliveryFailureOther          DeliveryFailureReason = "Other"
+)
+
+func GetAllDeliveryFailureReasons() []DeliveryFailureReason {
+	return []DeliveryFailureReason{
+		DeliveryFailureCustomerAbsent,
+		DeliveryFailureWrongAddress,
+		DeliveryFailureRefused,
+		DeliveryFailureOther,
+	}
+}
+
+func (r DeliveryFailureReason) IsValid() bool {
+	for _, reason := range GetAllDeliveryFailureReasons() {
+		if r == reason {
+			return true
+		}
+	}
+
+	return false
+}
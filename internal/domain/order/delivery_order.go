@@ -1,16 +1,27 @@
 package orderentity
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
 	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 )
 
+var (
+	ErrOrderBelowDeliveryMinimum  = errors.New("order total is below the delivery zone minimum")
+	ErrDeliveryMustBePending      = errors.New("delivery must be pending")
+	ErrDeliveryMustBeShipped      = errors.New("delivery must be shipped")
+	ErrDeliveryMustBeFailed       = errors.New("delivery must be failed")
+	ErrDeliveryMustBeUndelivered  = errors.New("delivery must be failed or returned")
+	ErrDeliveryFailureReasonValid = errors.New("delivery failure reason is invalid")
+)
+
 type DeliveryOrder struct {
 	entity.Entity
 	bun.BaseModel `bun:"table:delivery_orders"`
@@ -19,31 +30,150 @@ type DeliveryOrder struct {
 }
 
 type DeliveryOrderCommonAttributes struct {
-	Status      StatusDeliveryOrder      `bun:"status" json:"status"`
-	DeliveryTax *float64                 `bun:"delivery_tax" json:"delivery_tax"`
-	ClientID    uuid.UUID                `bun:"column:client_id,type:uuid,notnull" json:"client_id"`
-	Client      *cliententity.Client     `bun:"rel:belongs-to" json:"client"`
-	AddressID   uuid.UUID                `bun:"column:address_id,type:uuid,notnull" json:"address_id"`
-	Address     *addressentity.Address   `bun:"rel:belongs-to" json:"address"`
-	DriverID    *uuid.UUID               `bun:"column:driver_id,type:uuid" json:"driver_id"`
-	Driver      *employeeentity.Employee `bun:"rel:belongs-to" json:"driver"`
-	OrderID     uuid.UUID                `bun:"column:order_id,type:uuid,notnull" json:"order_id"`
+	Status         StatusDeliveryOrder              `bun:"status" json:"status"`
+	DeliveryTax    *float64                         `bun:"delivery_tax" json:"delivery_tax"`
+	ClientID       uuid.UUID                        `bun:"column:client_id,type:uuid,notnull" json:"client_id"`
+	Client         *cliententity.Client             `bun:"rel:belongs-to" json:"client"`
+	AddressID      uuid.UUID                        `bun:"column:address_id,type:uuid,notnull" json:"address_id"`
+	Address        *addressentity.Address           `bun:"rel:belongs-to" json:"address"`
+	DeliveryZoneID *uuid.UUID                       `bun:"column:delivery_zone_id,type:uuid" json:"delivery_zone_id"`
+	DeliveryZone   *deliveryzoneentity.DeliveryZone `bun:"rel:belongs-to" json:"delivery_zone,omitempty"`
+	DriverID       *uuid.UUID                       `bun:"column:driver_id,type:uuid" json:"driver_id"`
+	Driver         *employeeentity.Employee         `bun:"rel:belongs-to" json:"driver"`
+	RouteID        *uuid.UUID                       `bun:"column:route_id,type:uuid" json:"route_id,omitempty"`
+	RouteStop      int                              `bun:"route_stop" json:"route_stop,omitempty"`
+	SettlementID   *uuid.UUID                       `bun:"column:settlement_id,type:uuid" json:"settlement_id,omitempty"`
+	FailureReason  DeliveryFailureReason            `bun:"failure_reason" json:"failure_reason,omitempty"`
+	Attempts       []DeliveryAttempt                `bun:"rel:has-many,join:id=delivery_id" json:"attempts,omitempty"`
+	OrderID        uuid.UUID                        `bun:"column:order_id,type:uuid,notnull" json:"order_id"`
 }
 
 type DeliveryTimeLogs struct {
 	LaunchedAt  *time.Time `bun:"launched_at" json:"launched_at,omitempty"`
 	DeliveredAt *time.Time `bun:"delivered_at" json:"delivered_at,omitempty"`
+	FailedAt    *time.Time `bun:"failed_at" json:"failed_at,omitempty"`
+	ReturnedAt  *time.Time `bun:"returned_at" json:"returned_at,omitempty"`
+}
+
+func (d *DeliveryOrder) ApplyDeliveryZone(zone *deliveryzoneentity.DeliveryZone) {
+	fee := zone.Fee
+	d.DeliveryZoneID = &zone.ID
+	d.DeliveryZone = zone
+	d.DeliveryTax = &fee
+}
+
+// ValidateMinOrderValue checks the items total against the minimum of the delivery zone, if loaded.
+func (d *DeliveryOrder) ValidateMinOrderValue(itemsTotal float64) error {
+	if d.DeliveryZone == nil {
+		return nil
+	}
+
+	if itemsTotal < d.DeliveryZone.MinOrderValue {
+		return ErrOrderBelowDeliveryMinimum
+	}
+
+	return nil
 }
 
-func (d *DeliveryOrder) LaunchDelivery(driverID uuid.UUID) {
-	*d.DriverID = driverID
+func (d *DeliveryOrder) LaunchDelivery(driverID uuid.UUID) error {
+	if d.Status != DeliveryOrderStatusPending {
+		return ErrDeliveryMustBePending
+	}
+
+	d.DriverID = &driverID
 	d.LaunchedAt = &time.Time{}
 	*d.LaunchedAt = time.Now()
 	d.Status = DeliveryOrderStatusShipped
+	return nil
 }
 
-func (d *DeliveryOrder) FinishDelivery() {
+func (d *DeliveryOrder) LaunchInRoute(routeID uuid.UUID, stop int, driverID uuid.UUID) {
+	d.RouteID = &routeID
+	d.RouteStop = stop
+	d.DriverID = &driverID
+	d.LaunchedAt = &time.Time{}
+	*d.LaunchedAt = time.Now()
+	d.Status = DeliveryOrderStatusShipped
+}
+
+func (d *DeliveryOrder) FinishDelivery() (*DeliveryAttempt, error) {
+	if d.Status != DeliveryOrderStatusShipped {
+		return nil, ErrDeliveryMustBeShipped
+	}
+
 	d.DeliveredAt = &time.Time{}
 	*d.DeliveredAt = time.Now()
 	d.Status = DeliveryOrderStatusDelivered
+	return d.addAttempt("", ""), nil
+}
+
+// FailDelivery registers that the driver could not deliver the order.
+func (d *DeliveryOrder) FailDelivery(reason DeliveryFailureReason, observation string) (*DeliveryAttempt, error) {
+	if d.Status != DeliveryOrderStatusShipped {
+		return nil, ErrDeliveryMustBeShipped
+	}
+
+	if !reason.IsValid() {
+		return nil, ErrDeliveryFailureReasonValid
+	}
+
+	d.FailureReason = reason
+	d.FailedAt = &time.Time{}
+	*d.FailedAt = time.Now()
+	d.Status = DeliveryOrderStatusFailed
+	return d.addAttempt(reason, observation), nil
+}
+
+// ReturnDelivery registers that the order of a failed delivery is back at the store.
+func (d *DeliveryOrder) ReturnDelivery() error {
+	if d.Status != DeliveryOrderStatusFailed {
+		return ErrDeliveryMustBeFailed
+	}
+
+	d.ReturnedAt = &time.Time{}
+	*d.ReturnedAt = time.Now()
+	d.Status = DeliveryOrderStatusReturned
+	return nil
+}
+
+// RetryDelivery puts a failed or returned delivery back in the queue to be launched again.
+func (d *DeliveryOrder) RetryDelivery() error {
+	if !d.IsUndelivered() {
+		return ErrDeliveryMustBeUndelivered
+	}
+
+	d.Status = DeliveryOrderStatusPending
+	d.DriverID = nil
+	d.RouteID = nil
+	d.RouteStop = 0
+	d.LaunchedAt = nil
+	d.FailedAt = nil
+	d.ReturnedAt = nil
+	d.FailureReason = ""
+	return nil
+}
+
+func (d *DeliveryOrder) IsUndelivered() bool {
+	return d.Status == DeliveryOrderStatusFailed || d.Status == DeliveryOrderStatusReturned
+}
+
+func (d *DeliveryOrder) addAttempt(reason DeliveryFailureReason, observation string) *DeliveryAttempt {
+	attempt := &DeliveryAttempt{
+		Entity: entity.NewEntity(),
+		DeliveryAttemptCommonAttributes: DeliveryAttemptCommonAttributes{
+			DeliveryID:    d.ID,
+			Number:        len(d.Attempts) + 1,
+			Status:        d.Status,
+			FailureReason: reason,
+			Observation:   observation,
+			DriverID:      d.DriverID,
+			RouteID:       d.RouteID,
+			LaunchedAt:    d.LaunchedAt,
+			FinishedAt:    &time.Time{},
+		},
+	}
+
+	*attempt.FinishedAt = time.Now()
+	d.Attempts = append(d.Attempts, *attempt)
+	return attempt
 }
@@ -0,0 +1,79 @@
+package orderentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+)
+
+func TestDeliveryLifecycle(t *testing.T) {
+	delivery := &DeliveryOrder{
+		Entity: entity.NewEntity(),
+		DeliveryOrderCommonAttributes: DeliveryOrderCommonAttributes{
+			Status: DeliveryOrderStatusPending,
+		},
+	}
+
+	driverID := uuid.New()
+	assert.Nil(t, delivery.LaunchDelivery(driverID))
+	assert.Equal(t, driverID, *delivery.DriverID)
+	assert.Equal(t, ErrDeliveryMustBePending, delivery.LaunchDelivery(driverID))
+
+	_, err := delivery.FailDelivery("Lost", "")
+	assert.Equal(t, ErrDeliveryFailureReasonValid, err)
+
+	attempt, err := delivery.FailDelivery(DeliveryFailureCustomerAbsent, "nobody at home")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, attempt.Number)
+	assert.Equal(t, DeliveryOrderStatusFailed, attempt.Status)
+	assert.Equal(t, driverID, *attempt.DriverID)
+
+	assert.Nil(t, delivery.ReturnDelivery())
+	assert.Equal(t, DeliveryOrderStatusReturned, delivery.Status)
+
+	assert.Nil(t, delivery.RetryDelivery())
+	assert.Equal(t, DeliveryOrderStatusPending, delivery.Status)
+	assert.Nil(t, delivery.DriverID)
+
+	assert.Nil(t, delivery.LaunchDelivery(driverID))
+	attempt, err = delivery.FinishDelivery()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, attempt.Number)
+	assert.Equal(t, DeliveryOrderStatusDelivered, attempt.Status)
+	assert.Equal(t, ErrDeliveryMustBeUndelivered, delivery.RetryDelivery())
+}
+
+func TestValidateMinOrderValue(t *testing.T) {
+	pizza := itementity.NewItem(itementity.ItemCommonAttributes{Name: "Pizza", Price: 45, Quantity: 1})
+	soda := itementity.NewItem(itementity.ItemCommonAttributes{Name: "Soda", Price: 20, Quantity: 1})
+
+	canceled := groupitementity.NewGroupItem(groupitementity.GroupCommonAttributes{Items: []itementity.Item{*soda}})
+	canceled.Status = groupitementity.StatusGroupCanceled
+
+	order := &Order{Entity: entity.NewEntity()}
+	order.Groups = []groupitementity.GroupItem{
+		*groupitementity.NewGroupItem(groupitementity.GroupCommonAttributes{Items: []itementity.Item{*pizza}}),
+		*canceled,
+	}
+
+	zone := &deliveryzoneentity.DeliveryZone{Entity: entity.NewEntity()}
+	zone.Fee = 10
+	zone.MinOrderValue = 50
+
+	delivery := &DeliveryOrder{Entity: entity.NewEntity()}
+	delivery.ApplyDeliveryZone(zone)
+	order.Delivery = delivery
+
+	// The fee would cross the minimum, but only the items that are not canceled count
+	order.CalculateTotalPrice()
+	assert.Equal(t, 45.0, order.GetItemsTotal())
+	assert.ErrorIs(t, delivery.ValidateMinOrderValue(order.GetItemsTotal()), ErrOrderBelowDeliveryMinimum)
+
+	zone.MinOrderValue = 45
+	assert.Nil(t, delivery.ValidateMinOrderValue(order.GetItemsTotal()))
+}
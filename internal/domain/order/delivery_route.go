@@ -0,0 +1,138 @@
+package orderentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrRouteWithoutDeliveries       = errors.New("route must have at least one delivery")
+	ErrRouteAlreadyReturned         = errors.New("route already returned")
+	ErrRouteHasDeliveriesInProgress = errors.New("route has deliveries in progress")
+)
+
+type DeliveryRoute struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:delivery_routes"`
+	DeliveryRouteTimeLogs
+	DeliveryRouteCommonAttributes
+}
+
+type DeliveryRouteCommonAttributes struct {
+	Status          StatusDeliveryRoute      `bun:"status,notnull" json:"status"`
+	DriverID        uuid.UUID                `bun:"column:driver_id,type:uuid,notnull" json:"driver_id"`
+	Driver          *employeeentity.Employee `bun:"rel:belongs-to" json:"driver,omitempty"`
+	Deliveries      []DeliveryOrder          `bun:"rel:has-many,join:id=route_id" json:"deliveries,omitempty"`
+	TotalDistanceKm float64                  `bun:"total_distance_km" json:"total_distance_km"`
+}
+
+type DeliveryRouteTimeLogs struct {
+	LaunchedAt *time.Time `bun:"launched_at" json:"launched_at,omitempty"`
+	ReturnedAt *time.Time `bun:"returned_at" json:"returned_at,omitempty"`
+}
+
+// NewDeliveryRoute orders the stops starting from the store and launches every delivery to the driver.
+func NewDeliveryRoute(driverID uuid.UUID, deliveries []DeliveryOrder, store *addressentity.Address) (*DeliveryRoute, error) {
+	if len(deliveries) == 0 {
+		return nil, ErrRouteWithoutDeliveries
+	}
+
+	route := &DeliveryRoute{
+		Entity: entity.NewEntity(),
+		DeliveryRouteCommonAttributes: DeliveryRouteCommonAttributes{
+			Status:   DeliveryRouteStatusInProgress,
+			DriverID: driverID,
+		},
+	}
+
+	route.Deliveries, route.TotalDistanceKm = OrderStopsByNearestNeighbor(deliveries, store)
+
+	for i := range route.Deliveries {
+		route.Deliveries[i].LaunchInRoute(route.ID, i+1, driverID)
+	}
+
+	route.LaunchedAt = &time.Time{}
+	*route.LaunchedAt = time.Now()
+	return route, nil
+}
+
+// ReturnToStore closes the route, failed deliveries come back with the driver.
+func (r *DeliveryRoute) ReturnToStore() error {
+	if r.Status == DeliveryRouteStatusReturned {
+		return ErrRouteAlreadyReturned
+	}
+
+	for _, delivery := range r.Deliveries {
+		if delivery.Status == DeliveryOrderStatusShipped {
+			return ErrRouteHasDeliveriesInProgress
+		}
+	}
+
+	for i := range r.Deliveries {
+		if r.Deliveries[i].Status != DeliveryOrderStatusFailed {
+			continue
+		}
+
+		if err := r.Deliveries[i].ReturnDelivery(); err != nil {
+			return err
+		}
+	}
+
+	r.Status = DeliveryRouteStatusReturned
+	r.ReturnedAt = &time.Time{}
+	*r.ReturnedAt = time.Now()
+	return nil
+}
+
+// OrderStopsByNearestNeighbor always visits the closest delivery not visited yet,
+// deliveries without coordinates are kept at the end in the given order.
+func OrderStopsByNearestNeighbor(deliveries []DeliveryOrder, store *addressentity.Address) (ordered []DeliveryOrder, totalDistanceKm float64) {
+	pending := []DeliveryOrder{}
+	withoutCoordinates := []DeliveryOrder{}
+
+	for _, delivery := range deliveries {
+		if delivery.Address != nil && delivery.Address.HasCoordinates() {
+			pending = append(pending, delivery)
+		} else {
+			withoutCoordinates = append(withoutCoordinates, delivery)
+		}
+	}
+
+	var current *addressentity.Address
+	if store != nil && store.HasCoordinates() {
+		current = store
+	}
+
+	for len(pending) > 0 {
+		nearest := 0
+		nearestDistance := 0.0
+
+		if current != nil {
+			for i := range pending {
+				distance := distanceBetween(current, pending[i].Address)
+				if i == 0 || distance < nearestDistance {
+					nearest = i
+					nearestDistance = distance
+				}
+			}
+		}
+
+		totalDistanceKm += nearestDistance
+		current = pending[nearest].Address
+		ordered = append(ordered, pending[nearest])
+		pending = append(pending[:nearest], pending[nearest+1:]...)
+	}
+
+	return append(ordered, withoutCoordinates...), totalDistanceKm
+}
+
+func distanceBetween(from *addressentity.Address, to *addressentity.Address) float64 {
+	return deliveryzoneentity.DistanceKm(*from.Latitude, *from.Longitude, *to.Latitude, *to.Longitude)
+}
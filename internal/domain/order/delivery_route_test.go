@@ -0,0 +1,94 @@
+package orderentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+func newAddressAt(latitude float64, longitude float64) *addressentity.Address {
+	return &addressentity.Address{
+		Entity: entity.NewEntity(),
+		AddressCommonAttributes: addressentity.AddressCommonAttributes{
+			Latitude:  &latitude,
+			Longitude: &longitude,
+		},
+	}
+}
+
+func newPendingDelivery(address *addressentity.Address) DeliveryOrder {
+	return DeliveryOrder{
+		Entity: entity.NewEntity(),
+		DeliveryOrderCommonAttributes: DeliveryOrderCommonAttributes{
+			Status:  DeliveryOrderStatusPending,
+			Address: address,
+		},
+	}
+}
+
+func TestOrderStopsByNearestNeighbor(t *testing.T) {
+	store := newAddressAt(0, 0)
+	far := newPendingDelivery(newAddressAt(0, 0.3))
+	near := newPendingDelivery(newAddressAt(0, 0.1))
+	middle := newPendingDelivery(newAddressAt(0, 0.2))
+	withoutCoordinates := newPendingDelivery(&addressentity.Address{Entity: entity.NewEntity()})
+
+	ordered, totalDistanceKm := OrderStopsByNearestNeighbor([]DeliveryOrder{withoutCoordinates, far, near, middle}, store)
+
+	assert.Len(t, ordered, 4)
+	assert.Equal(t, near.ID, ordered[0].ID)
+	assert.Equal(t, middle.ID, ordered[1].ID)
+	assert.Equal(t, far.ID, ordered[2].ID)
+	assert.Equal(t, withoutCoordinates.ID, ordered[3].ID)
+
+	// 0.3 degrees of longitude on the equator
+	assert.InDelta(t, 33.36, totalDistanceKm, 0.1)
+
+	// Without the store the first delivery with coordinates is the start
+	ordered, _ = OrderStopsByNearestNeighbor([]DeliveryOrder{far, near, middle}, nil)
+	assert.Equal(t, far.ID, ordered[0].ID)
+	assert.Equal(t, middle.ID, ordered[1].ID)
+	assert.Equal(t, near.ID, ordered[2].ID)
+}
+
+func TestDeliveryRouteLifecycle(t *testing.T) {
+	_, err := NewDeliveryRoute(uuid.New(), nil, nil)
+	assert.ErrorIs(t, err, ErrRouteWithoutDeliveries)
+
+	driverID := uuid.New()
+	far := newPendingDelivery(newAddressAt(0, 0.2))
+	near := newPendingDelivery(newAddressAt(0, 0.1))
+
+	route, err := NewDeliveryRoute(driverID, []DeliveryOrder{far, near}, newAddressAt(0, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, DeliveryRouteStatusInProgress, route.Status)
+	assert.NotNil(t, route.LaunchedAt)
+
+	for i, delivery := range route.Deliveries {
+		assert.Equal(t, DeliveryOrderStatusShipped, delivery.Status)
+		assert.Equal(t, route.ID, *delivery.RouteID)
+		assert.Equal(t, driverID, *delivery.DriverID)
+		assert.Equal(t, i+1, delivery.RouteStop)
+	}
+
+	assert.Equal(t, near.ID, route.Deliveries[0].ID)
+
+	// The route can't return while a delivery is still shipped
+	assert.ErrorIs(t, route.ReturnToStore(), ErrRouteHasDeliveriesInProgress)
+
+	_, err = route.Deliveries[0].FinishDelivery()
+	assert.Nil(t, err)
+	_, err = route.Deliveries[1].FailDelivery(DeliveryFailureCustomerAbsent, "")
+	assert.Nil(t, err)
+
+	assert.Nil(t, route.ReturnToStore())
+	assert.Equal(t, DeliveryRouteStatusReturned, route.Status)
+	assert.NotNil(t, route.ReturnedAt)
+	assert.Equal(t, DeliveryOrderStatusDelivered, route.Deliveries[0].Status)
+	assert.Equal(t, DeliveryOrderStatusReturned, route.Deliveries[1].Status)
+
+	assert.ErrorIs(t, route.ReturnToStore(), ErrRouteAlreadyReturned)
+}
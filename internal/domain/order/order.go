@@ -2,6 +2,7 @@ package orderentity
 
 import (
 	"errors"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -43,6 +44,7 @@ type OrderDetail struct {
 	TotalPayable  float64                  `bun:"total_payable" json:"total_payable"`
 	TotalPaid     float64                  `bun:"total_paid" json:"total_paid"`
 	TotalChange   float64                  `bun:"total_change" json:"total_change"`
+	Discount      float64                  `bun:"discount" json:"discount,omitempty"`
 	QuantityItems float64                  `bun:"quantity_items" json:"quantity_items"`
 	Observation   string                   `bun:"observation" json:"observation"`
 	AttendantID   *uuid.UUID               `bun:"column:attendant_id,type:uuid,notnull" json:"attendant_id"`
@@ -172,6 +174,46 @@ func (o *Order) UnarchiveOrder() (err error) {
 	return
 }
 
+// GetClientID returns the client of the delivery or of the table, if any was attached.
+func (o *Order) GetClientID() *uuid.UUID {
+	if o.Delivery != nil && o.Delivery.ClientID != uuid.Nil {
+		return &o.Delivery.ClientID
+	}
+
+	if o.Table != nil && o.Table.ClientID != nil {
+		return o.Table.ClientID
+	}
+
+	return nil
+}
+
+// IsPurchase checks if the order was finished, even if archived later.
+func (o *Order) IsPurchase() bool {
+	return o.FinishedAt != nil && o.CanceledAt == nil
+}
+
+// IsReadyToShip checks if every group not canceled is ready.
+func (o *Order) IsReadyToShip() bool {
+	if o.Status != OrderStatusPending {
+		return false
+	}
+
+	hasGroups := false
+	for _, group := range o.Groups {
+		if group.Status == groupitementity.StatusGroupCanceled {
+			continue
+		}
+
+		if group.Status != groupitementity.StatusGroupReady {
+			return false
+		}
+
+		hasGroups = true
+	}
+
+	return hasGroups
+}
+
 func (o *Order) ScheduleOrder(startAt *time.Time) {
 	o.StartAt = startAt
 }
@@ -186,10 +228,12 @@ func (o *Order) ValidatePayments() error {
 		totalToPay += group.Total
 	}
 
-	if o.Delivery != nil {
+	if o.Delivery != nil && o.Delivery.DeliveryTax != nil {
 		totalToPay += *o.Delivery.DeliveryTax
 	}
 
+	totalToPay -= o.Discount
+
 	if totalToPay < o.TotalPaid {
 		return ErrOrderPaidMoreThanTotal
 	}
@@ -202,6 +246,49 @@ func (o *Order) AddPayment(payment *PaymentOrder) {
 	o.Payments = append(o.Payments, *payment)
 }
 
+// GetRemainingToPay returns the amount of the order not paid yet.
+func (o *Order) GetRemainingToPay() float64 {
+	totalToPay := 0.00
+	for _, group := range o.Groups {
+		totalToPay += group.Total
+	}
+
+	if o.Delivery != nil && o.Delivery.DeliveryTax != nil {
+		totalToPay += *o.Delivery.DeliveryTax
+	}
+
+	totalToPay -= o.Discount
+
+	return math.Max(math.Round((totalToPay-o.TotalPaid)*100)/100, 0)
+}
+
+func (o *Order) HasPaymentMethod(method PayMethod) bool {
+	for _, payment := range o.Payments {
+		if payment.Method == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetTotalPaidInCash returns the cash kept from the payments, discounting the change given back.
+func (o *Order) GetTotalPaidInCash() float64 {
+	totalCash := 0.00
+
+	for _, payment := range o.Payments {
+		if payment.Method == Dinheiro {
+			totalCash += payment.TotalPaid
+		}
+	}
+
+	if totalCash < o.TotalChange {
+		return 0
+	}
+
+	return totalCash - o.TotalChange
+}
+
 func (o *Order) CalculateTotalChange() {
 	totalPaid := 0.00
 
@@ -214,6 +301,7 @@ func (o *Order) CalculateTotalChange() {
 		totalToPay += group.Total
 	}
 
+	totalToPay -= o.Discount
 	o.TotalPaid = totalPaid
 
 	if totalToPay < totalPaid {
@@ -235,6 +323,47 @@ func (o *Order) CalculateTotalPrice() {
 		totalPrice += *o.Delivery.DeliveryTax
 	}
 
-	o.TotalPayable = totalPrice
+	o.TotalPayable = math.Max(totalPrice-o.Discount, 0)
 	o.QuantityItems = qtd
 }
+
+// GetItemsTotal sums the groups that are not canceled, without the delivery fee and the discount.
+func (o *Order) GetItemsTotal() float64 {
+	total := 0.00
+
+	for i := range o.Groups {
+		if o.Groups[i].Status == groupitementity.StatusGroupCanceled {
+			continue
+		}
+
+		o.Groups[i].CalculateTotalPrice()
+		total += o.Groups[i].Total
+	}
+
+	return total
+}
+
+// ApplyDiscount adds a discount to the order, limited to the total of the items.
+func (o *Order) ApplyDiscount(discount float64) (applied float64, err error) {
+	if o.Status == OrderStatusFinished {
+		return 0, ErrOrderAlreadyFinished
+	}
+
+	if o.Status == OrderStatusCanceled {
+		return 0, ErrOrderAlreadyCanceled
+	}
+
+	if discount <= 0 {
+		return 0, ErrDiscountMustBePositive
+	}
+
+	itemsTotal := 0.00
+	for _, group := range o.Groups {
+		itemsTotal += group.Total
+	}
+
+	applied = math.Min(discount, math.Max(itemsTotal-o.Discount, 0))
+	o.Discount += applied
+	o.CalculateTotalPrice()
+	return applied, nil
+}
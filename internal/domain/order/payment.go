@@ -35,6 +35,8 @@ const (
 	Alelo           PayMethod = "Alelo"
 	PayPal          PayMethod = "PayPal"
 	Outros          PayMethod = "Outros"
+	// Fiado is charged to the client credit account, it is not accepted as a direct payment.
+	Fiado PayMethod = "Fiado"
 )
 
 func GetAllPayMethod() []PayMethod {
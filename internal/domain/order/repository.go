@@ -1,6 +1,9 @@
 package orderentity
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type OrderRepository interface {
 	CreateOrder(ctx context.Context, order *Order) error
@@ -9,6 +12,8 @@ type OrderRepository interface {
 	DeleteOrder(ctx context.Context, id string) error
 	GetOrderById(ctx context.Context, id string) (*Order, error)
 	GetAllOrders(ctx context.Context) ([]Order, error)
+	GetOrdersByClientId(ctx context.Context, clientID string) ([]Order, error)
+	GetAllClientOrders(ctx context.Context) ([]Order, error)
 	AddPaymentOrder(ctx context.Context, payment *PaymentOrder) error
 }
 
@@ -17,7 +22,20 @@ type DeliveryOrderRepository interface {
 	UpdateDeliveryOrder(ctx context.Context, delivery *DeliveryOrder) error
 	DeleteDeliveryOrder(ctx context.Context, id string) error
 	GetDeliveryById(ctx context.Context, id string) (*DeliveryOrder, error)
+	GetDeliveryByOrderId(ctx context.Context, orderID string) (*DeliveryOrder, error)
 	GetAllDeliveries(ctx context.Context) ([]DeliveryOrder, error)
+	GetDeliveriesByClientId(ctx context.Context, clientID string) ([]DeliveryOrder, error)
+	GetDeliveriesByStatus(ctx context.Context, status StatusDeliveryOrder) ([]DeliveryOrder, error)
+	AddDeliveryAttempt(ctx context.Context, delivery *DeliveryOrder, attempt *DeliveryAttempt) error
+	GetDeliveriesToSettle(ctx context.Context, driverID string, startAt time.Time, endAt time.Time) ([]DeliveryOrder, error)
+}
+
+type DeliveryRouteRepository interface {
+	CreateDeliveryRoute(ctx context.Context, route *DeliveryRoute) error
+	UpdateDeliveryRoute(ctx context.Context, route *DeliveryRoute) error
+	GetDeliveryRouteById(ctx context.Context, id string) (*DeliveryRoute, error)
+	GetAllDeliveryRoutes(ctx context.Context) ([]DeliveryRoute, error)
+	GetDeliveryRoutesByStatus(ctx context.Context, status StatusDeliveryRoute) ([]DeliveryRoute, error)
 }
 
 type TableOrderRepository interface {
@@ -25,5 +43,10 @@ type TableOrderRepository interface {
 	UpdateTableOrder(ctx context.Context, table *TableOrder) error
 	DeleteTableOrder(ctx context.Context, id string) error
 	GetTableOrderById(ctx context.Context, id string) (*TableOrder, error)
+	GetTableOrderByOrderId(ctx context.Context, orderID string) (*TableOrder, error)
 	GetAllTableOrders(ctx context.Context) ([]TableOrder, error)
+	GetOpenTableOrders(ctx context.Context) ([]TableOrder, error)
+	GetFinishedTableOrders(ctx context.Context, startAt time.Time, endAt time.Time) ([]TableOrder, error)
+	SaveTableOrderTransfer(ctx context.Context, result *TransferResult, source *Order, target *Order) error
+	GetTableOrderTransfers(ctx context.Context, tableOrderID string) ([]TableOrderTransfer, error)
 }
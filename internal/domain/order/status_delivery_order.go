@@ -6,6 +6,8 @@ const (
 	DeliveryOrderStatusPending   StatusDeliveryOrder = "Pending"
 	DeliveryOrderStatusShipped   StatusDeliveryOrder = "Shipped"
 	DeliveryOrderStatusDelivered StatusDeliveryOrder = "Delivered"
+	DeliveryOrderStatusFailed    StatusDeliveryOrder = "Failed"
+	DeliveryOrderStatusReturned  StatusDeliveryOrder = "Returned"
 )
 
 func GetAllDeliveryStatus() []StatusDeliveryOrder {
@@ -13,5 +15,7 @@ func GetAllDeliveryStatus() []StatusDeliveryOrder {
 		DeliveryOrderStatusPending,
 		DeliveryOrderStatusShipped,
 		DeliveryOrderStatusDelivered,
+		DeliveryOrderStatusFailed,
+		DeliveryOrderStatusReturned,
 	}
 }
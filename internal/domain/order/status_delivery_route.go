@@ -0,0 +1,15 @@
+package orderentity
+
+type StatusDeliveryRoute string
+
+const (
+	DeliveryRouteStatusInProgress StatusDeliveryRoute = "InProgress"
+	DeliveryRouteStatusReturned   StatusDeliveryRoute = "Returned"
+)
+
+func GetAllDeliveryRouteStatus() []StatusDeliveryRoute {
+	return []StatusDeliveryRoute{
+		DeliveryRouteStatusInProgress,
+		DeliveryRouteStatusReturned,
+	}
+}
@@ -19,4 +19,7 @@ type TableOrderCommonAttributes struct {
 	Waiter   *employeeentity.Employee `bun:"rel:belongs-to" json:"waiter"`
 	OrderID  uuid.UUID                `bun:"column:order_id,type:uuid,notnull" json:"order_id"`
 	TableID  uuid.UUID                `bun:"column:table_id,type:uuid,notnull" json:"table_id"`
+	ClientID *uuid.UUID               `bun:"column:client_id,type:uuid" json:"client_id,omitempty"`
+	// CommissionID links the table to the waiter commission that paid it
+	CommissionID *uuid.UUID `bun:"column:commission_id,type:uuid" json:"commission_id,omitempty"`
 }
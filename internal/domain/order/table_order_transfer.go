@@ -0,0 +1,253 @@
+package orderentity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+)
+
+var (
+	ErrTransferSameOrder     = errors.New("source and target orders must be different")
+	ErrOrderMustBeOpen       = errors.New("order must be staging or pending")
+	ErrTransferEmpty         = errors.New("no groups or items to transfer")
+	ErrGroupNotFoundInOrder  = errors.New("group not found in the order")
+	ErrItemNotFoundInOrder   = errors.New("item not found in the order")
+	ErrCanceledGroupTransfer = errors.New("canceled group cannot be transferred")
+	ErrCanceledItemTransfer  = errors.New("canceled item cannot be transferred")
+)
+
+type TransferType string
+
+const (
+	TransferTypeMerge   TransferType = "Merge"
+	TransferTypePartial TransferType = "Partial"
+)
+
+func GetAllTransferTypes() []TransferType {
+	return []TransferType{
+		TransferTypeMerge,
+		TransferTypePartial,
+	}
+}
+
+// TableOrderTransfer is the audit of groups, items and payments moved between table orders.
+type TableOrderTransfer struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:table_order_transfers"`
+	TableOrderTransferCommonAttributes
+}
+
+type TableOrderTransferCommonAttributes struct {
+	Type               TransferType `bun:"type,notnull" json:"type"`
+	SourceTableOrderID uuid.UUID    `bun:"column:source_table_order_id,type:uuid,notnull" json:"source_table_order_id"`
+	TargetTableOrderID uuid.UUID    `bun:"column:target_table_order_id,type:uuid,notnull" json:"target_table_order_id"`
+	SourceOrderID      uuid.UUID    `bun:"column:source_order_id,type:uuid,notnull" json:"source_order_id"`
+	TargetOrderID      uuid.UUID    `bun:"column:target_order_id,type:uuid,notnull" json:"target_order_id"`
+	GroupIDs           []uuid.UUID  `bun:"group_ids,type:jsonb" json:"group_ids"`
+	ItemIDs            []uuid.UUID  `bun:"item_ids,type:jsonb" json:"item_ids"`
+	MovedPayments      int          `bun:"moved_payments" json:"moved_payments"`
+	TotalMoved         float64      `bun:"total_moved" json:"total_moved"`
+	UserID             *uuid.UUID   `bun:"column:user_id,type:uuid" json:"user_id,omitempty"`
+}
+
+// TransferResult holds the changes made in the groups by a transfer, to be saved with the orders.
+type TransferResult struct {
+	Transfer        *TableOrderTransfer
+	MovedItems      []itementity.Item
+	DeletedGroupIDs []uuid.UUID
+}
+
+func newTableOrderTransfer(transferType TransferType, source *TableOrder, target *TableOrder) *TableOrderTransfer {
+	return &TableOrderTransfer{
+		Entity: entity.NewEntity(),
+		TableOrderTransferCommonAttributes: TableOrderTransferCommonAttributes{
+			Type:               transferType,
+			SourceTableOrderID: source.ID,
+			TargetTableOrderID: target.ID,
+			SourceOrderID:      source.OrderID,
+			TargetOrderID:      target.OrderID,
+			GroupIDs:           []uuid.UUID{},
+			ItemIDs:            []uuid.UUID{},
+		},
+	}
+}
+
+func (o *Order) IsOpen() bool {
+	return o.Status == OrderStatusStaging || o.Status == OrderStatusPending
+}
+
+// MergeInto moves every group not canceled, every payment and the discount to the target order, the
+// source order is canceled after it.
+func (o *Order) MergeInto(target *Order, source *TableOrder, targetTable *TableOrder) (*TransferResult, error) {
+	if err := o.validateTransfer(target); err != nil {
+		return nil, err
+	}
+
+	transfer := newTableOrderTransfer(TransferTypeMerge, source, targetTable)
+	totalBefore := target.TotalPayable
+
+	remaining := []groupitementity.GroupItem{}
+	for _, group := range o.Groups {
+		if group.Status == groupitementity.StatusGroupCanceled {
+			remaining = append(remaining, group)
+			continue
+		}
+
+		group.OrderID = target.ID
+		target.Groups = append(target.Groups, group)
+		transfer.GroupIDs = append(transfer.GroupIDs, group.ID)
+	}
+
+	o.Groups = remaining
+
+	for _, payment := range o.Payments {
+		payment.OrderID = target.ID
+		target.AddPayment(&payment)
+		transfer.MovedPayments++
+	}
+
+	o.Payments = []PaymentOrder{}
+	o.TotalPaid = 0
+	o.TotalChange = 0
+
+	// The discount follows the loyalty redemption, moved with the order
+	target.Discount += o.Discount
+	o.Discount = 0
+
+	o.recalculateTransfer(target)
+	transfer.TotalMoved = target.TotalPayable - totalBefore
+	o.Observation = strings.TrimSpace(fmt.Sprintf("%s merged into order %d", o.Observation, target.OrderNumber))
+
+	if err := o.CancelOrder(); err != nil {
+		return nil, err
+	}
+
+	return &TransferResult{Transfer: transfer}, nil
+}
+
+// TransferTo moves the groups and the items chosen to the target order. The items are moved to a new
+// group in the target, with the same details of the group they came from.
+func (o *Order) TransferTo(target *Order, source *TableOrder, targetTable *TableOrder, groupIDs []uuid.UUID, itemIDs []uuid.UUID) (*TransferResult, error) {
+	if err := o.validateTransfer(target); err != nil {
+		return nil, err
+	}
+
+	if len(groupIDs) == 0 && len(itemIDs) == 0 {
+		return nil, ErrTransferEmpty
+	}
+
+	result := &TransferResult{Transfer: newTableOrderTransfer(TransferTypePartial, source, targetTable)}
+	totalBefore := target.TotalPayable
+
+	for _, groupID := range groupIDs {
+		index := o.findGroup(groupID)
+
+		if index == -1 {
+			return nil, ErrGroupNotFoundInOrder
+		}
+
+		group := o.Groups[index]
+		if group.Status == groupitementity.StatusGroupCanceled {
+			return nil, ErrCanceledGroupTransfer
+		}
+
+		group.OrderID = target.ID
+		target.Groups = append(target.Groups, group)
+		o.Groups = append(o.Groups[:index], o.Groups[index+1:]...)
+		result.Transfer.GroupIDs = append(result.Transfer.GroupIDs, groupID)
+	}
+
+	newGroups := map[uuid.UUID]int{}
+	for _, itemID := range itemIDs {
+		groupIndex, itemIndex := o.findItem(itemID)
+
+		if groupIndex == -1 {
+			return nil, ErrItemNotFoundInOrder
+		}
+
+		group := &o.Groups[groupIndex]
+		item := group.Items[itemIndex]
+
+		if item.Status == itementity.StatusItemCanceled || group.Status == groupitementity.StatusGroupCanceled {
+			return nil, ErrCanceledItemTransfer
+		}
+
+		targetIndex, ok := newGroups[group.ID]
+		if !ok {
+			target.Groups = append(target.Groups, *group.CopyToOrder(target.ID))
+			targetIndex = len(target.Groups) - 1
+			newGroups[group.ID] = targetIndex
+		}
+
+		item.GroupItemID = target.Groups[targetIndex].ID
+		target.Groups[targetIndex].Items = append(target.Groups[targetIndex].Items, item)
+		group.Items = append(group.Items[:itemIndex], group.Items[itemIndex+1:]...)
+
+		result.MovedItems = append(result.MovedItems, item)
+		result.Transfer.ItemIDs = append(result.Transfer.ItemIDs, itemID)
+	}
+
+	remaining := []groupitementity.GroupItem{}
+	for _, group := range o.Groups {
+		if _, ok := newGroups[group.ID]; ok && len(group.Items) == 0 {
+			result.DeletedGroupIDs = append(result.DeletedGroupIDs, group.ID)
+			continue
+		}
+
+		remaining = append(remaining, group)
+	}
+
+	o.Groups = remaining
+
+	o.recalculateTransfer(target)
+	result.Transfer.TotalMoved = target.TotalPayable - totalBefore
+
+	return result, nil
+}
+
+func (o *Order) validateTransfer(target *Order) error {
+	if o.ID == target.ID {
+		return ErrTransferSameOrder
+	}
+
+	if !o.IsOpen() || !target.IsOpen() {
+		return ErrOrderMustBeOpen
+	}
+
+	return nil
+}
+
+func (o *Order) recalculateTransfer(target *Order) {
+	o.CalculateTotalPrice()
+	o.CalculateTotalChange()
+	target.CalculateTotalPrice()
+	target.CalculateTotalChange()
+}
+
+func (o *Order) findGroup(groupID uuid.UUID) int {
+	for i := range o.Groups {
+		if o.Groups[i].ID == groupID {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (o *Order) findItem(itemID uuid.UUID) (groupIndex int, itemIndex int) {
+	for i := range o.Groups {
+		for j := range o.Groups[i].Items {
+			if o.Groups[i].Items[j].ID == itemID {
+				return i, j
+			}
+		}
+	}
+
+	return -1, -1
+}
@@ -0,0 +1,89 @@
+package orderentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+)
+
+func newTransferOrder(prices ...float64) *Order {
+	order := NewDefaultOrder(nil, 1, nil)
+	order.Status = OrderStatusPending
+
+	group := groupitementity.NewGroupItem(groupitementity.GroupCommonAttributes{OrderID: order.ID})
+	for _, price := range prices {
+		item := itementity.NewItem(itementity.ItemCommonAttributes{Name: "item", Price: price, Quantity: 1})
+		item.GroupItemID = group.ID
+		group.Items = append(group.Items, *item)
+	}
+
+	order.Groups = append(order.Groups, *group)
+	order.CalculateTotalPrice()
+	return order
+}
+
+func newTransferTables(source *Order, target *Order) (*TableOrder, *TableOrder) {
+	sourceTable := &TableOrder{Entity: entity.NewEntity(), TableOrderCommonAttributes: TableOrderCommonAttributes{OrderID: source.ID}}
+	targetTable := &TableOrder{Entity: entity.NewEntity(), TableOrderCommonAttributes: TableOrderCommonAttributes{OrderID: target.ID}}
+	return sourceTable, targetTable
+}
+
+func TestOrderMergeInto(t *testing.T) {
+	source, target := newTransferOrder(10, 20), newTransferOrder(5)
+	source.AddPayment(&PaymentOrder{TotalPaid: 15, Method: Visa, OrderID: source.ID})
+	sourceTable, targetTable := newTransferTables(source, target)
+
+	_, err := source.ApplyDiscount(4)
+	assert.Nil(t, err)
+
+	_, err = source.MergeInto(source, sourceTable, sourceTable)
+	assert.Equal(t, ErrTransferSameOrder, err)
+
+	result, err := source.MergeInto(target, sourceTable, targetTable)
+	assert.Nil(t, err)
+	assert.Equal(t, 31.0, target.TotalPayable)
+	assert.Equal(t, 4.0, target.Discount)
+	assert.Equal(t, 0.0, source.Discount)
+	assert.Equal(t, 15.0, target.TotalPaid)
+	assert.Equal(t, target.ID, target.Payments[0].OrderID)
+	assert.Equal(t, 0.0, source.TotalPayable)
+	assert.Equal(t, OrderStatusCanceled, source.Status)
+	assert.Equal(t, 1, result.Transfer.MovedPayments)
+	assert.Equal(t, 26.0, result.Transfer.TotalMoved)
+
+	_, err = source.MergeInto(target, sourceTable, targetTable)
+	assert.Equal(t, ErrOrderMustBeOpen, err)
+}
+
+func TestOrderTransferTo(t *testing.T) {
+	source, target := newTransferOrder(10, 20), newTransferOrder(5)
+	sourceTable, targetTable := newTransferTables(source, target)
+	first, second := source.Groups[0].Items[0], source.Groups[0].Items[1]
+
+	source.Groups[0].Items[1].CancelItem()
+	_, err := source.TransferTo(target, sourceTable, targetTable, nil, []uuid.UUID{second.ID})
+	assert.Equal(t, ErrCanceledItemTransfer, err)
+
+	_, err = source.TransferTo(target, sourceTable, targetTable, nil, []uuid.UUID{uuid.New()})
+	assert.Equal(t, ErrItemNotFoundInOrder, err)
+
+	result, err := source.TransferTo(target, sourceTable, targetTable, nil, []uuid.UUID{first.ID})
+	assert.Nil(t, err)
+	assert.Len(t, target.Groups, 2)
+	assert.Equal(t, target.Groups[1].ID, result.MovedItems[0].GroupItemID)
+	assert.Equal(t, 15.0, target.TotalPayable)
+	assert.Equal(t, 20.0, source.TotalPayable)
+	assert.Empty(t, result.DeletedGroupIDs)
+
+	// Moving a whole group keeps its id
+	groupID := target.Groups[0].ID
+	result, err = target.TransferTo(source, targetTable, sourceTable, []uuid.UUID{groupID}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{groupID}, result.Transfer.GroupIDs)
+	assert.Equal(t, 25.0, source.TotalPayable)
+	assert.Equal(t, 10.0, target.TotalPayable)
+}
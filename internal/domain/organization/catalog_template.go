@@ -0,0 +1,279 @@
+package organizationentity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+var (
+	ErrCatalogTemplateEmpty = errors.New("catalog template has no categories")
+)
+
+type CatalogTemplate struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:catalog_templates"`
+	CatalogTemplateCommonAttributes
+}
+
+type CatalogTemplateCommonAttributes struct {
+	OrganizationID  uuid.UUID          `bun:"column:organization_id,type:uuid,notnull" json:"organization_id"`
+	Name            string             `bun:"name,notnull" json:"name"`
+	SourceCompanyID uuid.UUID          `bun:"column:source_company_id,type:uuid,notnull" json:"source_company_id"`
+	Categories      []TemplateCategory `bun:"categories,type:jsonb" json:"categories"`
+}
+
+// TemplateCategory is a snapshot of the category, linked by names instead of ids to be applied in any branch.
+type TemplateCategory struct {
+	Name                 string                `json:"name"`
+	ImagePath            string                `json:"image_path"`
+	NeedPrint            bool                  `json:"need_print"`
+	RemovableIngredients []string              `json:"removable_ingredients,omitempty"`
+	Sizes                []TemplateSize        `json:"sizes,omitempty"`
+	Quantities           []float64             `json:"quantities,omitempty"`
+	ProcessRules         []TemplateProcessRule `json:"process_rules,omitempty"`
+	Products             []TemplateProduct     `json:"products,omitempty"`
+}
+
+type TemplateSize struct {
+	Name   string `json:"name"`
+	Active *bool  `json:"active"`
+}
+
+type TemplateProcessRule struct {
+	Name              string         `json:"name"`
+	Order             int8           `json:"order"`
+	IdealTime         *time.Duration `json:"ideal_time"`
+	ExperimentalError *time.Duration `json:"experimental_error"`
+}
+
+type TemplateProduct struct {
+	Code        string  `json:"code"`
+	Name        string  `json:"name"`
+	ImagePath   *string `json:"image_path"`
+	Description string  `json:"description"`
+	Price       float64 `json:"price"`
+	Cost        float64 `json:"cost"`
+	IsAvailable bool    `json:"is_available"`
+	SizeName    string  `json:"size_name"`
+}
+
+// CatalogPlan holds the rows to be written in the branch to apply a template.
+type CatalogPlan struct {
+	Categories      []productentity.Category    `json:"categories"`
+	Sizes           []productentity.Size        `json:"sizes"`
+	Quantities      []productentity.Quantity    `json:"quantities"`
+	ProcessRules    []productentity.ProcessRule `json:"process_rules"`
+	Products        []productentity.Product     `json:"products"`
+	UpdatedProducts []productentity.Product     `json:"updated_products"`
+}
+
+func NewCatalogTemplate(organizationID uuid.UUID, sourceCompanyID uuid.UUID, name string, categories []productentity.Category) (*CatalogTemplate, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+
+	if len(categories) == 0 {
+		return nil, ErrCatalogTemplateEmpty
+	}
+
+	templateCategories := make([]TemplateCategory, 0, len(categories))
+	for _, category := range categories {
+		templateCategories = append(templateCategories, newTemplateCategory(category))
+	}
+
+	return &CatalogTemplate{
+		Entity: entity.NewEntity(),
+		CatalogTemplateCommonAttributes: CatalogTemplateCommonAttributes{
+			OrganizationID:  organizationID,
+			Name:            name,
+			SourceCompanyID: sourceCompanyID,
+			Categories:      templateCategories,
+		},
+	}, nil
+}
+
+func newTemplateCategory(category productentity.Category) TemplateCategory {
+	templateCategory := TemplateCategory{
+		Name:                 category.Name,
+		ImagePath:            category.ImagePath,
+		NeedPrint:            category.NeedPrint,
+		RemovableIngredients: category.RemovableIngredients,
+	}
+
+	sizeNames := map[uuid.UUID]string{}
+	for _, size := range category.Sizes {
+		sizeNames[size.ID] = size.Name
+		templateCategory.Sizes = append(templateCategory.Sizes, TemplateSize{Name: size.Name, Active: size.Active})
+	}
+
+	for _, quantity := range category.Quantities {
+		templateCategory.Quantities = append(templateCategory.Quantities, quantity.Quantity)
+	}
+
+	for _, rule := range category.ProcessRules {
+		templateCategory.ProcessRules = append(templateCategory.ProcessRules, TemplateProcessRule{
+			Name:              rule.Name,
+			Order:             rule.Order,
+			IdealTime:         rule.IdealTime,
+			ExperimentalError: rule.ExperimentalError,
+		})
+	}
+
+	for _, product := range category.Products {
+		templateCategory.Products = append(templateCategory.Products, TemplateProduct{
+			Code:        product.Code,
+			Name:        product.Name,
+			ImagePath:   product.ImagePath,
+			Description: product.Description,
+			Price:       product.Price,
+			Cost:        product.Cost,
+			IsAvailable: product.IsAvailable,
+			SizeName:    sizeNames[product.SizeID],
+		})
+	}
+
+	return templateCategory
+}
+
+// Plan compares the template with the current catalog of the branch.
+// Categories, sizes and process rules are matched by name, quantities by value and products by code;
+// missing rows are created and existing products are updated, nothing is removed from the branch.
+func (t *CatalogTemplate) Plan(current []productentity.Category) *CatalogPlan {
+	plan := &CatalogPlan{}
+
+	productsByCode := map[string]productentity.Product{}
+	for _, category := range current {
+		for _, product := range category.Products {
+			productsByCode[product.Code] = product
+		}
+	}
+
+	for _, templateCategory := range t.Categories {
+		category := findCategoryByName(current, templateCategory.Name)
+
+		if category == nil {
+			category = productentity.NewCategory(productentity.CategoryCommonAttributes{
+				Name:                 templateCategory.Name,
+				ImagePath:            templateCategory.ImagePath,
+				NeedPrint:            templateCategory.NeedPrint,
+				RemovableIngredients: templateCategory.RemovableIngredients,
+			})
+
+			plan.Categories = append(plan.Categories, *category)
+		}
+
+		sizeIDs := map[string]uuid.UUID{}
+		for _, size := range category.Sizes {
+			sizeIDs[strings.ToLower(size.Name)] = size.ID
+		}
+
+		for _, templateSize := range templateCategory.Sizes {
+			if _, ok := sizeIDs[strings.ToLower(templateSize.Name)]; ok {
+				continue
+			}
+
+			size := productentity.Size{
+				Entity: entity.NewEntity(),
+				SizeCommonAttributes: productentity.SizeCommonAttributes{
+					Name:       templateSize.Name,
+					Active:     templateSize.Active,
+					CategoryID: category.ID,
+				},
+			}
+
+			sizeIDs[strings.ToLower(size.Name)] = size.ID
+			plan.Sizes = append(plan.Sizes, size)
+		}
+
+		for _, value := range templateCategory.Quantities {
+			if hasQuantity(category.Quantities, value) {
+				continue
+			}
+
+			plan.Quantities = append(plan.Quantities, productentity.Quantity{
+				Entity:                   entity.NewEntity(),
+				QuantityCommonAttributes: productentity.QuantityCommonAttributes{Quantity: value, CategoryID: category.ID},
+			})
+		}
+
+		for _, templateRule := range templateCategory.ProcessRules {
+			if hasProcessRule(category.ProcessRules, templateRule.Name) {
+				continue
+			}
+
+			plan.ProcessRules = append(plan.ProcessRules, *productentity.NewProcessRule(productentity.ProcessRuleCommonAttributes{
+				Name:              templateRule.Name,
+				Order:             templateRule.Order,
+				IdealTime:         templateRule.IdealTime,
+				ExperimentalError: templateRule.ExperimentalError,
+				CategoryID:        category.ID,
+			}))
+		}
+
+		for _, templateProduct := range templateCategory.Products {
+			sizeID, ok := sizeIDs[strings.ToLower(templateProduct.SizeName)]
+			if !ok {
+				continue
+			}
+
+			attributes := productentity.ProductCommonAttributes{
+				Code:        templateProduct.Code,
+				Name:        templateProduct.Name,
+				ImagePath:   templateProduct.ImagePath,
+				Description: templateProduct.Description,
+				Price:       templateProduct.Price,
+				Cost:        templateProduct.Cost,
+				IsAvailable: templateProduct.IsAvailable,
+				CategoryID:  category.ID,
+				SizeID:      sizeID,
+			}
+
+			if product, ok := productsByCode[templateProduct.Code]; ok {
+				product.ProductCommonAttributes = attributes
+				plan.UpdatedProducts = append(plan.UpdatedProducts, product)
+				continue
+			}
+
+			plan.Products = append(plan.Products, productentity.Product{Entity: entity.NewEntity(), ProductCommonAttributes: attributes})
+		}
+	}
+
+	return plan
+}
+
+func findCategoryByName(categories []productentity.Category, name string) *productentity.Category {
+	for i := range categories {
+		if strings.EqualFold(categories[i].Name, name) {
+			return &categories[i]
+		}
+	}
+
+	return nil
+}
+
+func hasQuantity(quantities []productentity.Quantity, value float64) bool {
+	for _, quantity := range quantities {
+		if quantity.Quantity == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasProcessRule(rules []productentity.ProcessRule, name string) bool {
+	for _, rule := range rules {
+		if strings.EqualFold(rule.Name, name) {
+			return true
+		}
+	}
+
+	return false
+}
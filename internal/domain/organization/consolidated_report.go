@@ -0,0 +1,136 @@
+package organizationentity
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+const TopProductsLimit = 10
+
+type ProductSales struct {
+	Name     string  `bun:"name" json:"name"`
+	Quantity float64 `bun:"quantity" json:"quantity"`
+	Total    float64 `bun:"total" json:"total"`
+}
+
+type PaymentSales struct {
+	Method orderentity.PayMethod `bun:"method" json:"method"`
+	Count  int                   `bun:"count" json:"count"`
+	Total  float64               `bun:"total" json:"total"`
+	Share  float64               `bun:"-" json:"share"`
+}
+
+type SalesSummary struct {
+	TotalOrders   int            `json:"total_orders"`
+	TotalSales    float64        `json:"total_sales"`
+	AverageTicket float64        `json:"average_ticket"`
+	TopProducts   []ProductSales `json:"top_products"`
+	PaymentMix    []PaymentSales `json:"payment_mix"`
+}
+
+type BranchReport struct {
+	CompanyID uuid.UUID `json:"company_id"`
+	TradeName string    `json:"trade_name"`
+	SalesSummary
+}
+
+type ConsolidatedReport struct {
+	OrganizationID uuid.UUID      `json:"organization_id"`
+	StartAt        time.Time      `json:"start_at"`
+	EndAt          time.Time      `json:"end_at"`
+	Branches       []BranchReport `json:"branches"`
+	SalesSummary
+}
+
+// Finish ranks the products and payments and computes the average ticket and payment shares.
+func (s *SalesSummary) Finish() {
+	sort.Slice(s.TopProducts, func(i, j int) bool {
+		if s.TopProducts[i].Total == s.TopProducts[j].Total {
+			return s.TopProducts[i].Name < s.TopProducts[j].Name
+		}
+
+		return s.TopProducts[i].Total > s.TopProducts[j].Total
+	})
+
+	if len(s.TopProducts) > TopProductsLimit {
+		s.TopProducts = s.TopProducts[:TopProductsLimit]
+	}
+
+	sort.Slice(s.PaymentMix, func(i, j int) bool {
+		return s.PaymentMix[i].Total > s.PaymentMix[j].Total
+	})
+
+	s.AverageTicket = 0
+	if s.TotalOrders > 0 {
+		s.AverageTicket = s.TotalSales / float64(s.TotalOrders)
+	}
+
+	totalPaid := 0.0
+	for _, payment := range s.PaymentMix {
+		totalPaid += payment.Total
+	}
+
+	for i := range s.PaymentMix {
+		s.PaymentMix[i].Share = 0
+		if totalPaid > 0 {
+			s.PaymentMix[i].Share = s.PaymentMix[i].Total / totalPaid * 100
+		}
+	}
+}
+
+// NewConsolidatedReport sums the branches, products are grouped by name and payments by method.
+// The branches must hold all their products, they are ranked only after being merged.
+func NewConsolidatedReport(organizationID uuid.UUID, startAt time.Time, endAt time.Time, branches []BranchReport) *ConsolidatedReport {
+	report := &ConsolidatedReport{
+		OrganizationID: organizationID,
+		StartAt:        startAt,
+		EndAt:          endAt,
+		Branches:       branches,
+	}
+
+	products := map[string]*ProductSales{}
+	payments := map[orderentity.PayMethod]*PaymentSales{}
+
+	for _, branch := range branches {
+		report.TotalOrders += branch.TotalOrders
+		report.TotalSales += branch.TotalSales
+
+		for _, product := range branch.TopProducts {
+			if _, ok := products[product.Name]; !ok {
+				products[product.Name] = &ProductSales{Name: product.Name}
+			}
+
+			products[product.Name].Quantity += product.Quantity
+			products[product.Name].Total += product.Total
+		}
+
+		for _, payment := range branch.PaymentMix {
+			if _, ok := payments[payment.Method]; !ok {
+				payments[payment.Method] = &PaymentSales{Method: payment.Method}
+			}
+
+			payments[payment.Method].Count += payment.Count
+			payments[payment.Method].Total += payment.Total
+		}
+	}
+
+	report.TopProducts = make([]ProductSales, 0, len(products))
+	for _, product := range products {
+		report.TopProducts = append(report.TopProducts, *product)
+	}
+
+	report.PaymentMix = make([]PaymentSales, 0, len(payments))
+	for _, payment := range payments {
+		report.PaymentMix = append(report.PaymentMix, *payment)
+	}
+
+	for i := range report.Branches {
+		report.Branches[i].Finish()
+	}
+
+	report.Finish()
+	return report
+}
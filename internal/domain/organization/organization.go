@@ -0,0 +1,106 @@
+package organizationentity
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrNameRequired              = errors.New("name is required")
+	ErrOnlyOwnerCanManage        = errors.New("only the owner can manage the organization")
+	ErrOnlyCompanyOwnerCanAdd    = errors.New("only the owner of the company can add it to an organization")
+	ErrCompanyAlreadyInOrg       = errors.New("company already belongs to an organization")
+	ErrCompanyNotInOrganization  = errors.New("company not found in organization")
+	ErrUserWithoutAccessToAll    = errors.New("user must have access to all companies of the organization")
+	ErrOrganizationWithoutBranch = errors.New("organization has no companies")
+)
+
+type Organization struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:organizations"`
+	OrganizationCommonAttributes
+}
+
+type OrganizationCommonAttributes struct {
+	Name      string                           `bun:"name,notnull" json:"name"`
+	OwnerID   uuid.UUID                        `bun:"column:owner_id,type:uuid,notnull" json:"owner_id"`
+	Companies []companyentity.CompanyWithUsers `bun:"m2m:organization_to_companies,join:Organization=Company" json:"companies,omitempty"`
+}
+
+// OrganizationToCompanies links the public companies, each company belongs to a single organization.
+type OrganizationToCompanies struct {
+	OrganizationID uuid.UUID                       `bun:"type:uuid,pk"`
+	Organization   *Organization                   `bun:"rel:belongs-to,join:organization_id=id"`
+	CompanyID      uuid.UUID                       `bun:"type:uuid,pk,unique"`
+	Company        *companyentity.CompanyWithUsers `bun:"rel:belongs-to,join:company_id=id"`
+}
+
+func NewOrganization(name string, ownerID uuid.UUID) (*Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+
+	return &Organization{
+		Entity: entity.NewEntity(),
+		OrganizationCommonAttributes: OrganizationCommonAttributes{
+			Name:    name,
+			OwnerID: ownerID,
+		},
+	}, nil
+}
+
+func (o *Organization) IsOwner(userID uuid.UUID) bool {
+	return o.OwnerID == userID
+}
+
+func (o *Organization) FindCompany(companyID uuid.UUID) (*companyentity.CompanyWithUsers, error) {
+	for i := range o.Companies {
+		if o.Companies[i].ID == companyID {
+			return &o.Companies[i], nil
+		}
+	}
+
+	return nil, ErrCompanyNotInOrganization
+}
+
+// CanBeAddedBy checks that the user owns the company before grouping it.
+func CanBeAddedBy(user *companyentity.User, companyID uuid.UUID) error {
+	for _, ctu := range user.CompanyToUsers {
+		if ctu.CompanyWithUsersID == companyID && ctu.Role == companyentity.RoleOwner {
+			return nil
+		}
+	}
+
+	return ErrOnlyCompanyOwnerCanAdd
+}
+
+// CheckAccessToAll is required by the consolidated data, a user can't see branches they don't belong to.
+func (o *Organization) CheckAccessToAll(user *companyentity.User) error {
+	if len(o.Companies) == 0 {
+		return ErrOrganizationWithoutBranch
+	}
+
+	for _, company := range o.Companies {
+		if !hasAccess(user, company.ID) {
+			return ErrUserWithoutAccessToAll
+		}
+	}
+
+	return nil
+}
+
+func hasAccess(user *companyentity.User, companyID uuid.UUID) bool {
+	for _, ctu := range user.CompanyToUsers {
+		if ctu.CompanyWithUsersID == companyID {
+			return true
+		}
+	}
+
+	return false
+}
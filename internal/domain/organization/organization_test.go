@@ -0,0 +1,123 @@
+package organizationentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+)
+
+func newCategory(name string, sizeName string, products ...productentity.ProductCommonAttributes) productentity.Category {
+	category := *productentity.NewCategory(productentity.CategoryCommonAttributes{Name: name})
+	size := productentity.Size{Entity: entity.NewEntity(), SizeCommonAttributes: productentity.SizeCommonAttributes{Name: sizeName, CategoryID: category.ID}}
+	category.Sizes = []productentity.Size{size}
+	category.Quantities = []productentity.Quantity{{Entity: entity.NewEntity(), QuantityCommonAttributes: productentity.QuantityCommonAttributes{Quantity: 1, CategoryID: category.ID}}}
+
+	for _, attributes := range products {
+		attributes.CategoryID = category.ID
+		attributes.SizeID = size.ID
+		category.Products = append(category.Products, productentity.Product{Entity: entity.NewEntity(), ProductCommonAttributes: attributes})
+	}
+
+	return category
+}
+
+func TestCatalogTemplatePlan(t *testing.T) {
+	source := []productentity.Category{
+		newCategory("Pizza", "Grande",
+			productentity.ProductCommonAttributes{Code: "P1", Name: "Calabresa", Price: 50},
+			productentity.ProductCommonAttributes{Code: "P2", Name: "Mussarela", Price: 45},
+		),
+		newCategory("Bebidas", "Lata", productentity.ProductCommonAttributes{Code: "B1", Name: "Refrigerante", Price: 6}),
+	}
+
+	template, err := NewCatalogTemplate(uuid.New(), uuid.New(), "Base", source)
+	assert.Nil(t, err)
+	assert.Equal(t, "Grande", template.Categories[0].Products[0].SizeName)
+
+	_, err = NewCatalogTemplate(uuid.New(), uuid.New(), "Base", nil)
+	assert.ErrorIs(t, err, ErrCatalogTemplateEmpty)
+
+	// Empty branch receives everything
+	plan := template.Plan(nil)
+	assert.Len(t, plan.Categories, 2)
+	assert.Len(t, plan.Sizes, 2)
+	assert.Len(t, plan.Quantities, 2)
+	assert.Len(t, plan.Products, 3)
+	assert.Empty(t, plan.UpdatedProducts)
+	assert.Equal(t, plan.Categories[0].ID, plan.Products[0].CategoryID)
+	assert.Equal(t, plan.Sizes[0].ID, plan.Products[0].SizeID)
+
+	// Branch with the pizza category and an old price keeps its ids
+	branch := []productentity.Category{newCategory("pizza", "grande", productentity.ProductCommonAttributes{Code: "P1", Name: "Calabresa", Price: 40})}
+	plan = template.Plan(branch)
+	assert.Len(t, plan.Categories, 1)
+	assert.Equal(t, "Bebidas", plan.Categories[0].Name)
+	assert.Len(t, plan.Sizes, 1)
+	assert.Len(t, plan.Quantities, 1)
+	assert.Len(t, plan.Products, 2)
+	assert.Len(t, plan.UpdatedProducts, 1)
+	assert.Equal(t, branch[0].Products[0].ID, plan.UpdatedProducts[0].ID)
+	assert.Equal(t, branch[0].ID, plan.UpdatedProducts[0].CategoryID)
+	assert.Equal(t, branch[0].Sizes[0].ID, plan.UpdatedProducts[0].SizeID)
+	assert.Equal(t, 50.0, plan.UpdatedProducts[0].Price)
+}
+
+func TestConsolidatedReport(t *testing.T) {
+	branches := []BranchReport{
+		{CompanyID: uuid.New(), SalesSummary: SalesSummary{
+			TotalOrders: 2, TotalSales: 100,
+			TopProducts: []ProductSales{{Name: "Calabresa", Quantity: 1, Total: 50}, {Name: "Refrigerante", Quantity: 5, Total: 30}},
+			PaymentMix:  []PaymentSales{{Method: orderentity.Dinheiro, Count: 1, Total: 25}, {Method: orderentity.Visa, Count: 1, Total: 75}},
+		}},
+		{CompanyID: uuid.New(), SalesSummary: SalesSummary{
+			TotalOrders: 3, TotalSales: 200,
+			TopProducts: []ProductSales{{Name: "Refrigerante", Quantity: 10, Total: 60}},
+			PaymentMix:  []PaymentSales{{Method: orderentity.Visa, Count: 3, Total: 200}},
+		}},
+	}
+
+	report := NewConsolidatedReport(uuid.New(), time.Now().AddDate(0, -1, 0), time.Now(), branches)
+
+	assert.Equal(t, 5, report.TotalOrders)
+	assert.Equal(t, 300.0, report.TotalSales)
+	assert.Equal(t, 60.0, report.AverageTicket)
+	assert.Equal(t, "Refrigerante", report.TopProducts[0].Name)
+	assert.Equal(t, 15.0, report.TopProducts[0].Quantity)
+	assert.Equal(t, orderentity.Visa, report.PaymentMix[0].Method)
+	assert.Equal(t, 4, report.PaymentMix[0].Count)
+	assert.InDelta(t, 91.66, report.PaymentMix[0].Share, 0.01)
+	assert.Equal(t, 50.0, report.Branches[0].AverageTicket)
+	assert.Equal(t, 75.0, report.Branches[0].PaymentMix[0].Share)
+}
+
+func TestOrganizationAccess(t *testing.T) {
+	owner := companyentity.NewUser(companyentity.UserCommonAttributes{})
+	first := companyentity.CompanyWithUsers{Entity: entity.NewEntity()}
+	second := companyentity.CompanyWithUsers{Entity: entity.NewEntity()}
+
+	organization, err := NewOrganization("Grupo", owner.ID)
+	assert.Nil(t, err)
+	assert.ErrorIs(t, organization.CheckAccessToAll(owner), ErrOrganizationWithoutBranch)
+
+	_, err = NewOrganization(" ", owner.ID)
+	assert.ErrorIs(t, err, ErrNameRequired)
+
+	owner.CompanyToUsers = []companyentity.CompanyToUsers{{CompanyWithUsersID: first.ID, Role: companyentity.RoleOwner}}
+	assert.Nil(t, CanBeAddedBy(owner, first.ID))
+	assert.ErrorIs(t, CanBeAddedBy(owner, second.ID), ErrOnlyCompanyOwnerCanAdd)
+
+	organization.Companies = []companyentity.CompanyWithUsers{first, second}
+	assert.ErrorIs(t, organization.CheckAccessToAll(owner), ErrUserWithoutAccessToAll)
+
+	owner.CompanyToUsers = append(owner.CompanyToUsers, companyentity.CompanyToUsers{CompanyWithUsersID: second.ID, Role: companyentity.RoleManager})
+	assert.Nil(t, organization.CheckAccessToAll(owner))
+
+	_, err = organization.FindCompany(uuid.New())
+	assert.ErrorIs(t, err, ErrCompanyNotInOrganization)
+}
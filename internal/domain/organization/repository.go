@@ -0,0 +1,28 @@
+package organizationentity
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationRepository interface {
+	CreateOrganization(ctx context.Context, organization *Organization) error
+	UpdateOrganization(ctx context.Context, organization *Organization) error
+	GetOrganizationById(ctx context.Context, id string) (*Organization, error)
+	GetOrganizationsByUserId(ctx context.Context, userID uuid.UUID) ([]Organization, error)
+	AddCompany(ctx context.Context, organizationID uuid.UUID, companyID uuid.UUID) error
+	RemoveCompany(ctx context.Context, organizationID uuid.UUID, companyID uuid.UUID) error
+}
+
+type CatalogTemplateRepository interface {
+	CreateCatalogTemplate(ctx context.Context, template *CatalogTemplate) error
+	GetCatalogTemplateById(ctx context.Context, id string) (*CatalogTemplate, error)
+	GetCatalogTemplatesByOrganizationId(ctx context.Context, organizationID string) ([]CatalogTemplate, error)
+	ApplyCatalogPlan(ctx context.Context, plan *CatalogPlan) error
+}
+
+type ReportRepository interface {
+	GetBranchSales(ctx context.Context, startAt time.Time, endAt time.Time) (*SalesSummary, error)
+}
@@ -57,8 +57,15 @@ func (p *Person) AddContact(contactInput *string, contactType ContactType) error
 
 func (p *Person) AddAddress(addressCommonAttributes *addressentity.AddressCommonAttributes) error {
 	addressCommonAttributes.ObjectID = p.ID
+	addressCommonAttributes.IsDefault = true
 	p.Address = addressentity.NewAddress(addressCommonAttributes)
 
+	if p.Address.Cep != "" {
+		if err := p.Address.SetCep(p.Address.Cep); err != nil {
+			return err
+		}
+	}
+
 	if err := p.Address.Validate(); err != nil {
 		return err
 	}
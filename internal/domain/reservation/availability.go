@@ -0,0 +1,195 @@
+package reservationentity
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+)
+
+// OpenTableOrderMargin is the minimum time a table with an open order is still considered busy.
+const OpenTableOrderMargin = 30 * time.Minute
+
+var (
+	ErrTableNotAvailable     = errors.New("table not available at this time")
+	ErrTablesBelowPartySize  = errors.New("tables capacity is below the party size")
+	ErrNoTableAvailableFound = errors.New("no table available for this party size")
+	ErrTableNotFoundInTables = errors.New("table not found")
+)
+
+// Occupation is the time a table is held by a reservation or an open order.
+type Occupation struct {
+	TableID       uuid.UUID  `json:"table_id"`
+	StartAt       time.Time  `json:"start_at"`
+	EndAt         time.Time  `json:"end_at"`
+	ReservationID *uuid.UUID `json:"reservation_id,omitempty"`
+	TableOrderID  *uuid.UUID `json:"table_order_id,omitempty"`
+}
+
+func (o Occupation) Overlaps(startAt time.Time, endAt time.Time) bool {
+	return o.StartAt.Before(endAt) && startAt.Before(o.EndAt)
+}
+
+// NewOccupations merges the active reservations and the open table orders, the reservation ignored is
+// the one being changed.
+func NewOccupations(reservations []Reservation, openTableOrders []orderentity.TableOrder, ignoreID uuid.UUID, now time.Time) []Occupation {
+	occupations := []Occupation{}
+
+	for i := range reservations {
+		reservation := &reservations[i]
+
+		if reservation.ID == ignoreID {
+			continue
+		}
+
+		// The first table of a seated reservation is represented by its table order, the extra ones
+		// stay busy until they are released
+		if reservation.Status == ReservationStatusSeated {
+			if !reservation.HoldsExtraTables() {
+				continue
+			}
+
+			endAt := reservation.EndAt()
+			if minEndAt := now.Add(OpenTableOrderMargin); endAt.Before(minEndAt) {
+				endAt = minEndAt
+			}
+
+			for _, tableID := range reservation.ExtraTableIDs() {
+				occupations = append(occupations, Occupation{
+					TableID:       tableID,
+					StartAt:       reservation.StartAt,
+					EndAt:         endAt,
+					ReservationID: &reservation.ID,
+				})
+			}
+
+			continue
+		}
+
+		if reservation.Status != ReservationStatusBooked {
+			continue
+		}
+
+		for _, tableID := range reservation.TableIDs {
+			occupations = append(occupations, Occupation{
+				TableID:       tableID,
+				StartAt:       reservation.StartAt,
+				EndAt:         reservation.EndAt(),
+				ReservationID: &reservation.ID,
+			})
+		}
+	}
+
+	for i := range openTableOrders {
+		tableOrder := &openTableOrders[i]
+		endAt := tableOrder.CreatedAt.Add(DefaultDuration * time.Minute)
+
+		if minEndAt := now.Add(OpenTableOrderMargin); endAt.Before(minEndAt) {
+			endAt = minEndAt
+		}
+
+		occupations = append(occupations, Occupation{
+			TableID:      tableOrder.TableID,
+			StartAt:      tableOrder.CreatedAt,
+			EndAt:        endAt,
+			TableOrderID: &tableOrder.ID,
+		})
+	}
+
+	return occupations
+}
+
+func IsTableFree(tableID uuid.UUID, startAt time.Time, endAt time.Time, occupations []Occupation) bool {
+	for _, occupation := range occupations {
+		if occupation.TableID == tableID && occupation.Overlaps(startAt, endAt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CheckTables validates the tables chosen for the reservation against its party size and the occupations.
+func (r *Reservation) CheckTables(tables []tableentity.Table, occupations []Occupation) error {
+	capacity := 0
+
+	for _, tableID := range r.TableIDs {
+		table := findTable(tables, tableID)
+
+		if table == nil {
+			return ErrTableNotFoundInTables
+		}
+
+		if !IsTableFree(tableID, r.StartAt, r.EndAt(), occupations) {
+			return ErrTableNotAvailable
+		}
+
+		capacity += table.Capacity
+	}
+
+	if capacity < r.PartySize {
+		return ErrTablesBelowPartySize
+	}
+
+	return nil
+}
+
+type Suggestion struct {
+	Tables   []tableentity.Table `json:"tables"`
+	Capacity int                 `json:"capacity"`
+}
+
+// FindAvailability suggests the free tables that fit the party, smallest first. When no table fits alone,
+// the largest free tables are joined.
+func FindAvailability(tables []tableentity.Table, partySize int, startAt time.Time, endAt time.Time, occupations []Occupation) ([]Suggestion, error) {
+	if partySize <= 0 {
+		return nil, ErrPartySizeInvalid
+	}
+
+	free := []tableentity.Table{}
+	for _, table := range tables {
+		if table.IsAvailable && IsTableFree(table.ID, startAt, endAt, occupations) {
+			free = append(free, table)
+		}
+	}
+
+	sort.SliceStable(free, func(i, j int) bool {
+		return free[i].Capacity < free[j].Capacity
+	})
+
+	suggestions := []Suggestion{}
+	for _, table := range free {
+		if table.Capacity >= partySize {
+			suggestions = append(suggestions, Suggestion{Tables: []tableentity.Table{table}, Capacity: table.Capacity})
+		}
+	}
+
+	if len(suggestions) > 0 {
+		return suggestions, nil
+	}
+
+	combination := Suggestion{Tables: []tableentity.Table{}}
+	for i := len(free) - 1; i >= 0 && combination.Capacity < partySize; i-- {
+		combination.Tables = append(combination.Tables, free[i])
+		combination.Capacity += free[i].Capacity
+	}
+
+	if combination.Capacity < partySize {
+		return nil, ErrNoTableAvailableFound
+	}
+
+	return []Suggestion{combination}, nil
+}
+
+func findTable(tables []tableentity.Table, id uuid.UUID) *tableentity.Table {
+	for i := range tables {
+		if tables[i].ID == id {
+			return &tables[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package reservationentity
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	CreateReservation(ctx context.Context, reservation *Reservation) error
+	UpdateReservation(ctx context.Context, reservation *Reservation) error
+	GetReservationById(ctx context.Context, id string) (*Reservation, error)
+	GetReservationsByPeriod(ctx context.Context, startAt time.Time, endAt time.Time) ([]Reservation, error)
+	GetReservationByTableOrderId(ctx context.Context, tableOrderID string) (*Reservation, error)
+	GetReservationsByClientId(ctx context.Context, clientID string) ([]Reservation, error)
+	GetActiveReservationsByPeriod(ctx context.Context, startAt time.Time, endAt time.Time) ([]Reservation, error)
+}
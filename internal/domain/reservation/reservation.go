@@ -0,0 +1,173 @@
+package reservationentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+// DefaultDuration is the time in minutes a table is kept for a reservation.
+const DefaultDuration = 120
+
+var (
+	ErrNameOrClientRequired     = errors.New("name or client is required")
+	ErrPartySizeInvalid         = errors.New("party size must be positive")
+	ErrStartAtRequired          = errors.New("start at is required")
+	ErrDurationInvalid          = errors.New("duration must be positive")
+	ErrTablesRequired           = errors.New("at least one table is required")
+	ErrReservationMustBeBooked  = errors.New("reservation must be booked")
+	ErrReservationNotStartedYet = errors.New("reservation not started yet")
+	ErrReservationMustBeSeated  = errors.New("reservation must be seated")
+	ErrTablesAlreadyReleased    = errors.New("reservation tables already released")
+)
+
+type Reservation struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:reservations"`
+	ReservationTimeLogs
+	ReservationCommonAttributes
+}
+
+type ReservationCommonAttributes struct {
+	ClientID     *uuid.UUID           `bun:"column:client_id,type:uuid" json:"client_id,omitempty"`
+	Client       *cliententity.Client `bun:"rel:belongs-to" json:"client,omitempty"`
+	Name         string               `bun:"name,notnull" json:"name"`
+	Contact      string               `bun:"contact" json:"contact,omitempty"`
+	PartySize    int                  `bun:"party_size,notnull" json:"party_size"`
+	StartAt      time.Time            `bun:"start_at,notnull" json:"start_at"`
+	Duration     int                  `bun:"duration,notnull" json:"duration"`
+	Status       StatusReservation    `bun:"status,notnull" json:"status"`
+	TableIDs     []uuid.UUID          `bun:"table_ids,type:jsonb" json:"table_ids"`
+	TableOrderID *uuid.UUID           `bun:"column:table_order_id,type:uuid" json:"table_order_id,omitempty"`
+	Observation  string               `bun:"observation" json:"observation,omitempty"`
+}
+
+type ReservationTimeLogs struct {
+	SeatedAt   *time.Time `bun:"seated_at" json:"seated_at,omitempty"`
+	NoShowAt   *time.Time `bun:"no_show_at" json:"no_show_at,omitempty"`
+	CanceledAt *time.Time `bun:"canceled_at" json:"canceled_at,omitempty"`
+	ReleasedAt *time.Time `bun:"released_at" json:"released_at,omitempty"`
+}
+
+type PatchReservation struct {
+	Name        *string     `json:"name"`
+	Contact     *string     `json:"contact"`
+	PartySize   *int        `json:"party_size"`
+	StartAt     *time.Time  `json:"start_at"`
+	Duration    *int        `json:"duration"`
+	TableIDs    []uuid.UUID `json:"table_ids"`
+	Observation *string     `json:"observation"`
+}
+
+func NewReservation(reservationCommonAttributes ReservationCommonAttributes) *Reservation {
+	reservationCommonAttributes.Status = ReservationStatusBooked
+
+	if reservationCommonAttributes.Duration == 0 {
+		reservationCommonAttributes.Duration = DefaultDuration
+	}
+
+	return &Reservation{
+		Entity:                      entity.NewEntity(),
+		ReservationCommonAttributes: reservationCommonAttributes,
+	}
+}
+
+func (r *Reservation) Validate() error {
+	if r.Name == "" && r.ClientID == nil {
+		return ErrNameOrClientRequired
+	}
+
+	if r.PartySize <= 0 {
+		return ErrPartySizeInvalid
+	}
+
+	if r.StartAt.IsZero() {
+		return ErrStartAtRequired
+	}
+
+	if r.Duration <= 0 {
+		return ErrDurationInvalid
+	}
+
+	if len(r.TableIDs) == 0 {
+		return ErrTablesRequired
+	}
+
+	return nil
+}
+
+func (r *Reservation) EndAt() time.Time {
+	return r.StartAt.Add(time.Duration(r.Duration) * time.Minute)
+}
+
+// IsActive tells if the reservation still holds its tables.
+func (r *Reservation) IsActive() bool {
+	return r.Status == ReservationStatusBooked || r.Status == ReservationStatusSeated
+}
+
+func (r *Reservation) Seat(tableOrderID uuid.UUID, now time.Time) error {
+	if r.Status != ReservationStatusBooked {
+		return ErrReservationMustBeBooked
+	}
+
+	r.Status = ReservationStatusSeated
+	r.SeatedAt = &now
+	r.TableOrderID = &tableOrderID
+	return nil
+}
+
+// ExtraTableIDs are the tables locked when seated, the first one is held by the table order.
+func (r *Reservation) ExtraTableIDs() []uuid.UUID {
+	if len(r.TableIDs) <= 1 {
+		return nil
+	}
+
+	return r.TableIDs[1:]
+}
+
+// HoldsExtraTables tells if the extra tables are still locked by the seated reservation.
+func (r *Reservation) HoldsExtraTables() bool {
+	return r.Status == ReservationStatusSeated && r.ReleasedAt == nil
+}
+
+// ReleaseTables marks the extra tables as released when the table order is finished, canceled or transferred.
+func (r *Reservation) ReleaseTables(now time.Time) error {
+	if r.Status != ReservationStatusSeated {
+		return ErrReservationMustBeSeated
+	}
+
+	if r.ReleasedAt != nil {
+		return ErrTablesAlreadyReleased
+	}
+
+	r.ReleasedAt = &now
+	return nil
+}
+
+func (r *Reservation) MarkNoShow(now time.Time) error {
+	if r.Status != ReservationStatusBooked {
+		return ErrReservationMustBeBooked
+	}
+
+	if now.Before(r.StartAt) {
+		return ErrReservationNotStartedYet
+	}
+
+	r.Status = ReservationStatusNoShow
+	r.NoShowAt = &now
+	return nil
+}
+
+func (r *Reservation) Cancel(now time.Time) error {
+	if r.Status != ReservationStatusBooked {
+		return ErrReservationMustBeBooked
+	}
+
+	r.Status = ReservationStatusCanceled
+	r.CanceledAt = &now
+	return nil
+}
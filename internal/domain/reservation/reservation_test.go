@@ -0,0 +1,97 @@
+package reservationentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+)
+
+func newTable(name string, capacity int) tableentity.Table {
+	return tableentity.Table{
+		Entity:                entity.NewEntity(),
+		TableCommonAttributes: tableentity.TableCommonAttributes{Name: name, Capacity: capacity, IsAvailable: true},
+	}
+}
+
+func TestReservationConflictsAndAvailability(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 18, 0, 0, 0, time.UTC)
+	small, medium, large := newTable("1", 2), newTable("2", 4), newTable("3", 6)
+	tables := []tableentity.Table{small, medium, large}
+
+	booked := NewReservation(ReservationCommonAttributes{Name: "Ana", PartySize: 4, StartAt: now.Add(2 * time.Hour), TableIDs: []uuid.UUID{medium.ID}})
+	assert.Nil(t, booked.Validate())
+
+	openTableOrder := orderentity.TableOrder{Entity: entity.NewEntity()}
+	openTableOrder.CreatedAt = now.Add(-time.Hour)
+	openTableOrder.TableID = large.ID
+
+	occupations := NewOccupations([]Reservation{*booked}, []orderentity.TableOrder{openTableOrder}, uuid.Nil, now)
+
+	// The medium table is booked and the large one is busy for the next hour
+	other := NewReservation(ReservationCommonAttributes{Name: "Bia", PartySize: 4, StartAt: now.Add(3 * time.Hour), TableIDs: []uuid.UUID{medium.ID}})
+	assert.Equal(t, ErrTableNotAvailable, other.CheckTables(tables, occupations))
+
+	other.TableIDs = []uuid.UUID{small.ID}
+	assert.Equal(t, ErrTablesBelowPartySize, other.CheckTables(tables, occupations))
+
+	other.TableIDs = []uuid.UUID{large.ID}
+	assert.Nil(t, other.CheckTables(tables, occupations))
+
+	// The booked reservation does not conflict with itself
+	ignoring := NewOccupations([]Reservation{*booked}, nil, booked.ID, now)
+	assert.Nil(t, booked.CheckTables(tables, ignoring))
+
+	suggestions, err := FindAvailability(tables, 3, now, now.Add(time.Hour), occupations)
+	assert.Nil(t, err)
+	assert.Len(t, suggestions, 1)
+	assert.Equal(t, medium.ID, suggestions[0].Tables[0].ID)
+
+	// No table fits alone, the free ones are joined
+	suggestions, err = FindAvailability(tables, 5, now, now.Add(time.Hour), occupations)
+	assert.Nil(t, err)
+	assert.Len(t, suggestions[0].Tables, 2)
+	assert.Equal(t, 6, suggestions[0].Capacity)
+
+	_, err = FindAvailability(tables, 7, now, now.Add(time.Hour), occupations)
+	assert.Equal(t, ErrNoTableAvailableFound, err)
+
+	// A locked table is not suggested
+	tables[1].LockTable()
+	suggestions, err = FindAvailability(tables, 3, now, now.Add(time.Hour), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, large.ID, suggestions[0].Tables[0].ID)
+}
+
+func TestSeatedReservationHoldsExtraTables(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 18, 0, 0, 0, time.UTC)
+	first, extra := newTable("1", 4), newTable("2", 4)
+
+	seated := NewReservation(ReservationCommonAttributes{Name: "Ana", PartySize: 8, StartAt: now, TableIDs: []uuid.UUID{first.ID, extra.ID}})
+	assert.Equal(t, ErrReservationMustBeSeated, seated.ReleaseTables(now))
+	assert.Nil(t, seated.Seat(uuid.New(), now))
+	assert.Equal(t, []uuid.UUID{extra.ID}, seated.ExtraTableIDs())
+
+	// Only the extra table comes from the reservation, the first one is held by the table order
+	occupations := NewOccupations([]Reservation{*seated}, nil, uuid.Nil, now.Add(3*time.Hour))
+	assert.Len(t, occupations, 1)
+	assert.False(t, IsTableFree(extra.ID, now.Add(3*time.Hour), now.Add(4*time.Hour), occupations))
+
+	assert.Nil(t, seated.ReleaseTables(now.Add(3*time.Hour)))
+	assert.Equal(t, ErrTablesAlreadyReleased, seated.ReleaseTables(now))
+	assert.Empty(t, NewOccupations([]Reservation{*seated}, nil, uuid.Nil, now))
+}
+
+func TestReservationStatus(t *testing.T) {
+	now := time.Now()
+	reservation := NewReservation(ReservationCommonAttributes{Name: "Ana", PartySize: 2, StartAt: now.Add(time.Hour), TableIDs: []uuid.UUID{uuid.New()}})
+
+	assert.Equal(t, ErrReservationNotStartedYet, reservation.MarkNoShow(now))
+	assert.Nil(t, reservation.Seat(uuid.New(), now))
+	assert.Equal(t, ReservationStatusSeated, reservation.Status)
+	assert.Equal(t, ErrReservationMustBeBooked, reservation.Cancel(now))
+}
@@ -0,0 +1,19 @@
+package reservationentity
+
+type StatusReservation string
+
+const (
+	ReservationStatusBooked   StatusReservation = "Booked"
+	ReservationStatusSeated   StatusReservation = "Seated"
+	ReservationStatusNoShow   StatusReservation = "NoShow"
+	ReservationStatusCanceled StatusReservation = "Canceled"
+)
+
+func GetAllReservationStatus() []StatusReservation {
+	return []StatusReservation{
+		ReservationStatusBooked,
+		ReservationStatusSeated,
+		ReservationStatusNoShow,
+		ReservationStatusCanceled,
+	}
+}
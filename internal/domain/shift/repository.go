@@ -8,4 +8,5 @@ type ShiftRepository interface {
 	DeleteShift(ctx context.Context, id string) (err error)
 	GetShiftByID(ctx context.Context, id string) (shift *Shift, err error)
 	GetOpenedShift(ctx context.Context) (*Shift, error)
+	GetLastShift(ctx context.Context) (*Shift, error)
 }
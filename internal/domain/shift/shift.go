@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
@@ -40,6 +41,22 @@ func (s *Shift) OpenShift() (err error) {
 	return nil
 }
 
+// ContinueOrderNumber keeps the numbering of the previous shift when the company doesn't restart it on every shift.
+func (s *Shift) ContinueOrderNumber(previous *Shift, reset companyentity.OrderNumberReset) {
+	if previous == nil {
+		return
+	}
+
+	switch reset {
+	case companyentity.OrderNumberResetNever:
+		s.CurrentOrderNumber = previous.CurrentOrderNumber
+	case companyentity.OrderNumberResetDaily:
+		if s.Day != nil && previous.Day != nil && previous.Day.Equal(*s.Day) {
+			s.CurrentOrderNumber = previous.CurrentOrderNumber
+		}
+	}
+}
+
 func (s *Shift) CloseShift(endChange float32) (err error) {
 	s.EndChange = &endChange
 	s.ClosedAt = &time.Time{}
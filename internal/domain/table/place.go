@@ -1,22 +1,117 @@
 package tableentity
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 )
 
+var (
+	ErrPlaceNameRequired      = errors.New("place name is required")
+	ErrPlaceSizeInvalid       = errors.New("place rows and columns must be positive")
+	ErrTableOutOfPlace        = errors.New("table position is out of the place")
+	ErrTablePositionIsUsed    = errors.New("table position is used by another table")
+	ErrTableRepeatedInPlace   = errors.New("table is repeated in the place")
+	ErrTableShapeInvalid      = errors.New("table shape is invalid")
+	ErrTableUsedInOtherPlace  = errors.New("table is used in another place")
+	ErrPlaceTableNotFoundInDB = errors.New("table of the layout not found")
+)
+
+// Place is an area of the floor, like the salão or the varanda, with its tables on a grid.
 type Place struct {
 	entity.Entity
 	bun.BaseModel `bun:"table:places"`
 	PlaceCommonAttributes
 }
 
+type PlaceCommonAttributes struct {
+	Name    string       `bun:"name,notnull" json:"name"`
+	Rows    int          `bun:"rows,notnull" json:"rows"`
+	Columns int          `bun:"columns,notnull" json:"columns"`
+	Tables  []PlaceTable `bun:"rel:has-many,join:id=place_id" json:"tables"`
+}
+
+type PatchPlace struct {
+	Name    *string `json:"name"`
+	Rows    *int    `json:"rows"`
+	Columns *int    `json:"columns"`
+}
+
+// PlaceTable is the position and the shape of a table in the place.
+type PlaceTable struct {
+	bun.BaseModel `bun:"table:place_tables"`
+	PlaceID       uuid.UUID `bun:"column:place_id,type:uuid,notnull" json:"place_id"`
+	TableRelation
+	Row    int        `bun:"grid_row,notnull" json:"row"`
+	Column int        `bun:"grid_column,notnull" json:"column"`
+	Shape  TableShape `bun:"shape,notnull" json:"shape"`
+}
+
 type TableRelation struct {
-	TableID uuid.UUID `bun:"column:table_id,type:uuid,notnull" json:"table_id"`
-	Table   *Table    `bun:"rel:belongs-to" json:"table"`
+	TableID uuid.UUID `bun:"column:table_id,type:uuid,pk" json:"table_id"`
+	Table   *Table    `bun:"rel:belongs-to" json:"table,omitempty"`
 }
 
-type PlaceCommonAttributes struct {
-	Tables [][]TableRelation `bun:"rel:has-many,join:id=place_id" json:"tables"`
+func NewPlace(placeCommonAttributes PlaceCommonAttributes) *Place {
+	return &Place{
+		Entity:                entity.NewEntity(),
+		PlaceCommonAttributes: placeCommonAttributes,
+	}
+}
+
+func (p *Place) Validate() error {
+	if p.Name == "" {
+		return ErrPlaceNameRequired
+	}
+
+	if p.Rows <= 0 || p.Columns <= 0 {
+		return ErrPlaceSizeInvalid
+	}
+
+	return p.validateTables()
+}
+
+// SetTables replaces the layout of the place.
+func (p *Place) SetTables(tables []PlaceTable) error {
+	for i := range tables {
+		tables[i].PlaceID = p.ID
+
+		if tables[i].Shape == "" {
+			tables[i].Shape = TableShapeSquare
+		}
+	}
+
+	p.Tables = tables
+	return p.validateTables()
+}
+
+func (p *Place) validateTables() error {
+	positions := map[[2]int]bool{}
+	tableIDs := map[uuid.UUID]bool{}
+
+	for _, table := range p.Tables {
+		if table.Row < 0 || table.Row >= p.Rows || table.Column < 0 || table.Column >= p.Columns {
+			return ErrTableOutOfPlace
+		}
+
+		if !table.Shape.IsValid() {
+			return ErrTableShapeInvalid
+		}
+
+		position := [2]int{table.Row, table.Column}
+		if positions[position] {
+			return ErrTablePositionIsUsed
+		}
+
+		if tableIDs[table.TableID] {
+			return ErrTableRepeatedInPlace
+		}
+
+		positions[position] = true
+		tableIDs[table.TableID] = true
+	}
+
+	return nil
 }
@@ -0,0 +1,65 @@
+package tableentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+func TestPlaceLayout(t *testing.T) {
+	place := NewPlace(PlaceCommonAttributes{Name: "Varanda", Rows: 2, Columns: 2})
+	assert.Nil(t, place.Validate())
+
+	first, second := uuid.New(), uuid.New()
+
+	assert.Equal(t, ErrTableOutOfPlace, place.SetTables([]PlaceTable{{TableRelation: TableRelation{TableID: first}, Row: 2}}))
+	assert.Equal(t, ErrTablePositionIsUsed, place.SetTables([]PlaceTable{
+		{TableRelation: TableRelation{TableID: first}},
+		{TableRelation: TableRelation{TableID: second}},
+	}))
+	assert.Equal(t, ErrTableRepeatedInPlace, place.SetTables([]PlaceTable{
+		{TableRelation: TableRelation{TableID: first}},
+		{TableRelation: TableRelation{TableID: first}, Column: 1},
+	}))
+	assert.Equal(t, ErrTableShapeInvalid, place.SetTables([]PlaceTable{{TableRelation: TableRelation{TableID: first}, Shape: "Star"}}))
+
+	assert.Nil(t, place.SetTables([]PlaceTable{
+		{TableRelation: TableRelation{TableID: first}},
+		{TableRelation: TableRelation{TableID: second}, Column: 1, Shape: TableShapeRound},
+	}))
+	assert.Equal(t, TableShapeSquare, place.Tables[0].Shape)
+	assert.Equal(t, place.ID, place.Tables[1].PlaceID)
+}
+
+func TestPlaceView(t *testing.T) {
+	now := time.Now()
+	tables := []Table{}
+	for _, name := range []string{"1", "2", "3", "4"} {
+		tables = append(tables, Table{Entity: entity.NewEntity(), TableCommonAttributes: TableCommonAttributes{Name: name, Capacity: 4, IsAvailable: true}})
+	}
+	tables[2].ReleaseTable()
+
+	place := NewPlace(PlaceCommonAttributes{Name: "Salão", Rows: 1, Columns: 4})
+	for i := range tables {
+		place.Tables = append(place.Tables, PlaceTable{TableRelation: TableRelation{TableID: tables[i].ID, Table: &tables[i]}, Column: i, Shape: TableShapeSquare})
+	}
+
+	occupancies := map[uuid.UUID]Occupancy{
+		tables[0].ID: {TableOrderID: uuid.New(), OrderID: uuid.New(), OpenedAt: now.Add(-45 * time.Minute), OrderTotal: 80},
+	}
+	bookings := map[uuid.UUID]Booking{
+		tables[1].ID: {ReservationID: uuid.New(), Name: "Ana", StartAt: now.Add(30 * time.Minute)},
+		tables[3].ID: {ReservationID: uuid.New(), Name: "Bia", StartAt: now.Add(3 * time.Hour)},
+	}
+
+	view := place.View(occupancies, bookings, now)
+	assert.Equal(t, TableStatusOccupied, view.Tables[0].Status)
+	assert.Equal(t, 45, view.Tables[0].ElapsedMinutes)
+	assert.Equal(t, 80.0, view.Tables[0].OrderTotal)
+	assert.Equal(t, TableStatusReserved, view.Tables[1].Status)
+	assert.Equal(t, TableStatusNeedsClean, view.Tables[2].Status)
+	assert.Equal(t, TableStatusFree, view.Tables[3].Status)
+}
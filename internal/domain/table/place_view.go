@@ -0,0 +1,127 @@
+package tableentity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReservedWindow is how long before a reservation the table is shown as reserved.
+const ReservedWindow = time.Hour
+
+type TableStatus string
+
+const (
+	TableStatusFree       TableStatus = "Free"
+	TableStatusOccupied   TableStatus = "Occupied"
+	TableStatusReserved   TableStatus = "Reserved"
+	TableStatusNeedsClean TableStatus = "NeedsClean"
+)
+
+func GetAllTableStatus() []TableStatus {
+	return []TableStatus{
+		TableStatusFree,
+		TableStatusOccupied,
+		TableStatusReserved,
+		TableStatusNeedsClean,
+	}
+}
+
+// Occupancy is the open order on a table.
+type Occupancy struct {
+	TableOrderID uuid.UUID
+	OrderID      uuid.UUID
+	OpenedAt     time.Time
+	OrderTotal   float64
+}
+
+// Booking is the next reservation of a table.
+type Booking struct {
+	ReservationID uuid.UUID
+	Name          string
+	StartAt       time.Time
+}
+
+type PlaceView struct {
+	ID      uuid.UUID   `json:"id"`
+	Name    string      `json:"name"`
+	Rows    int         `json:"rows"`
+	Columns int         `json:"columns"`
+	Tables  []TableView `json:"tables"`
+}
+
+type TableView struct {
+	TableID         uuid.UUID   `json:"table_id"`
+	Name            string      `json:"name"`
+	Capacity        int         `json:"capacity"`
+	Row             int         `json:"row"`
+	Column          int         `json:"column"`
+	Shape           TableShape  `json:"shape"`
+	Status          TableStatus `json:"status"`
+	TableOrderID    *uuid.UUID  `json:"table_order_id,omitempty"`
+	OrderID         *uuid.UUID  `json:"order_id,omitempty"`
+	OrderTotal      float64     `json:"order_total,omitempty"`
+	ElapsedMinutes  int         `json:"elapsed_minutes,omitempty"`
+	ReservationID   *uuid.UUID  `json:"reservation_id,omitempty"`
+	ReservationName string      `json:"reservation_name,omitempty"`
+	ReservedAt      *time.Time  `json:"reserved_at,omitempty"`
+}
+
+// View returns the state of each table of the place, an open order comes first, then cleaning and the
+// reservations starting soon.
+func (p *Place) View(occupancies map[uuid.UUID]Occupancy, bookings map[uuid.UUID]Booking, now time.Time) *PlaceView {
+	view := &PlaceView{
+		ID:      p.ID,
+		Name:    p.Name,
+		Rows:    p.Rows,
+		Columns: p.Columns,
+		Tables:  []TableView{},
+	}
+
+	for _, placeTable := range p.Tables {
+		tableView := TableView{
+			TableID: placeTable.TableID,
+			Row:     placeTable.Row,
+			Column:  placeTable.Column,
+			Shape:   placeTable.Shape,
+			Status:  TableStatusFree,
+		}
+
+		needsClean := false
+		if placeTable.Table != nil {
+			tableView.Name = placeTable.Table.Name
+			tableView.Capacity = placeTable.Table.Capacity
+			needsClean = placeTable.Table.NeedsClean
+
+			if !placeTable.Table.IsAvailable {
+				tableView.Status = TableStatusOccupied
+			}
+		}
+
+		if booking, ok := bookings[placeTable.TableID]; ok && booking.StartAt.Before(now.Add(ReservedWindow)) {
+			tableView.ReservationID = &booking.ReservationID
+			tableView.ReservationName = booking.Name
+			tableView.ReservedAt = &booking.StartAt
+
+			if tableView.Status == TableStatusFree {
+				tableView.Status = TableStatusReserved
+			}
+		}
+
+		if needsClean && tableView.Status != TableStatusOccupied {
+			tableView.Status = TableStatusNeedsClean
+		}
+
+		if occupancy, ok := occupancies[placeTable.TableID]; ok {
+			tableView.Status = TableStatusOccupied
+			tableView.TableOrderID = &occupancy.TableOrderID
+			tableView.OrderID = &occupancy.OrderID
+			tableView.OrderTotal = occupancy.OrderTotal
+			tableView.ElapsedMinutes = int(now.Sub(occupancy.OpenedAt).Minutes())
+		}
+
+		view.Tables = append(view.Tables, tableView)
+	}
+
+	return view
+}
@@ -9,3 +9,12 @@ type TableRepository interface {
 	GetTableById(ctx context.Context, id string) (*Table, error)
 	GetAllTables(ctx context.Context) ([]Table, error)
 }
+
+type PlaceRepository interface {
+	CreatePlace(ctx context.Context, place *Place) error
+	UpdatePlace(ctx context.Context, place *Place) error
+	DeletePlace(ctx context.Context, id string) error
+	GetPlaceById(ctx context.Context, id string) (*Place, error)
+	GetAllPlaces(ctx context.Context) ([]Place, error)
+	SavePlaceTables(ctx context.Context, place *Place) error
+}
@@ -1,11 +1,19 @@
 package tableentity
 
 import (
+	"errors"
+
 	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 )
 
+const DefaultTableCapacity = 4
+
+var (
+	ErrCapacityInvalid = errors.New("capacity must be positive")
+)
+
 type Table struct {
 	entity.Entity
 	bun.BaseModel `bun:"table:tables"`
@@ -15,6 +23,8 @@ type Table struct {
 type TableCommonAttributes struct {
 	Name        string                   `bun:"name,notnull" json:"name"`
 	IsAvailable bool                     `bun:"is_available" json:"is_available"`
+	Capacity    int                      `bun:"capacity" json:"capacity"`
+	NeedsClean  bool                     `bun:"needs_clean" json:"needs_clean"`
 	Orders      []orderentity.TableOrder `bun:"rel:has-many,join:id=table_id" json:"orders,omitempty"`
 }
 
@@ -25,3 +35,18 @@ func (t *Table) LockTable() {
 func (t *Table) UnlockTable() {
 	t.IsAvailable = true
 }
+
+// ReleaseTable frees the table after the order, it must be cleaned before the next clients.
+func (t *Table) ReleaseTable() {
+	t.IsAvailable = true
+	t.NeedsClean = true
+}
+
+func (t *Table) CleanTable() {
+	t.NeedsClean = false
+}
+
+type PatchTable struct {
+	Name     *string `json:"name"`
+	Capacity *int    `json:"capacity"`
+}
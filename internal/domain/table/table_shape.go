@@ -0,0 +1,27 @@
+package tableentity
+
+type TableShape string
+
+const (
+	TableShapeSquare    TableShape = "Square"
+	TableShapeRound     TableShape = "Round"
+	TableShapeRectangle TableShape = "Rectangle"
+)
+
+func GetAllTableShapes() []TableShape {
+	return []TableShape{
+		TableShapeSquare,
+		TableShapeRound,
+		TableShapeRectangle,
+	}
+}
+
+func (s TableShape) IsValid() bool {
+	for _, shape := range GetAllTableShapes() {
+		if shape == s {
+			return true
+		}
+	}
+
+	return false
+}
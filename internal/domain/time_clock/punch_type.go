@@ -0,0 +1,38 @@
+package timeclockentity
+
+type PunchType string
+
+const (
+	PunchTypeIn         PunchType = "PunchIn"
+	PunchTypeBreakStart PunchType = "BreakStart"
+	PunchTypeBreakEnd   PunchType = "BreakEnd"
+	PunchTypeOut        PunchType = "PunchOut"
+)
+
+func GetAllPunchTypes() []PunchType {
+	return []PunchType{
+		PunchTypeIn,
+		PunchTypeBreakStart,
+		PunchTypeBreakEnd,
+		PunchTypeOut,
+	}
+}
+
+func IsValidPunchType(punchType PunchType) bool {
+	for _, t := range GetAllPunchTypes() {
+		if t == punchType {
+			return true
+		}
+	}
+
+	return false
+}
+
+type PunchSource string
+
+const (
+	PunchSourcePin      PunchSource = "Pin"
+	PunchSourceTerminal PunchSource = "Terminal"
+	PunchSourceUser     PunchSource = "User"
+	PunchSourceManager  PunchSource = "Manager"
+)
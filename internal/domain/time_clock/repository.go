@@ -0,0 +1,24 @@
+package timeclockentity
+
+import (
+	"context"
+	"time"
+)
+
+type TimeClockRepository interface {
+	CreateEntry(ctx context.Context, entry *TimeClockEntry) error
+	CreateCorrectedEntry(ctx context.Context, entry *TimeClockEntry, correction *TimeClockCorrection) error
+	UpdateCorrectedEntry(ctx context.Context, entry *TimeClockEntry, correction *TimeClockCorrection) error
+	GetEntryById(ctx context.Context, id string) (*TimeClockEntry, error)
+	GetLastEntryByEmployeeId(ctx context.Context, employeeID string) (*TimeClockEntry, error)
+	GetEntriesByEmployeeId(ctx context.Context, employeeID string, startAt time.Time, endAt time.Time) ([]TimeClockEntry, error)
+	GetCorrectionsByEmployeeId(ctx context.Context, employeeID string) ([]TimeClockCorrection, error)
+}
+
+type WorkScheduleRepository interface {
+	CreateWorkSchedule(ctx context.Context, schedule *WorkSchedule) error
+	UpdateWorkSchedule(ctx context.Context, schedule *WorkSchedule) error
+	DeleteWorkSchedule(ctx context.Context, id string) error
+	GetWorkScheduleById(ctx context.Context, id string) (*WorkSchedule, error)
+	GetAllWorkSchedules(ctx context.Context) ([]WorkSchedule, error)
+}
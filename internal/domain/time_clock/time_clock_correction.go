@@ -0,0 +1,105 @@
+package timeclockentity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+type CorrectionAction string
+
+const (
+	CorrectionActionCreate CorrectionAction = "Create"
+	CorrectionActionUpdate CorrectionAction = "Update"
+	CorrectionActionVoid   CorrectionAction = "Void"
+)
+
+// TimeClockCorrection is the audit of a manager change, it keeps the entry before and after.
+type TimeClockCorrection struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:time_clock_corrections"`
+	TimeClockCorrectionCommonAttributes
+}
+
+type TimeClockCorrectionCommonAttributes struct {
+	EntryID       uuid.UUID                       `bun:"column:entry_id,type:uuid,notnull" json:"entry_id"`
+	EmployeeID    uuid.UUID                       `bun:"column:employee_id,type:uuid,notnull" json:"employee_id"`
+	Action        CorrectionAction                `bun:"action,notnull" json:"action"`
+	Before        *TimeClockEntryCommonAttributes `bun:"before,type:jsonb" json:"before,omitempty"`
+	After         *TimeClockEntryCommonAttributes `bun:"after,type:jsonb" json:"after,omitempty"`
+	Reason        string                          `bun:"reason,notnull" json:"reason"`
+	CorrectedByID uuid.UUID                       `bun:"column:corrected_by_id,type:uuid,notnull" json:"corrected_by_id"`
+}
+
+func newCorrection(entry *TimeClockEntry, action CorrectionAction, before *TimeClockEntryCommonAttributes, reason string, correctedByID uuid.UUID) *TimeClockCorrection {
+	correction := &TimeClockCorrection{
+		Entity: entity.NewEntity(),
+		TimeClockCorrectionCommonAttributes: TimeClockCorrectionCommonAttributes{
+			EntryID:       entry.ID,
+			EmployeeID:    entry.EmployeeID,
+			Action:        action,
+			Before:        before,
+			Reason:        reason,
+			CorrectedByID: correctedByID,
+		},
+	}
+
+	if action != CorrectionActionVoid {
+		after := entry.TimeClockEntryCommonAttributes
+		correction.After = &after
+	}
+
+	return correction
+}
+
+// CorrectCreate audits an entry added by a manager, like a forgotten punch.
+func CorrectCreate(entry *TimeClockEntry, reason string, correctedByID uuid.UUID) (*TimeClockCorrection, error) {
+	if reason == "" {
+		return nil, ErrCorrectionReason
+	}
+
+	entry.Source = PunchSourceManager
+	return newCorrection(entry, CorrectionActionCreate, nil, reason, correctedByID), nil
+}
+
+// CorrectUpdate changes the type or time of the entry.
+func (e *TimeClockEntry) CorrectUpdate(punchType PunchType, at time.Time, reason string, correctedByID uuid.UUID) (*TimeClockCorrection, error) {
+	if reason == "" {
+		return nil, ErrCorrectionReason
+	}
+
+	if e.IsVoided() {
+		return nil, ErrEntryVoided
+	}
+
+	if !IsValidPunchType(punchType) {
+		return nil, ErrPunchTypeInvalid
+	}
+
+	before := e.TimeClockEntryCommonAttributes
+	e.Type = punchType
+	e.At = at
+	e.CorrectedAt = &time.Time{}
+	*e.CorrectedAt = time.Now()
+
+	return newCorrection(e, CorrectionActionUpdate, &before, reason, correctedByID), nil
+}
+
+func (e *TimeClockEntry) CorrectVoid(reason string, correctedByID uuid.UUID) (*TimeClockCorrection, error) {
+	if reason == "" {
+		return nil, ErrCorrectionReason
+	}
+
+	if e.IsVoided() {
+		return nil, ErrEntryVoided
+	}
+
+	before := e.TimeClockEntryCommonAttributes
+	e.VoidedAt = &time.Time{}
+	*e.VoidedAt = time.Now()
+	e.CorrectedAt = e.VoidedAt
+
+	return newCorrection(e, CorrectionActionVoid, &before, reason, correctedByID), nil
+}
@@ -0,0 +1,109 @@
+package timeclockentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrPunchTypeInvalid      = errors.New("punch type is invalid")
+	ErrAlreadyPunchedIn      = errors.New("employee already punched in")
+	ErrNotPunchedIn          = errors.New("employee is not punched in")
+	ErrAlreadyOnBreak        = errors.New("employee already on break")
+	ErrNotOnBreak            = errors.New("employee is not on break")
+	ErrEndBreakBeforePunch   = errors.New("break must end before punching out")
+	ErrEntryVoided           = errors.New("time clock entry already voided")
+	ErrCorrectionReason      = errors.New("correction reason is required")
+	ErrOnlyManagerCanCorrect = errors.New("only managers can correct the time clock")
+	ErrOnlyManagerOrSelf     = errors.New("only managers or the employee can see the time clock")
+)
+
+// TimeClockEntry is a single punch, corrections never delete it, a voided entry is ignored by the timesheets.
+type TimeClockEntry struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:time_clock_entries"`
+	TimeClockEntryCommonAttributes
+	EntryTimeLogs
+}
+
+type TimeClockEntryCommonAttributes struct {
+	EmployeeID uuid.UUID   `bun:"column:employee_id,type:uuid,notnull" json:"employee_id"`
+	Type       PunchType   `bun:"type,notnull" json:"type"`
+	At         time.Time   `bun:"at,notnull" json:"at"`
+	Source     PunchSource `bun:"source,notnull" json:"source"`
+	Geofence   *Geofence   `bun:"geofence,type:jsonb" json:"geofence,omitempty"`
+}
+
+// Geofence is the location sent by the device at the moment of the punch.
+type Geofence struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Accuracy  float64 `json:"accuracy,omitempty"`
+}
+
+type EntryTimeLogs struct {
+	CorrectedAt *time.Time `bun:"corrected_at" json:"corrected_at,omitempty"`
+	VoidedAt    *time.Time `bun:"voided_at" json:"voided_at,omitempty"`
+}
+
+func NewTimeClockEntry(employeeID uuid.UUID, punchType PunchType, at time.Time, source PunchSource, geofence *Geofence) (*TimeClockEntry, error) {
+	if !IsValidPunchType(punchType) {
+		return nil, ErrPunchTypeInvalid
+	}
+
+	return &TimeClockEntry{
+		Entity: entity.NewEntity(),
+		TimeClockEntryCommonAttributes: TimeClockEntryCommonAttributes{
+			EmployeeID: employeeID,
+			Type:       punchType,
+			At:         at,
+			Source:     source,
+			Geofence:   geofence,
+		},
+	}, nil
+}
+
+func (e *TimeClockEntry) IsVoided() bool {
+	return e.VoidedAt != nil
+}
+
+// ValidateNextPunch checks the punch against the last one of the employee.
+func ValidateNextPunch(last *TimeClockEntry, next PunchType) error {
+	var lastType PunchType = PunchTypeOut
+	if last != nil {
+		lastType = last.Type
+	}
+
+	switch next {
+	case PunchTypeIn:
+		if lastType != PunchTypeOut {
+			return ErrAlreadyPunchedIn
+		}
+	case PunchTypeBreakStart:
+		if lastType == PunchTypeBreakStart {
+			return ErrAlreadyOnBreak
+		}
+		if lastType == PunchTypeOut {
+			return ErrNotPunchedIn
+		}
+	case PunchTypeBreakEnd:
+		if lastType != PunchTypeBreakStart {
+			return ErrNotOnBreak
+		}
+	case PunchTypeOut:
+		if lastType == PunchTypeBreakStart {
+			return ErrEndBreakBeforePunch
+		}
+		if lastType == PunchTypeOut {
+			return ErrNotPunchedIn
+		}
+	default:
+		return ErrPunchTypeInvalid
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package timeclockentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newEntry(t *testing.T, employeeID uuid.UUID, punchType PunchType, at time.Time) TimeClockEntry {
+	entry, err := NewTimeClockEntry(employeeID, punchType, at, PunchSourcePin, nil)
+	assert.Nil(t, err)
+	return *entry
+}
+
+func TestValidateNextPunch(t *testing.T) {
+	assert.Nil(t, ValidateNextPunch(nil, PunchTypeIn))
+	assert.Equal(t, ErrNotPunchedIn, ValidateNextPunch(nil, PunchTypeOut))
+
+	in := &TimeClockEntry{TimeClockEntryCommonAttributes: TimeClockEntryCommonAttributes{Type: PunchTypeIn}}
+	assert.Equal(t, ErrAlreadyPunchedIn, ValidateNextPunch(in, PunchTypeIn))
+	assert.Nil(t, ValidateNextPunch(in, PunchTypeBreakStart))
+
+	onBreak := &TimeClockEntry{TimeClockEntryCommonAttributes: TimeClockEntryCommonAttributes{Type: PunchTypeBreakStart}}
+	assert.Equal(t, ErrEndBreakBeforePunch, ValidateNextPunch(onBreak, PunchTypeOut))
+	assert.Nil(t, ValidateNextPunch(onBreak, PunchTypeBreakEnd))
+}
+
+func TestBuildTimesheets(t *testing.T) {
+	employeeID := uuid.New()
+	// 2026-10-05 is a monday
+	day := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+
+	schedule, err := NewWorkSchedule(WorkScheduleCommonAttributes{DailyMinutes: []int{0, 480, 480, 480, 480, 480, 0}, ToleranceMinutes: 10})
+	assert.Nil(t, err)
+
+	entries := []TimeClockEntry{
+		// Monday: 9h worked with 1h break, 1h of overtime
+		newEntry(t, employeeID, PunchTypeIn, day.Add(8*time.Hour)),
+		newEntry(t, employeeID, PunchTypeBreakStart, day.Add(12*time.Hour)),
+		newEntry(t, employeeID, PunchTypeBreakEnd, day.Add(13*time.Hour)),
+		newEntry(t, employeeID, PunchTypeOut, day.Add(18*time.Hour)),
+		// Tuesday: night shift crossing midnight, 7h55 inside the tolerance
+		newEntry(t, employeeID, PunchTypeIn, day.Add(24*time.Hour+18*time.Hour)),
+		newEntry(t, employeeID, PunchTypeOut, day.Add(48*time.Hour+1*time.Hour+55*time.Minute)),
+		// Thursday: forgot to punch out
+		newEntry(t, employeeID, PunchTypeIn, day.Add(72*time.Hour+8*time.Hour)),
+	}
+
+	timesheets := BuildTimesheets(entries, schedule, day, day.AddDate(0, 0, 3))
+	assert.Len(t, timesheets, 4)
+
+	assert.Equal(t, 540, timesheets[0].WorkedMinutes)
+	assert.Equal(t, 60, timesheets[0].BreakMinutes)
+	assert.Equal(t, 60, timesheets[0].OvertimeMinutes)
+
+	assert.Equal(t, 475, timesheets[1].WorkedMinutes)
+	assert.Len(t, timesheets[1].Entries, 2)
+	assert.Equal(t, 0, timesheets[1].MissingMinutes)
+
+	// Wednesday absent
+	assert.Len(t, timesheets[2].Entries, 0)
+	assert.Equal(t, 480, timesheets[2].MissingMinutes)
+
+	assert.True(t, timesheets[3].Incomplete)
+
+	attendance := NewMonthlyAttendance(employeeID, day, timesheets)
+	assert.Equal(t, 3, attendance.DaysWorked)
+	assert.Equal(t, 1, attendance.Absences)
+}
+
+func TestTimeClockCorrections(t *testing.T) {
+	entry := newEntry(t, uuid.New(), PunchTypeIn, time.Now())
+	managerID := uuid.New()
+
+	_, err := entry.CorrectUpdate(PunchTypeIn, time.Now(), "", managerID)
+	assert.Equal(t, ErrCorrectionReason, err)
+
+	at := entry.At.Add(-15 * time.Minute)
+	correction, err := entry.CorrectUpdate(PunchTypeIn, at, "arrived earlier", managerID)
+	assert.Nil(t, err)
+	assert.Equal(t, CorrectionActionUpdate, correction.Action)
+	assert.Equal(t, at, correction.After.At)
+	assert.NotEqual(t, at, correction.Before.At)
+
+	correction, err = entry.CorrectVoid("duplicated", managerID)
+	assert.Nil(t, err)
+	assert.Nil(t, correction.After)
+	assert.True(t, entry.IsVoided())
+
+	_, err = entry.CorrectVoid("again", managerID)
+	assert.Equal(t, ErrEntryVoided, err)
+}
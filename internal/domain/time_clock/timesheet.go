@@ -0,0 +1,154 @@
+package timeclockentity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DailyTimesheet groups the punches of a day, a shift crossing midnight belongs to the day of the punch in.
+type DailyTimesheet struct {
+	Day             time.Time        `json:"day"`
+	Entries         []TimeClockEntry `json:"entries"`
+	WorkedMinutes   int              `json:"worked_minutes"`
+	BreakMinutes    int              `json:"break_minutes"`
+	ExpectedMinutes int              `json:"expected_minutes"`
+	OvertimeMinutes int              `json:"overtime_minutes"`
+	MissingMinutes  int              `json:"missing_minutes"`
+	Incomplete      bool             `json:"incomplete"`
+	worked          time.Duration
+	breaks          time.Duration
+}
+
+type MonthlyAttendance struct {
+	EmployeeID      uuid.UUID        `json:"employee_id"`
+	Month           time.Time        `json:"month"`
+	Days            []DailyTimesheet `json:"days"`
+	WorkedMinutes   int              `json:"worked_minutes"`
+	BreakMinutes    int              `json:"break_minutes"`
+	ExpectedMinutes int              `json:"expected_minutes"`
+	OvertimeMinutes int              `json:"overtime_minutes"`
+	MissingMinutes  int              `json:"missing_minutes"`
+	DaysWorked      int              `json:"days_worked"`
+	Absences        int              `json:"absences"`
+}
+
+// BuildTimesheets returns one timesheet per day between start and end, the entries must be sorted and not voided.
+func BuildTimesheets(entries []TimeClockEntry, schedule *WorkSchedule, start time.Time, end time.Time) []DailyTimesheet {
+	loc := start.Location()
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+
+	timesheets := []DailyTimesheet{}
+	for day := first; !day.After(end); day = day.AddDate(0, 0, 1) {
+		timesheets = append(timesheets, DailyTimesheet{Day: day, Entries: []TimeClockEntry{}})
+	}
+
+	indexOf := func(at time.Time) int {
+		at = at.In(loc)
+		day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, loc)
+		for i := range timesheets {
+			if timesheets[i].Day.Equal(day) {
+				return i
+			}
+		}
+
+		return -1
+	}
+
+	current := -1
+	var workStart, breakStart *time.Time
+
+	for _, entry := range entries {
+		if entry.Type == PunchTypeIn || current == -1 {
+			current = indexOf(entry.At)
+		}
+
+		if current == -1 {
+			continue
+		}
+
+		timesheet := &timesheets[current]
+		timesheet.Entries = append(timesheet.Entries, entry)
+		timesheet.Incomplete = entry.Type != PunchTypeOut
+		at := entry.At
+
+		switch entry.Type {
+		case PunchTypeIn:
+			workStart, breakStart = &at, nil
+		case PunchTypeBreakStart:
+			if workStart != nil {
+				timesheet.worked += at.Sub(*workStart)
+			}
+			workStart, breakStart = nil, &at
+		case PunchTypeBreakEnd:
+			if breakStart != nil {
+				timesheet.breaks += at.Sub(*breakStart)
+			}
+			workStart, breakStart = &at, nil
+		case PunchTypeOut:
+			if workStart != nil {
+				timesheet.worked += at.Sub(*workStart)
+			}
+			workStart, breakStart = nil, nil
+		}
+	}
+
+	for i := range timesheets {
+		timesheets[i].close(schedule)
+	}
+
+	return timesheets
+}
+
+func (t *DailyTimesheet) close(schedule *WorkSchedule) {
+	t.WorkedMinutes = int(t.worked.Minutes())
+	t.BreakMinutes = int(t.breaks.Minutes())
+
+	if schedule == nil {
+		return
+	}
+
+	t.ExpectedMinutes = schedule.ExpectedMinutes(t.Day.Weekday())
+	balance := t.WorkedMinutes - t.ExpectedMinutes
+
+	// Small differences inside the tolerance are not counted
+	if balance <= schedule.ToleranceMinutes && balance >= -schedule.ToleranceMinutes {
+		return
+	}
+
+	if balance > 0 {
+		t.OvertimeMinutes = balance
+	} else {
+		t.MissingMinutes = -balance
+	}
+}
+
+func NewMonthlyAttendance(employeeID uuid.UUID, month time.Time, days []DailyTimesheet) *MonthlyAttendance {
+	attendance := &MonthlyAttendance{
+		EmployeeID: employeeID,
+		Month:      month,
+		Days:       days,
+	}
+
+	for _, day := range days {
+		attendance.WorkedMinutes += day.WorkedMinutes
+		attendance.BreakMinutes += day.BreakMinutes
+		attendance.ExpectedMinutes += day.ExpectedMinutes
+		attendance.OvertimeMinutes += day.OvertimeMinutes
+		attendance.MissingMinutes += day.MissingMinutes
+
+		if len(day.Entries) > 0 {
+			attendance.DaysWorked++
+		} else if day.ExpectedMinutes > 0 {
+			attendance.Absences++
+		}
+	}
+
+	return attendance
+}
+
+// MonthPeriod returns the first and last instant of the month.
+func MonthPeriod(month time.Time) (time.Time, time.Time) {
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	return start, start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+}
@@ -0,0 +1,93 @@
+package timeclockentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrScheduleDaysInvalid     = errors.New("schedule must have the minutes of the 7 weekdays")
+	ErrScheduleMinutesInvalid  = errors.New("schedule minutes must be between 0 and 1440")
+	ErrToleranceMinutesInvalid = errors.New("tolerance minutes must be positive")
+	ErrWorkScheduleNotFound    = errors.New("work schedule not found")
+)
+
+// WorkSchedule is the expected minutes of work per weekday, a schedule without employee is the default of the company.
+type WorkSchedule struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:work_schedules"`
+	WorkScheduleCommonAttributes
+}
+
+type WorkScheduleCommonAttributes struct {
+	EmployeeID *uuid.UUID `bun:"column:employee_id,type:uuid" json:"employee_id,omitempty"`
+	// DailyMinutes is indexed by time.Weekday, starting on sunday
+	DailyMinutes     []int `bun:"daily_minutes,type:jsonb" json:"daily_minutes"`
+	ToleranceMinutes int   `bun:"tolerance_minutes" json:"tolerance_minutes"`
+}
+
+type PatchWorkSchedule struct {
+	DailyMinutes     []int `json:"daily_minutes"`
+	ToleranceMinutes *int  `json:"tolerance_minutes"`
+}
+
+func NewWorkSchedule(workScheduleCommonAttributes WorkScheduleCommonAttributes) (*WorkSchedule, error) {
+	schedule := &WorkSchedule{
+		Entity:                       entity.NewEntity(),
+		WorkScheduleCommonAttributes: workScheduleCommonAttributes,
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (s *WorkSchedule) Validate() error {
+	if len(s.DailyMinutes) != 7 {
+		return ErrScheduleDaysInvalid
+	}
+
+	for _, minutes := range s.DailyMinutes {
+		if minutes < 0 || minutes > 24*60 {
+			return ErrScheduleMinutesInvalid
+		}
+	}
+
+	if s.ToleranceMinutes < 0 {
+		return ErrToleranceMinutesInvalid
+	}
+
+	return nil
+}
+
+func (s *WorkSchedule) ExpectedMinutes(weekday time.Weekday) int {
+	return s.DailyMinutes[weekday]
+}
+
+// FindWorkSchedule returns the schedule of the employee or the default of the company.
+func FindWorkSchedule(schedules []WorkSchedule, employeeID uuid.UUID) (*WorkSchedule, error) {
+	var defaultSchedule *WorkSchedule
+
+	for i := range schedules {
+		if schedules[i].EmployeeID == nil {
+			defaultSchedule = &schedules[i]
+			continue
+		}
+
+		if *schedules[i].EmployeeID == employeeID {
+			return &schedules[i], nil
+		}
+	}
+
+	if defaultSchedule == nil {
+		return nil, ErrWorkScheduleNotFound
+	}
+
+	return defaultSchedule, nil
+}
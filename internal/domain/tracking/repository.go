@@ -0,0 +1,15 @@
+package trackingentity
+
+import (
+	"context"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type Repository interface {
+	CreateTrackingToken(ctx context.Context, token *TrackingToken) error
+	CreateTrackingTokenWithDelivery(ctx context.Context, delivery *orderentity.DeliveryOrder, token *TrackingToken) error
+	UpdateTrackingToken(ctx context.Context, token *TrackingToken) error
+	GetTrackingTokenByToken(ctx context.Context, token string) (*TrackingToken, error)
+	GetTrackingTokenByDeliveryId(ctx context.Context, deliveryID string) (*TrackingToken, error)
+}
@@ -0,0 +1,58 @@
+package trackingentity
+
+import (
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type Stage string
+
+const (
+	StageReceived  Stage = "Received"
+	StagePreparing Stage = "Preparing"
+	StageReady     Stage = "Ready"
+	StageOnTheWay  Stage = "OnTheWay"
+	StageDelivered Stage = "Delivered"
+	StageNotDone   Stage = "NotDelivered"
+	StageCanceled  Stage = "Canceled"
+)
+
+func GetAllStages() []Stage {
+	return []Stage{
+		StageReceived,
+		StagePreparing,
+		StageReady,
+		StageOnTheWay,
+		StageDelivered,
+		StageNotDone,
+		StageCanceled,
+	}
+}
+
+// GetStage derives the stage shown to the customer from the delivery and the groups of the order.
+func GetStage(order *orderentity.Order, delivery *orderentity.DeliveryOrder) Stage {
+	if order.Status == orderentity.OrderStatusCanceled {
+		return StageCanceled
+	}
+
+	switch delivery.Status {
+	case orderentity.DeliveryOrderStatusShipped:
+		return StageOnTheWay
+	case orderentity.DeliveryOrderStatusDelivered:
+		return StageDelivered
+	case orderentity.DeliveryOrderStatusFailed, orderentity.DeliveryOrderStatusReturned:
+		return StageNotDone
+	}
+
+	if order.IsReadyToShip() {
+		return StageReady
+	}
+
+	for _, group := range order.Groups {
+		if group.Status == groupitementity.StatusGroupStarted || group.Status == groupitementity.StatusGroupReady {
+			return StagePreparing
+		}
+	}
+
+	return StageReceived
+}
@@ -0,0 +1,63 @@
+package trackingentity
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrTrackingTokenExpired = errors.New("tracking token expired")
+)
+
+// ExpirationAfterDelivery keeps the tracking available for a while after the delivery ends.
+const ExpirationAfterDelivery = 30 * time.Minute
+
+const tokenBytes = 32
+
+// TrackingToken is stored in the public schema, it points the customer to the schema of the company.
+type TrackingToken struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:tracking_tokens"`
+	TrackingTokenCommonAttributes
+}
+
+type TrackingTokenCommonAttributes struct {
+	Token      string     `bun:"token,unique,notnull" json:"token"`
+	SchemaName string     `bun:"schema_name,notnull" json:"-"`
+	DeliveryID uuid.UUID  `bun:"column:delivery_id,type:uuid,notnull" json:"delivery_id"`
+	OrderID    uuid.UUID  `bun:"column:order_id,type:uuid,notnull" json:"order_id"`
+	ExpiresAt  *time.Time `bun:"expires_at" json:"expires_at,omitempty"`
+}
+
+func NewTrackingToken(schemaName string, deliveryID uuid.UUID, orderID uuid.UUID) (*TrackingToken, error) {
+	bytes := make([]byte, tokenBytes)
+
+	if _, err := rand.Read(bytes); err != nil {
+		return nil, err
+	}
+
+	return &TrackingToken{
+		Entity: entity.NewEntity(),
+		TrackingTokenCommonAttributes: TrackingTokenCommonAttributes{
+			Token:      hex.EncodeToString(bytes),
+			SchemaName: schemaName,
+			DeliveryID: deliveryID,
+			OrderID:    orderID,
+		},
+	}, nil
+}
+
+func (t *TrackingToken) ExpireAfterDelivery() {
+	t.ExpiresAt = &time.Time{}
+	*t.ExpiresAt = time.Now().Add(ExpirationAfterDelivery)
+}
+
+func (t *TrackingToken) IsExpired() bool {
+	return t.ExpiresAt != nil && time.Now().After(*t.ExpiresAt)
+}
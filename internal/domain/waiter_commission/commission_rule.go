@@ -0,0 +1,131 @@
+package waitercommissionentity
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrCommissionTypeInvalid     = errors.New("commission type is invalid")
+	ErrCommissionPercentInvalid  = errors.New("commission percentage must be between 0 and 100")
+	ErrServiceChargeRateRequired = errors.New("service charge rate is required")
+	ErrCommissionRuleNotFound    = errors.New("commission rule not found")
+)
+
+// CommissionRule defines the percentage a waiter earns over the tables served, a rule without waiter is the default of the company.
+type CommissionRule struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:waiter_commission_rules"`
+	CommissionRuleCommonAttributes
+}
+
+type CommissionRuleCommonAttributes struct {
+	WaiterID *uuid.UUID     `bun:"column:waiter_id,type:uuid" json:"waiter_id,omitempty"`
+	Type     CommissionType `bun:"type,notnull" json:"type"`
+	// Percentage is over the sales, over the service charge or the default of the categories
+	Percentage float64 `bun:"percentage" json:"percentage"`
+	// ServiceChargeRate is the percentage of the sales charged as service charge on the tables
+	ServiceChargeRate  float64            `bun:"service_charge_rate" json:"service_charge_rate,omitempty"`
+	CategoryPercentage map[string]float64 `bun:"category_percentage,type:jsonb" json:"category_percentage,omitempty"`
+}
+
+type PatchCommissionRule struct {
+	Type               *CommissionType    `json:"type"`
+	Percentage         *float64           `json:"percentage"`
+	ServiceChargeRate  *float64           `json:"service_charge_rate"`
+	CategoryPercentage map[string]float64 `json:"category_percentage"`
+}
+
+func NewCommissionRule(commissionRuleCommonAttributes CommissionRuleCommonAttributes) (*CommissionRule, error) {
+	rule := &CommissionRule{
+		Entity:                         entity.NewEntity(),
+		CommissionRuleCommonAttributes: commissionRuleCommonAttributes,
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (c *CommissionRule) Validate() error {
+	if !isValidPercentage(c.Percentage) {
+		return ErrCommissionPercentInvalid
+	}
+
+	switch c.Type {
+	case CommissionTypeSales, CommissionTypePerCategory:
+	case CommissionTypeServiceCharge:
+		if c.ServiceChargeRate <= 0 || !isValidPercentage(c.ServiceChargeRate) {
+			return ErrServiceChargeRateRequired
+		}
+	default:
+		return ErrCommissionTypeInvalid
+	}
+
+	for _, percentage := range c.CategoryPercentage {
+		if !isValidPercentage(percentage) {
+			return ErrCommissionPercentInvalid
+		}
+	}
+
+	return nil
+}
+
+// Commission returns the amount earned over the order of a table.
+func (c *CommissionRuleCommonAttributes) Commission(order *orderentity.Order) float64 {
+	switch c.Type {
+	case CommissionTypeServiceCharge:
+		return order.TotalPayable * c.ServiceChargeRate / 100 * c.Percentage / 100
+	case CommissionTypePerCategory:
+		commission := 0.0
+		for _, group := range order.Groups {
+			if group.Status == groupitementity.StatusGroupCanceled {
+				continue
+			}
+
+			percentage, ok := c.CategoryPercentage[group.CategoryID.String()]
+			if !ok {
+				percentage = c.Percentage
+			}
+
+			commission += group.Total * percentage / 100
+		}
+
+		return commission
+	default:
+		return order.TotalPayable * c.Percentage / 100
+	}
+}
+
+// FindCommissionRule returns the rule of the waiter or the default of the company.
+func FindCommissionRule(rules []CommissionRule, waiterID uuid.UUID) (*CommissionRule, error) {
+	var defaultRule *CommissionRule
+
+	for i := range rules {
+		if rules[i].WaiterID == nil {
+			defaultRule = &rules[i]
+			continue
+		}
+
+		if *rules[i].WaiterID == waiterID {
+			return &rules[i], nil
+		}
+	}
+
+	if defaultRule == nil {
+		return nil, ErrCommissionRuleNotFound
+	}
+
+	return defaultRule, nil
+}
+
+func isValidPercentage(percentage float64) bool {
+	return percentage >= 0 && percentage <= 100
+}
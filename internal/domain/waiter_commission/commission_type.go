@@ -0,0 +1,17 @@
+package waitercommissionentity
+
+type CommissionType string
+
+const (
+	CommissionTypeSales         CommissionType = "Sales"
+	CommissionTypeServiceCharge CommissionType = "ServiceCharge"
+	CommissionTypePerCategory   CommissionType = "PerCategory"
+)
+
+func GetAllCommissionTypes() []CommissionType {
+	return []CommissionType{
+		CommissionTypeSales,
+		CommissionTypeServiceCharge,
+		CommissionTypePerCategory,
+	}
+}
@@ -0,0 +1,19 @@
+package waitercommissionentity
+
+import "context"
+
+type CommissionRuleRepository interface {
+	CreateCommissionRule(ctx context.Context, rule *CommissionRule) error
+	UpdateCommissionRule(ctx context.Context, rule *CommissionRule) error
+	DeleteCommissionRule(ctx context.Context, id string) error
+	GetCommissionRuleById(ctx context.Context, id string) (*CommissionRule, error)
+	GetAllCommissionRules(ctx context.Context) ([]CommissionRule, error)
+}
+
+type WaiterCommissionRepository interface {
+	CreateWaiterCommission(ctx context.Context, commission *WaiterCommission) error
+	UpdateWaiterCommission(ctx context.Context, commission *WaiterCommission) error
+	GetWaiterCommissionById(ctx context.Context, id string) (*WaiterCommission, error)
+	GetAllWaiterCommissions(ctx context.Context) ([]WaiterCommission, error)
+	GetWaiterCommissionsByWaiterId(ctx context.Context, waiterID string) ([]WaiterCommission, error)
+}
@@ -0,0 +1,15 @@
+package waitercommissionentity
+
+type StatusCommission string
+
+const (
+	CommissionStatusOpen   StatusCommission = "Open"
+	CommissionStatusClosed StatusCommission = "Closed"
+)
+
+func GetAllCommissionStatus() []StatusCommission {
+	return []StatusCommission{
+		CommissionStatusOpen,
+		CommissionStatusClosed,
+	}
+}
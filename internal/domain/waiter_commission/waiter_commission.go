@@ -0,0 +1,125 @@
+package waitercommissionentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrCommissionAlreadyClosed  = errors.New("commission already closed")
+	ErrCommissionWithoutTables  = errors.New("commission must have at least one table")
+	ErrTableAlreadyCommissioned = errors.New("table already in another commission")
+)
+
+// WaiterCommission is the payment of the tables served by a waiter, the tables are linked so they are not paid twice.
+type WaiterCommission struct {
+	entity.Entity
+	bun.BaseModel `bun:"table:waiter_commissions"`
+	CommissionTimeLogs
+	WaiterCommissionCommonAttributes
+}
+
+type WaiterCommissionCommonAttributes struct {
+	Status          StatusCommission               `bun:"status,notnull" json:"status"`
+	WaiterID        uuid.UUID                      `bun:"column:waiter_id,type:uuid,notnull" json:"waiter_id"`
+	Waiter          *employeeentity.Employee       `bun:"rel:belongs-to" json:"waiter,omitempty"`
+	StartAt         time.Time                      `bun:"start_at,notnull" json:"start_at"`
+	EndAt           time.Time                      `bun:"end_at,notnull" json:"end_at"`
+	Rule            CommissionRuleCommonAttributes `bun:"rule,type:jsonb" json:"rule"`
+	Tables          []CommissionTable              `bun:"tables,type:jsonb" json:"tables"`
+	TotalCommission float64                        `bun:"total_commission" json:"total_commission"`
+	Observation     string                         `bun:"observation" json:"observation,omitempty"`
+	ClosedByID      *uuid.UUID                     `bun:"column:closed_by_id,type:uuid" json:"closed_by_id,omitempty"`
+	WaiterPerformance
+}
+
+// WaiterPerformance is shared by the commissions and the period report.
+type WaiterPerformance struct {
+	TotalTables         int     `bun:"total_tables" json:"total_tables"`
+	TotalSales          float64 `bun:"total_sales" json:"total_sales"`
+	AverageTicket       float64 `bun:"average_ticket" json:"average_ticket"`
+	AverageTableMinutes float64 `bun:"average_table_minutes" json:"average_table_minutes"`
+	totalTableMinutes   float64
+}
+
+// CommissionTable is a snapshot of a table at the moment of the commission.
+type CommissionTable struct {
+	TableOrderID uuid.UUID  `json:"table_order_id"`
+	OrderID      uuid.UUID  `json:"order_id"`
+	TableID      uuid.UUID  `json:"table_id"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	Sales        float64    `json:"sales"`
+	TableMinutes float64    `json:"table_minutes"`
+	Commission   float64    `json:"commission"`
+}
+
+type CommissionTimeLogs struct {
+	ClosedAt *time.Time `bun:"closed_at" json:"closed_at,omitempty"`
+}
+
+func NewWaiterCommission(waiterID uuid.UUID, startAt time.Time, endAt time.Time, rule *CommissionRule) *WaiterCommission {
+	return &WaiterCommission{
+		Entity: entity.NewEntity(),
+		WaiterCommissionCommonAttributes: WaiterCommissionCommonAttributes{
+			Status:   CommissionStatusOpen,
+			WaiterID: waiterID,
+			StartAt:  startAt,
+			EndAt:    endAt,
+			Rule:     rule.CommissionRuleCommonAttributes,
+			Tables:   []CommissionTable{},
+		},
+	}
+}
+
+func (c *WaiterCommission) AddTable(tableOrder *orderentity.TableOrder, order *orderentity.Order) {
+	item := CommissionTable{
+		TableOrderID: tableOrder.ID,
+		OrderID:      order.ID,
+		TableID:      tableOrder.TableID,
+		FinishedAt:   order.FinishedAt,
+		Sales:        order.TotalPayable,
+		TableMinutes: TableMinutes(tableOrder, order),
+		Commission:   c.Rule.Commission(order),
+	}
+
+	c.Tables = append(c.Tables, item)
+	c.TotalCommission += item.Commission
+	c.WaiterPerformance.AddTable(item.Sales, item.TableMinutes)
+}
+
+// Close signs off the commission as paid.
+func (c *WaiterCommission) Close(closedByID uuid.UUID, observation string) error {
+	if c.Status == CommissionStatusClosed {
+		return ErrCommissionAlreadyClosed
+	}
+
+	c.Status = CommissionStatusClosed
+	c.ClosedByID = &closedByID
+	c.Observation = observation
+	c.ClosedAt = &time.Time{}
+	*c.ClosedAt = time.Now()
+	return nil
+}
+
+func (p *WaiterPerformance) AddTable(sales float64, tableMinutes float64) {
+	p.TotalTables++
+	p.TotalSales += sales
+	p.totalTableMinutes += tableMinutes
+	p.AverageTicket = p.TotalSales / float64(p.TotalTables)
+	p.AverageTableMinutes = p.totalTableMinutes / float64(p.TotalTables)
+}
+
+// TableMinutes is the time from the opening of the table until the order is finished.
+func TableMinutes(tableOrder *orderentity.TableOrder, order *orderentity.Order) float64 {
+	if order.FinishedAt == nil {
+		return 0
+	}
+
+	return order.FinishedAt.Sub(tableOrder.CreatedAt).Minutes()
+}
@@ -0,0 +1,97 @@
+package waitercommissionentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+func newTable(waiterID uuid.UUID, total float64, minutes int, groups ...groupitementity.GroupItem) (*orderentity.TableOrder, *orderentity.Order) {
+	table := &orderentity.TableOrder{
+		Entity:                     entity.NewEntity(),
+		TableOrderCommonAttributes: orderentity.TableOrderCommonAttributes{WaiterID: waiterID, TableID: uuid.New()},
+	}
+
+	finishedAt := table.CreatedAt.Add(time.Duration(minutes) * time.Minute)
+	order := &orderentity.Order{Entity: entity.NewEntity()}
+	order.TotalPayable = total
+	order.FinishedAt = &finishedAt
+	order.Groups = groups
+	table.OrderID = order.ID
+
+	return table, order
+}
+
+func newGroup(categoryID uuid.UUID, total float64, status groupitementity.StatusGroupItem) groupitementity.GroupItem {
+	group := groupitementity.GroupItem{}
+	group.CategoryID = categoryID
+	group.Total = total
+	group.Status = status
+	return group
+}
+
+func TestCommissionRules(t *testing.T) {
+	drinksID := uuid.New()
+	_, order := newTable(uuid.New(), 100, 60,
+		newGroup(drinksID, 40, groupitementity.StatusGroupReady),
+		newGroup(uuid.New(), 60, groupitementity.StatusGroupReady),
+		newGroup(drinksID, 30, groupitementity.StatusGroupCanceled),
+	)
+
+	sales, err := NewCommissionRule(CommissionRuleCommonAttributes{Type: CommissionTypeSales, Percentage: 5})
+	assert.Nil(t, err)
+	assert.InDelta(t, 5.0, sales.Commission(order), 0.001)
+
+	serviceCharge, err := NewCommissionRule(CommissionRuleCommonAttributes{Type: CommissionTypeServiceCharge, Percentage: 50, ServiceChargeRate: 10})
+	assert.Nil(t, err)
+	assert.InDelta(t, 5.0, serviceCharge.Commission(order), 0.001)
+
+	perCategory, err := NewCommissionRule(CommissionRuleCommonAttributes{Type: CommissionTypePerCategory, Percentage: 2, CategoryPercentage: map[string]float64{drinksID.String(): 10}})
+	assert.Nil(t, err)
+	assert.InDelta(t, 5.2, perCategory.Commission(order), 0.001)
+
+	_, err = NewCommissionRule(CommissionRuleCommonAttributes{Type: CommissionTypeServiceCharge, Percentage: 50})
+	assert.Equal(t, ErrServiceChargeRateRequired, err)
+
+	_, err = NewCommissionRule(CommissionRuleCommonAttributes{Type: CommissionTypeSales, Percentage: 120})
+	assert.Equal(t, ErrCommissionPercentInvalid, err)
+}
+
+func TestWaiterCommission(t *testing.T) {
+	waiterID := uuid.New()
+	rule, _ := NewCommissionRule(CommissionRuleCommonAttributes{Type: CommissionTypeSales, Percentage: 10})
+
+	found, err := FindCommissionRule([]CommissionRule{*rule}, waiterID)
+	assert.Nil(t, err)
+	assert.Equal(t, rule.ID, found.ID)
+
+	commission := NewWaiterCommission(waiterID, time.Now().Add(-time.Hour), time.Now(), rule)
+	commission.AddTable(newTable(waiterID, 100, 30))
+	commission.AddTable(newTable(waiterID, 50, 90))
+
+	assert.Equal(t, 2, commission.TotalTables)
+	assert.InDelta(t, 150.0, commission.TotalSales, 0.001)
+	assert.InDelta(t, 75.0, commission.AverageTicket, 0.001)
+	assert.InDelta(t, 60.0, commission.AverageTableMinutes, 0.001)
+	assert.InDelta(t, 15.0, commission.TotalCommission, 0.001)
+
+	assert.Nil(t, commission.Close(uuid.New(), ""))
+	assert.Equal(t, ErrCommissionAlreadyClosed, commission.Close(uuid.New(), ""))
+
+	// Tables linked to a commission are reported but not owed again
+	report := NewWaiterReport(waiterID, nil)
+	linkedTable, linkedOrder := newTable(waiterID, 100, 30)
+	linkedTable.CommissionID = &commission.ID
+	report.AddTable(linkedTable, linkedOrder, rule)
+	table, order := newTable(waiterID, 20, 30)
+	report.AddTable(table, order, rule)
+
+	assert.Equal(t, 2, report.TotalTables)
+	assert.InDelta(t, 10.0, report.CommissionLinked, 0.001)
+	assert.InDelta(t, 2.0, report.CommissionOwed, 0.001)
+}
@@ -0,0 +1,38 @@
+package waitercommissionentity
+
+import (
+	"github.com/google/uuid"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+// WaiterReport is the performance of a waiter in a period, tables already in a commission are not owed again.
+type WaiterReport struct {
+	WaiterID         uuid.UUID                `json:"waiter_id"`
+	Waiter           *employeeentity.Employee `json:"waiter,omitempty"`
+	CommissionOwed   float64                  `json:"commission_owed"`
+	CommissionLinked float64                  `json:"commission_linked"`
+	WaiterPerformance
+}
+
+func NewWaiterReport(waiterID uuid.UUID, waiter *employeeentity.Employee) *WaiterReport {
+	return &WaiterReport{
+		WaiterID: waiterID,
+		Waiter:   waiter,
+	}
+}
+
+func (r *WaiterReport) AddTable(tableOrder *orderentity.TableOrder, order *orderentity.Order, rule *CommissionRule) {
+	r.WaiterPerformance.AddTable(order.TotalPayable, TableMinutes(tableOrder, order))
+
+	if rule == nil {
+		return
+	}
+
+	commission := rule.Commission(order)
+	if tableOrder.CommissionID != nil {
+		r.CommissionLinked += commission
+	} else {
+		r.CommissionOwed += commission
+	}
+}
@@ -19,13 +19,17 @@ type RegisterAddressInput struct {
 	addressentity.PatchAddress
 }
 
+// validate requires the fields filled by the cep only when it is not sent.
 func (a *RegisterAddressInput) validate() error {
-	if a.Street == nil {
-		return ErrStreetRequired
-	}
 	if a.Number == nil {
 		return ErrNumberRequired
 	}
+	if a.Cep != nil {
+		return nil
+	}
+	if a.Street == nil {
+		return ErrStreetRequired
+	}
 	if a.Neighborhood == nil {
 		return ErrNeighborhoodRequired
 	}
@@ -44,14 +48,42 @@ func (a *RegisterAddressInput) ToModel() (*addressentity.Address, error) {
 	}
 
 	addressCommonAttributes := addressentity.AddressCommonAttributes{
-		Street:       *a.Street,
-		Number:       *a.Number,
-		Complement:   *a.Complement,
-		Reference:    *a.Reference,
-		Neighborhood: *a.Neighborhood,
-		City:         *a.City,
-		State:        *a.State,
-		Cep:          *a.Cep,
+		Number:    *a.Number,
+		Latitude:  a.Latitude,
+		Longitude: a.Longitude,
+	}
+
+	// Fields filled by the cep when missing
+	if a.Street != nil {
+		addressCommonAttributes.Street = *a.Street
+	}
+	if a.Neighborhood != nil {
+		addressCommonAttributes.Neighborhood = *a.Neighborhood
+	}
+	if a.City != nil {
+		addressCommonAttributes.City = *a.City
+	}
+	if a.State != nil {
+		addressCommonAttributes.State = *a.State
+	}
+
+	// Optional fields
+	if a.Label != nil {
+		addressCommonAttributes.Label = *a.Label
+	}
+	if a.IsDefault != nil {
+		addressCommonAttributes.IsDefault = *a.IsDefault
+	}
+	if a.Complement != nil {
+		addressCommonAttributes.Complement = *a.Complement
+	}
+	if a.Reference != nil {
+		addressCommonAttributes.Reference = *a.Reference
+	}
+	if a.Cep != nil {
+		if err := addressCommonAttributes.SetCep(*a.Cep); err != nil {
+			return nil, err
+		}
 	}
 
 	return &addressentity.Address{
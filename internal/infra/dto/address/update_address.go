@@ -0,0 +1,75 @@
+package addressdto
+
+import (
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+)
+
+type UpdateAddressInput struct {
+	addressentity.PatchAddress
+}
+
+func (a *UpdateAddressInput) validate() error {
+	if a.Street != nil && *a.Street == "" {
+		return ErrStreetRequired
+	}
+	if a.Number != nil && *a.Number == "" {
+		return ErrNumberRequired
+	}
+	if a.Neighborhood != nil && *a.Neighborhood == "" {
+		return ErrNeighborhoodRequired
+	}
+	if a.City != nil && *a.City == "" {
+		return ErrCityRequired
+	}
+	if a.State != nil && *a.State == "" {
+		return ErrStateRequired
+	}
+	return nil
+}
+
+func (a *UpdateAddressInput) UpdateModel(model *addressentity.Address) error {
+	if err := a.validate(); err != nil {
+		return err
+	}
+
+	if a.Label != nil {
+		model.Label = *a.Label
+	}
+	if a.Street != nil {
+		model.Street = *a.Street
+	}
+	if a.Number != nil {
+		model.Number = *a.Number
+	}
+	if a.Complement != nil {
+		model.Complement = *a.Complement
+	}
+	if a.Reference != nil {
+		model.Reference = *a.Reference
+	}
+	if a.Neighborhood != nil {
+		model.Neighborhood = *a.Neighborhood
+	}
+	if a.City != nil {
+		model.City = *a.City
+	}
+	if a.State != nil {
+		model.State = *a.State
+	}
+	if a.Cep != nil {
+		if err := model.SetCep(*a.Cep); err != nil {
+			return err
+		}
+	}
+	if a.DeliveryTax != nil {
+		model.DeliveryTax = *a.DeliveryTax
+	}
+	if a.Latitude != nil {
+		model.Latitude = a.Latitude
+	}
+	if a.Longitude != nil {
+		model.Longitude = a.Longitude
+	}
+
+	return model.Validate()
+}
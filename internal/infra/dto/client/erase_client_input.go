@@ -0,0 +1,5 @@
+package clientdto
+
+type EraseClientInput struct {
+	Observation string `json:"observation"`
+}
@@ -0,0 +1,37 @@
+package clientdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSurvivorIDRequired  = errors.New("survivor id is required")
+	ErrDuplicateIDRequired = errors.New("duplicate id is required")
+)
+
+type MergeClientsInput struct {
+	SurvivorID  uuid.UUID `json:"survivor_id"`
+	DuplicateID uuid.UUID `json:"duplicate_id"`
+}
+
+func (m *MergeClientsInput) validate() error {
+	if m.SurvivorID == uuid.Nil {
+		return ErrSurvivorIDRequired
+	}
+
+	if m.DuplicateID == uuid.Nil {
+		return ErrDuplicateIDRequired
+	}
+
+	return nil
+}
+
+func (m *MergeClientsInput) ToModel() (survivorID uuid.UUID, duplicateID uuid.UUID, err error) {
+	if err := m.validate(); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return m.SurvivorID, m.DuplicateID, nil
+}
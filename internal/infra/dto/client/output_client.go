@@ -1,17 +1,42 @@
 package clientdto
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	creditaccountentity "github.com/willjrcom/sales-backend-go/internal/domain/credit_account"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
 )
 
 type ClientOutput struct {
 	ID uuid.UUID `json:"id"`
 	personentity.PersonCommonAttributes
+	Contacts  []personentity.Contact  `json:"contacts,omitempty"`
+	Addresses []addressentity.Address `json:"addresses,omitempty"`
+	Credit    *ClientCreditOutput     `json:"credit,omitempty"`
+}
+
+type ClientCreditOutput struct {
+	AccountID       uuid.UUID `json:"account_id"`
+	Balance         float64   `json:"balance"`
+	AvailableCredit float64   `json:"available_credit"`
+	OverdueAmount   float64   `json:"overdue_amount"`
+	IsBlocked       bool      `json:"is_blocked"`
+}
+
+func (c *ClientCreditOutput) FromModel(model *creditaccountentity.CreditAccount, now time.Time) {
+	c.AccountID = model.ID
+	c.Balance = model.Balance
+	c.AvailableCredit = model.AvailableCredit()
+	c.OverdueAmount = model.OverdueAmount(now)
+	c.IsBlocked = model.IsBlocked
 }
 
 func (c *ClientOutput) FromModel(model *cliententity.Client) {
 	c.ID = model.ID
 	c.PersonCommonAttributes = model.PersonCommonAttributes
+	c.Contacts = model.Contacts
+	c.Addresses = model.Addresses
 }
@@ -0,0 +1,29 @@
+package clientdto
+
+import (
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	clientanalyticsentity "github.com/willjrcom/sales-backend-go/internal/domain/client_analytics"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type ClientHistoryOutput struct {
+	Client  ClientOutput                        `json:"client"`
+	Summary clientanalyticsentity.ClientSummary `json:"summary"`
+	Orders  []orderentity.Order                 `json:"orders"`
+}
+
+func (o *ClientHistoryOutput) FromModel(client *cliententity.Client, summary *clientanalyticsentity.ClientSummary, orders []orderentity.Order) {
+	o.Client.FromModel(client)
+	o.Summary = *summary
+	o.Orders = orders
+}
+
+type ClientSegmentOutput struct {
+	Client ClientOutput `json:"client"`
+	clientanalyticsentity.ClientRFM
+}
+
+func (o *ClientSegmentOutput) FromModel(client *cliententity.Client, rfm clientanalyticsentity.ClientRFM) {
+	o.Client.FromModel(client)
+	o.ClientRFM = rfm
+}
@@ -0,0 +1,38 @@
+package clientdto
+
+import (
+	"time"
+
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	creditaccountentity "github.com/willjrcom/sales-backend-go/internal/domain/credit_account"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
+)
+
+// ClientDataExport is the bundle answered to a lgpd access request.
+type ClientDataExport struct {
+	ExportedAt          time.Time                          `json:"exported_at"`
+	Client              ClientOutput                       `json:"client"`
+	Orders              []orderentity.Order                `json:"orders"`
+	LoyaltyTransactions []loyaltyentity.LoyaltyTransaction `json:"loyalty_transactions"`
+	Merges              []cliententity.ClientMerge         `json:"merges"`
+	Reservations        []reservationentity.Reservation    `json:"reservations"`
+	CreditAccount       *creditaccountentity.CreditAccount `json:"credit_account,omitempty"`
+}
+
+func (o *ClientDataExport) FromModel(client *cliententity.Client, orders []orderentity.Order, transactions []loyaltyentity.LoyaltyTransaction, merges []cliententity.ClientMerge, reservations []reservationentity.Reservation, account *creditaccountentity.CreditAccount) {
+	o.ExportedAt = time.Now()
+	o.Client.FromModel(client)
+	o.Orders = orders
+	o.LoyaltyTransactions = transactions
+	o.Reservations = reservations
+	o.CreditAccount = account
+	o.Merges = []cliententity.ClientMerge{}
+
+	for _, merge := range merges {
+		if merge.SurvivorID == client.ID || merge.MergedID == client.ID {
+			o.Merges = append(o.Merges, merge)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package companydto
+
+import (
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+)
+
+type CompanySettingsInput struct {
+	Timezone         *string                         `json:"timezone"`
+	Currency         *string                         `json:"currency"`
+	Locale           *string                         `json:"locale"`
+	BusinessHours    []companyentity.BusinessHours   `json:"business_hours"`
+	ServiceCharge    *float64                        `json:"service_charge"`
+	OrderNumberReset *companyentity.OrderNumberReset `json:"order_number_reset"`
+}
+
+func (c *CompanySettingsInput) UpdateModel(model *companyentity.CompanySettings) error {
+	if c.Timezone != nil {
+		model.Timezone = *c.Timezone
+	}
+
+	if c.Currency != nil {
+		model.Currency = *c.Currency
+	}
+
+	if c.Locale != nil {
+		model.Locale = *c.Locale
+	}
+
+	if c.BusinessHours != nil {
+		model.BusinessHours = c.BusinessHours
+	}
+
+	if c.ServiceCharge != nil {
+		model.ServiceCharge = *c.ServiceCharge
+	}
+
+	if c.OrderNumberReset != nil {
+		model.OrderNumberReset = *c.OrderNumberReset
+	}
+
+	return model.Validate()
+}
@@ -0,0 +1,28 @@
+package companydto
+
+import (
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+)
+
+type TwoFactorPolicyInput struct {
+	Roles []companyentity.UserRole `json:"roles"`
+}
+
+func (c *TwoFactorPolicyInput) validate() error {
+	for _, role := range c.Roles {
+		if !companyentity.IsValidUserRole(role) {
+			return ErrRoleInvalid
+		}
+	}
+
+	return nil
+}
+
+func (c *TwoFactorPolicyInput) UpdateModel(model *companyentity.Company) error {
+	if err := c.validate(); err != nil {
+		return err
+	}
+
+	model.TwoFactorRequiredRoles = c.Roles
+	return nil
+}
@@ -9,10 +9,12 @@ import (
 var (
 	ErrMustBeEmail    = errors.New("email is required")
 	ErrMustBePassword = errors.New("password is required")
+	ErrRoleInvalid    = errors.New("role must be Owner, Manager or Employee")
 )
 
 type UserInput struct {
 	companyentity.UserCommonAttributes
+	Role companyentity.UserRole `json:"role"`
 }
 
 func (c *UserInput) validate() error {
@@ -20,13 +22,22 @@ func (c *UserInput) validate() error {
 		return ErrMustBeEmail
 	}
 
+	if c.Role != "" && !companyentity.IsValidUserRole(c.Role) {
+		return ErrRoleInvalid
+	}
+
 	return nil
 }
 
-func (c *UserInput) ToModel() (*companyentity.User, error) {
+func (c *UserInput) ToModel() (*companyentity.User, companyentity.UserRole, error) {
 	if err := c.validate(); err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	role := c.Role
+	if role == "" {
+		role = companyentity.RoleEmployee
 	}
 
-	return companyentity.NewUser(c.UserCommonAttributes), nil
+	return companyentity.NewUser(c.UserCommonAttributes), role, nil
 }
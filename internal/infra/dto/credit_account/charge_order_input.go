@@ -0,0 +1,36 @@
+package creditaccountdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOrderIDRequired = errors.New("order id is required")
+)
+
+type ChargeOrderInput struct {
+	OrderID  uuid.UUID `json:"order_id"`
+	ClientID uuid.UUID `json:"client_id"`
+}
+
+func (c *ChargeOrderInput) validate() error {
+	if c.OrderID == uuid.Nil {
+		return ErrOrderIDRequired
+	}
+
+	if c.ClientID == uuid.Nil {
+		return ErrClientIDRequired
+	}
+
+	return nil
+}
+
+func (c *ChargeOrderInput) ToModel() (orderID uuid.UUID, clientID uuid.UUID, err error) {
+	if err := c.validate(); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return c.OrderID, c.ClientID, nil
+}
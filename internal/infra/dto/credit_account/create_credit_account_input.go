@@ -0,0 +1,40 @@
+package creditaccountdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	creditaccountentity "github.com/willjrcom/sales-backend-go/internal/domain/credit_account"
+)
+
+var (
+	ErrClientIDRequired = errors.New("client id is required")
+)
+
+type CreateCreditAccountInput struct {
+	ClientID    uuid.UUID `json:"client_id"`
+	CreditLimit float64   `json:"credit_limit"`
+	DueDay      int       `json:"due_day"`
+}
+
+func (c *CreateCreditAccountInput) validate() error {
+	if c.ClientID == uuid.Nil {
+		return ErrClientIDRequired
+	}
+
+	return nil
+}
+
+func (c *CreateCreditAccountInput) ToModel() (*creditaccountentity.CreditAccount, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	account := creditaccountentity.NewCreditAccount(c.ClientID, c.CreditLimit, c.DueDay)
+
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
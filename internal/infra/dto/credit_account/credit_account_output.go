@@ -0,0 +1,67 @@
+package creditaccountdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	creditaccountentity "github.com/willjrcom/sales-backend-go/internal/domain/credit_account"
+)
+
+type CreditAccountOutput struct {
+	ID              uuid.UUID                         `json:"id"`
+	ClientID        uuid.UUID                         `json:"client_id"`
+	ClientName      string                            `json:"client_name,omitempty"`
+	CreditLimit     float64                           `json:"credit_limit"`
+	Balance         float64                           `json:"balance"`
+	AvailableCredit float64                           `json:"available_credit"`
+	OverdueAmount   float64                           `json:"overdue_amount"`
+	IsOverdue       bool                              `json:"is_overdue"`
+	IsBlocked       bool                              `json:"is_blocked"`
+	DueDay          int                               `json:"due_day"`
+	Entries         []creditaccountentity.CreditEntry `json:"entries,omitempty"`
+}
+
+func (o *CreditAccountOutput) FromModel(model *creditaccountentity.CreditAccount, now time.Time) {
+	o.ID = model.ID
+	o.ClientID = model.ClientID
+	o.CreditLimit = model.CreditLimit
+	o.Balance = model.Balance
+	o.AvailableCredit = model.AvailableCredit()
+	o.OverdueAmount = model.OverdueAmount(now)
+	o.IsOverdue = o.OverdueAmount > 0
+	o.IsBlocked = model.IsBlocked
+	o.DueDay = model.DueDay
+	o.Entries = model.Entries
+
+	if model.Client != nil {
+		o.ClientName = model.Client.Name
+	}
+}
+
+type ReceivablesOutput struct {
+	TotalBalance  float64               `json:"total_balance"`
+	TotalOverdue  float64               `json:"total_overdue"`
+	TotalAccounts int                   `json:"total_accounts"`
+	Accounts      []CreditAccountOutput `json:"accounts"`
+}
+
+// FromModel lists the accounts with outstanding balance, without the entries.
+func (o *ReceivablesOutput) FromModel(accounts []creditaccountentity.CreditAccount, now time.Time) {
+	o.Accounts = []CreditAccountOutput{}
+
+	for i := range accounts {
+		if accounts[i].Balance <= 0 {
+			continue
+		}
+
+		account := CreditAccountOutput{}
+		account.FromModel(&accounts[i], now)
+		account.Entries = nil
+
+		o.TotalBalance += account.Balance
+		o.TotalOverdue += account.OverdueAmount
+		o.Accounts = append(o.Accounts, account)
+	}
+
+	o.TotalAccounts = len(o.Accounts)
+}
@@ -0,0 +1,43 @@
+package creditaccountdto
+
+import (
+	"errors"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrAmountInvalid = errors.New("amount is invalid")
+	ErrMethodInvalid = errors.New("payment method is invalid")
+)
+
+type SettleInput struct {
+	Amount float64               `json:"amount"`
+	Method orderentity.PayMethod `json:"method"`
+}
+
+func (s *SettleInput) validate() error {
+	if s.Amount <= 0 {
+		return ErrAmountInvalid
+	}
+
+	if s.Method == orderentity.Dinheiro {
+		return nil
+	}
+
+	for _, method := range orderentity.GetAllPayMethod() {
+		if method == s.Method {
+			return nil
+		}
+	}
+
+	return ErrMethodInvalid
+}
+
+func (s *SettleInput) ToModel() (amount float64, method orderentity.PayMethod, err error) {
+	if err := s.validate(); err != nil {
+		return 0, "", err
+	}
+
+	return s.Amount, s.Method, nil
+}
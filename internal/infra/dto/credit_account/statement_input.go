@@ -0,0 +1,36 @@
+package creditaccountdto
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrPeriodRequired = errors.New("start and end dates are required")
+	ErrEndBeforeStart = errors.New("end date must be after start date")
+)
+
+type StatementInput struct {
+	StartAt *time.Time `json:"start_at"`
+	EndAt   *time.Time `json:"end_at"`
+}
+
+func (s *StatementInput) validate() error {
+	if s.StartAt == nil || s.EndAt == nil {
+		return ErrPeriodRequired
+	}
+
+	if s.EndAt.Before(*s.StartAt) {
+		return ErrEndBeforeStart
+	}
+
+	return nil
+}
+
+func (s *StatementInput) ToModel() (startAt time.Time, endAt time.Time, err error) {
+	if err := s.validate(); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return *s.StartAt, *s.EndAt, nil
+}
@@ -0,0 +1,23 @@
+package creditaccountdto
+
+import (
+	creditaccountentity "github.com/willjrcom/sales-backend-go/internal/domain/credit_account"
+)
+
+type UpdateCreditAccountInput struct {
+	creditaccountentity.PatchCreditAccount
+}
+
+func (u *UpdateCreditAccountInput) UpdateModel(model *creditaccountentity.CreditAccount) error {
+	if u.CreditLimit != nil {
+		model.CreditLimit = *u.CreditLimit
+	}
+	if u.DueDay != nil {
+		model.DueDay = *u.DueDay
+	}
+	if u.IsBlocked != nil {
+		model.IsBlocked = *u.IsBlocked
+	}
+
+	return model.Validate()
+}
@@ -15,7 +15,8 @@ var (
 )
 
 type CreateDeliveryOrderInput struct {
-	ClientID uuid.UUID `json:"client_id"`
+	ClientID  uuid.UUID  `json:"client_id"`
+	AddressID *uuid.UUID `json:"address_id"`
 }
 
 func (o *CreateDeliveryOrderInput) validate() error {
@@ -36,6 +37,11 @@ func (o *CreateDeliveryOrderInput) ToModel() (*orderentity.DeliveryOrder, error)
 		Status:   orderentity.DeliveryOrderStatusPending,
 	}
 
+	// Without address the default address of the client is used
+	if o.AddressID != nil {
+		orderCommonAttributes.AddressID = *o.AddressID
+	}
+
 	return &orderentity.DeliveryOrder{
 		Entity:                        entity.NewEntity(),
 		DeliveryOrderCommonAttributes: orderCommonAttributes,
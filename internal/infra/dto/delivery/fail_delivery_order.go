@@ -0,0 +1,32 @@
+package deliveryorderdto
+
+import (
+	"errors"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrFailureReasonRequired = errors.New("failure reason is required")
+)
+
+type FailDeliveryOrder struct {
+	FailureReason orderentity.DeliveryFailureReason `json:"failure_reason"`
+	Observation   string                            `json:"observation"`
+}
+
+func (f *FailDeliveryOrder) validate() error {
+	if f.FailureReason == "" {
+		return ErrFailureReasonRequired
+	}
+
+	return nil
+}
+
+func (f *FailDeliveryOrder) ToModel() (reason orderentity.DeliveryFailureReason, observation string, err error) {
+	if err := f.validate(); err != nil {
+		return "", "", err
+	}
+
+	return f.FailureReason, f.Observation, nil
+}
@@ -0,0 +1,15 @@
+package deliveryorderdto
+
+import (
+	"time"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type RetryDeliveryOrder struct {
+	orderentity.ScheduledOrder
+}
+
+func (r *RetryDeliveryOrder) ToModel() (startAt *time.Time) {
+	return r.StartAt
+}
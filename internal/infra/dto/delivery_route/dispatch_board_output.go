@@ -0,0 +1,12 @@
+package deliveryroutedto
+
+import (
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	employeedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/employee"
+)
+
+type DispatchBoardOutput struct {
+	ReadyDeliveries  []orderentity.DeliveryOrder  `json:"ready_deliveries"`
+	AvailableDrivers []employeedto.EmployeeOutput `json:"available_drivers"`
+	RoutesInProgress []orderentity.DeliveryRoute  `json:"routes_in_progress"`
+}
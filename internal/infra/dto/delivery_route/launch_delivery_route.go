@@ -0,0 +1,47 @@
+package deliveryroutedto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDriverIDRequired   = errors.New("driver id is required")
+	ErrDeliveriesRequired = errors.New("at least one delivery is required")
+	ErrDuplicatedDelivery = errors.New("delivery is duplicated")
+)
+
+type LaunchDeliveryRouteInput struct {
+	DriverID    uuid.UUID   `json:"driver_id"`
+	DeliveryIDs []uuid.UUID `json:"delivery_ids"`
+}
+
+func (l *LaunchDeliveryRouteInput) validate() error {
+	if l.DriverID == uuid.Nil {
+		return ErrDriverIDRequired
+	}
+
+	if len(l.DeliveryIDs) == 0 {
+		return ErrDeliveriesRequired
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, id := range l.DeliveryIDs {
+		if seen[id] {
+			return ErrDuplicatedDelivery
+		}
+
+		seen[id] = true
+	}
+
+	return nil
+}
+
+func (l *LaunchDeliveryRouteInput) ToModel() (driverID uuid.UUID, deliveryIDs []uuid.UUID, err error) {
+	if err := l.validate(); err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return l.DriverID, l.DeliveryIDs, nil
+}
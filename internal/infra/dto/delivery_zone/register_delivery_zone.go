@@ -0,0 +1,37 @@
+package deliveryzonedto
+
+import (
+	"errors"
+
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+)
+
+var (
+	ErrNameRequired = errors.New("name is required")
+	ErrTypeRequired = errors.New("type is required")
+)
+
+type RegisterDeliveryZoneInput struct {
+	deliveryzoneentity.DeliveryZoneCommonAttributes
+}
+
+func (r *RegisterDeliveryZoneInput) validate() error {
+	if r.Name == "" {
+		return ErrNameRequired
+	}
+
+	if r.Type == "" {
+		return ErrTypeRequired
+	}
+
+	return nil
+}
+
+func (r *RegisterDeliveryZoneInput) ToModel() (*deliveryzoneentity.DeliveryZone, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	r.IsActive = true
+	return deliveryzoneentity.NewDeliveryZone(r.DeliveryZoneCommonAttributes)
+}
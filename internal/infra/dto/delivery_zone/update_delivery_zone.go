@@ -0,0 +1,56 @@
+package deliveryzonedto
+
+import (
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+)
+
+type UpdateDeliveryZoneInput struct {
+	deliveryzoneentity.PatchDeliveryZone
+}
+
+func (u *UpdateDeliveryZoneInput) validate() error {
+	if u.Name != nil && *u.Name == "" {
+		return ErrNameRequired
+	}
+
+	return nil
+}
+
+func (u *UpdateDeliveryZoneInput) UpdateModel(model *deliveryzoneentity.DeliveryZone) error {
+	if err := u.validate(); err != nil {
+		return err
+	}
+
+	if u.Name != nil {
+		model.Name = *u.Name
+	}
+	if u.Type != nil {
+		model.Type = *u.Type
+	}
+	if u.Neighborhoods != nil {
+		model.Neighborhoods = u.Neighborhoods
+	}
+	if u.CepStart != nil {
+		model.CepStart = *u.CepStart
+	}
+	if u.CepEnd != nil {
+		model.CepEnd = *u.CepEnd
+	}
+	if u.RadiusKm != nil {
+		model.RadiusKm = *u.RadiusKm
+	}
+	if u.Fee != nil {
+		model.Fee = *u.Fee
+	}
+	if u.MinOrderValue != nil {
+		model.MinOrderValue = *u.MinOrderValue
+	}
+	if u.EtaMinutes != nil {
+		model.EtaMinutes = *u.EtaMinutes
+	}
+	if u.IsActive != nil {
+		model.IsActive = *u.IsActive
+	}
+
+	return model.Validate()
+}
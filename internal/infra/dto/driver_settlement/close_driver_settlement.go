@@ -0,0 +1,30 @@
+package driversettlementdto
+
+import (
+	"errors"
+)
+
+var (
+	ErrCashReturnedRequired = errors.New("cash returned is required")
+)
+
+type CloseDriverSettlementInput struct {
+	CashReturned *float64 `json:"cash_returned"`
+	Observation  string   `json:"observation"`
+}
+
+func (c *CloseDriverSettlementInput) validate() error {
+	if c.CashReturned == nil {
+		return ErrCashReturnedRequired
+	}
+
+	return nil
+}
+
+func (c *CloseDriverSettlementInput) ToModel() (cashReturned float64, observation string, err error) {
+	if err := c.validate(); err != nil {
+		return 0, "", err
+	}
+
+	return *c.CashReturned, c.Observation, nil
+}
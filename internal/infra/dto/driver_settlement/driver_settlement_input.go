@@ -0,0 +1,49 @@
+package driversettlementdto
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDriverIDRequired     = errors.New("driver id is required")
+	ErrShiftOrPeriodRequest = errors.New("shift id or start and end dates are required")
+	ErrEndBeforeStart       = errors.New("end date must be after start date")
+)
+
+type DriverSettlementInput struct {
+	DriverID uuid.UUID  `json:"driver_id"`
+	ShiftID  *uuid.UUID `json:"shift_id"`
+	StartAt  *time.Time `json:"start_at"`
+	EndAt    *time.Time `json:"end_at"`
+}
+
+func (d *DriverSettlementInput) validate() error {
+	if d.DriverID == uuid.Nil {
+		return ErrDriverIDRequired
+	}
+
+	if d.ShiftID != nil {
+		return nil
+	}
+
+	if d.StartAt == nil || d.EndAt == nil {
+		return ErrShiftOrPeriodRequest
+	}
+
+	if d.EndAt.Before(*d.StartAt) {
+		return ErrEndBeforeStart
+	}
+
+	return nil
+}
+
+func (d *DriverSettlementInput) ToModel() (driverID uuid.UUID, shiftID *uuid.UUID, startAt *time.Time, endAt *time.Time, err error) {
+	if err := d.validate(); err != nil {
+		return uuid.Nil, nil, nil, nil, err
+	}
+
+	return d.DriverID, d.ShiftID, d.StartAt, d.EndAt, nil
+}
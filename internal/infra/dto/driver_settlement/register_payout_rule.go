@@ -0,0 +1,31 @@
+package driversettlementdto
+
+import (
+	"errors"
+
+	driversettlemententity "github.com/willjrcom/sales-backend-go/internal/domain/driver_settlement"
+)
+
+var (
+	ErrPayoutTypeRequired = errors.New("payout type is required")
+)
+
+type RegisterPayoutRuleInput struct {
+	driversettlemententity.PayoutRuleCommonAttributes
+}
+
+func (r *RegisterPayoutRuleInput) validate() error {
+	if r.Type == "" {
+		return ErrPayoutTypeRequired
+	}
+
+	return nil
+}
+
+func (r *RegisterPayoutRuleInput) ToModel() (*driversettlemententity.PayoutRule, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	return driversettlemententity.NewPayoutRule(r.PayoutRuleCommonAttributes)
+}
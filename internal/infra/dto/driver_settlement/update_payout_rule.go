@@ -0,0 +1,35 @@
+package driversettlementdto
+
+import (
+	driversettlemententity "github.com/willjrcom/sales-backend-go/internal/domain/driver_settlement"
+)
+
+type UpdatePayoutRuleInput struct {
+	driversettlemententity.PatchPayoutRule
+}
+
+func (u *UpdatePayoutRuleInput) validate() error {
+	if u.Type != nil && *u.Type == "" {
+		return ErrPayoutTypeRequired
+	}
+
+	return nil
+}
+
+func (u *UpdatePayoutRuleInput) UpdateModel(model *driversettlemententity.PayoutRule) error {
+	if err := u.validate(); err != nil {
+		return err
+	}
+
+	if u.Type != nil {
+		model.Type = *u.Type
+	}
+	if u.Amount != nil {
+		model.Amount = *u.Amount
+	}
+	if u.ZoneAmounts != nil {
+		model.ZoneAmounts = u.ZoneAmounts
+	}
+
+	return model.Validate()
+}
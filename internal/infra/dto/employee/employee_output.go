@@ -9,9 +9,11 @@ import (
 type EmployeeOutput struct {
 	ID uuid.UUID `json:"id"`
 	personentity.PersonCommonAttributes
+	HasPin bool `json:"has_pin"`
 }
 
 func (c *EmployeeOutput) FromModel(model *employeeentity.Employee) {
 	c.ID = model.ID
 	c.PersonCommonAttributes = model.PersonCommonAttributes
+	c.HasPin = model.HasPin()
 }
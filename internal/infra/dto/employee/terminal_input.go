@@ -0,0 +1,74 @@
+package employeedto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+)
+
+var (
+	ErrTerminalNameRequired = errors.New("terminal name required")
+	ErrEmployeeIDRequired   = errors.New("employee id required")
+)
+
+type PinInput struct {
+	Pin string `json:"pin"`
+}
+
+func (r *PinInput) validate() error {
+	if !employeeentity.IsValidPin(r.Pin) {
+		return employeeentity.ErrPinInvalid
+	}
+
+	return nil
+}
+
+func (r *PinInput) ToModel() (string, error) {
+	if err := r.validate(); err != nil {
+		return "", err
+	}
+
+	return r.Pin, nil
+}
+
+type BindTerminalInput struct {
+	Name string `json:"name"`
+}
+
+func (r *BindTerminalInput) validate() error {
+	if r.Name == "" {
+		return ErrTerminalNameRequired
+	}
+
+	return nil
+}
+
+func (r *BindTerminalInput) ToModel() (string, error) {
+	if err := r.validate(); err != nil {
+		return "", err
+	}
+
+	return r.Name, nil
+}
+
+type SwitchEmployeeInput struct {
+	EmployeeID uuid.UUID `json:"employee_id"`
+	PinInput
+}
+
+func (r *SwitchEmployeeInput) validate() error {
+	if r.EmployeeID == uuid.Nil {
+		return ErrEmployeeIDRequired
+	}
+
+	return r.PinInput.validate()
+}
+
+func (r *SwitchEmployeeInput) ToModel() (uuid.UUID, string, error) {
+	if err := r.validate(); err != nil {
+		return uuid.Nil, "", err
+	}
+
+	return r.EmployeeID, r.Pin, nil
+}
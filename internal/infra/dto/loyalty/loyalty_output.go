@@ -0,0 +1,20 @@
+package loyaltydto
+
+import (
+	"github.com/google/uuid"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+)
+
+type LoyaltyOutput struct {
+	ClientID     uuid.UUID                          `json:"client_id"`
+	Balance      int                                `json:"balance"`
+	BalanceValue float64                            `json:"balance_value"`
+	Transactions []loyaltyentity.LoyaltyTransaction `json:"transactions"`
+}
+
+func (o *LoyaltyOutput) FromModel(ledger *loyaltyentity.Ledger, program *loyaltyentity.LoyaltyProgram) {
+	o.ClientID = ledger.ClientID
+	o.Balance = ledger.Balance()
+	o.BalanceValue = program.PointsToDiscount(o.Balance)
+	o.Transactions = ledger.Transactions
+}
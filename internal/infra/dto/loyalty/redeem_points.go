@@ -0,0 +1,37 @@
+package loyaltydto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOrderIDRequired = errors.New("order id is required")
+	ErrPointsRequired  = errors.New("points are required")
+)
+
+type RedeemPointsInput struct {
+	OrderID uuid.UUID `json:"order_id"`
+	Points  int       `json:"points"`
+}
+
+func (r *RedeemPointsInput) validate() error {
+	if r.OrderID == uuid.Nil {
+		return ErrOrderIDRequired
+	}
+
+	if r.Points <= 0 {
+		return ErrPointsRequired
+	}
+
+	return nil
+}
+
+func (r *RedeemPointsInput) ToModel() (orderID uuid.UUID, points int, err error) {
+	if err := r.validate(); err != nil {
+		return uuid.Nil, 0, err
+	}
+
+	return r.OrderID, r.Points, nil
+}
@@ -0,0 +1,32 @@
+package loyaltydto
+
+import (
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+)
+
+type UpdateLoyaltyProgramInput struct {
+	loyaltyentity.PatchLoyaltyProgram
+}
+
+func (u *UpdateLoyaltyProgramInput) UpdateModel(model *loyaltyentity.LoyaltyProgram) error {
+	if u.PointsPerCurrency != nil {
+		model.PointsPerCurrency = *u.PointsPerCurrency
+	}
+	if u.CategoryMultipliers != nil {
+		model.CategoryMultipliers = u.CategoryMultipliers
+	}
+	if u.PointValue != nil {
+		model.PointValue = *u.PointValue
+	}
+	if u.MinRedeemPoints != nil {
+		model.MinRedeemPoints = *u.MinRedeemPoints
+	}
+	if u.ExpirationDays != nil {
+		model.ExpirationDays = *u.ExpirationDays
+	}
+	if u.IsActive != nil {
+		model.IsActive = *u.IsActive
+	}
+
+	return model.Validate()
+}
@@ -0,0 +1,26 @@
+package organizationdto
+
+import (
+	"github.com/google/uuid"
+)
+
+type CatalogTemplateInput struct {
+	Name string `json:"name"`
+	OrganizationCompanyInput
+}
+
+func (c *CatalogTemplateInput) validate() error {
+	if c.Name == "" {
+		return ErrNameRequired
+	}
+
+	return c.OrganizationCompanyInput.validate()
+}
+
+func (c *CatalogTemplateInput) ToModel() (name string, sourceCompanyID uuid.UUID, err error) {
+	if err := c.validate(); err != nil {
+		return "", uuid.Nil, err
+	}
+
+	return c.Name, c.CompanyID, nil
+}
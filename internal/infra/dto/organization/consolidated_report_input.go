@@ -0,0 +1,36 @@
+package organizationdto
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrPeriodRequired = errors.New("start and end dates are required")
+	ErrEndBeforeStart = errors.New("end date must be after start date")
+)
+
+type ConsolidatedReportInput struct {
+	StartAt *time.Time `json:"start_at"`
+	EndAt   *time.Time `json:"end_at"`
+}
+
+func (c *ConsolidatedReportInput) validate() error {
+	if c.StartAt == nil || c.EndAt == nil {
+		return ErrPeriodRequired
+	}
+
+	if c.EndAt.Before(*c.StartAt) {
+		return ErrEndBeforeStart
+	}
+
+	return nil
+}
+
+func (c *ConsolidatedReportInput) ToModel() (startAt time.Time, endAt time.Time, err error) {
+	if err := c.validate(); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return *c.StartAt, *c.EndAt, nil
+}
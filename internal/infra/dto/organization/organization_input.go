@@ -0,0 +1,52 @@
+package organizationdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNameRequired      = errors.New("name is required")
+	ErrCompanyIDRequired = errors.New("company id is required")
+)
+
+type OrganizationInput struct {
+	Name string `json:"name"`
+}
+
+func (o *OrganizationInput) validate() error {
+	if o.Name == "" {
+		return ErrNameRequired
+	}
+
+	return nil
+}
+
+func (o *OrganizationInput) ToModel() (name string, err error) {
+	if err := o.validate(); err != nil {
+		return "", err
+	}
+
+	return o.Name, nil
+}
+
+type OrganizationCompanyInput struct {
+	CompanyID uuid.UUID `json:"company_id"`
+}
+
+func (o *OrganizationCompanyInput) validate() error {
+	if o.CompanyID == uuid.Nil {
+		return ErrCompanyIDRequired
+	}
+
+	return nil
+}
+
+func (o *OrganizationCompanyInput) ToModel() (companyID uuid.UUID, err error) {
+	if err := o.validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return o.CompanyID, nil
+}
@@ -0,0 +1,25 @@
+package placedto
+
+import (
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+)
+
+type CreatePlaceInput struct {
+	Name    string `json:"name"`
+	Rows    int    `json:"rows"`
+	Columns int    `json:"columns"`
+}
+
+func (c *CreatePlaceInput) ToModel() (*tableentity.Place, error) {
+	place := tableentity.NewPlace(tableentity.PlaceCommonAttributes{
+		Name:    c.Name,
+		Rows:    c.Rows,
+		Columns: c.Columns,
+	})
+
+	if err := place.Validate(); err != nil {
+		return nil, err
+	}
+
+	return place, nil
+}
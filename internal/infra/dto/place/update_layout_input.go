@@ -0,0 +1,51 @@
+package placedto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+)
+
+var (
+	ErrTableIDRequired = errors.New("table_id is required")
+)
+
+type UpdateLayoutInput struct {
+	Tables []PlaceTableInput `json:"tables"`
+}
+
+type PlaceTableInput struct {
+	TableID uuid.UUID              `json:"table_id"`
+	Row     int                    `json:"row"`
+	Column  int                    `json:"column"`
+	Shape   tableentity.TableShape `json:"shape"`
+}
+
+func (u *UpdateLayoutInput) validate() error {
+	for _, table := range u.Tables {
+		if table.TableID == uuid.Nil {
+			return ErrTableIDRequired
+		}
+	}
+
+	return nil
+}
+
+func (u *UpdateLayoutInput) UpdateModel(model *tableentity.Place) error {
+	if err := u.validate(); err != nil {
+		return err
+	}
+
+	tables := []tableentity.PlaceTable{}
+	for _, table := range u.Tables {
+		tables = append(tables, tableentity.PlaceTable{
+			TableRelation: tableentity.TableRelation{TableID: table.TableID},
+			Row:           table.Row,
+			Column:        table.Column,
+			Shape:         table.Shape,
+		})
+	}
+
+	return model.SetTables(tables)
+}
@@ -0,0 +1,23 @@
+package placedto
+
+import (
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+)
+
+type UpdatePlaceInput struct {
+	tableentity.PatchPlace
+}
+
+func (u *UpdatePlaceInput) UpdateModel(model *tableentity.Place) error {
+	if u.Name != nil {
+		model.Name = *u.Name
+	}
+	if u.Rows != nil {
+		model.Rows = *u.Rows
+	}
+	if u.Columns != nil {
+		model.Columns = *u.Columns
+	}
+
+	return model.Validate()
+}
@@ -0,0 +1,42 @@
+package reservationdto
+
+import (
+	"time"
+
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
+)
+
+type AvailabilityInput struct {
+	PartySize int        `json:"party_size"`
+	StartAt   *time.Time `json:"start_at"`
+	Duration  int        `json:"duration"`
+}
+
+func (a *AvailabilityInput) validate() error {
+	if a.PartySize <= 0 {
+		return reservationentity.ErrPartySizeInvalid
+	}
+
+	if a.StartAt == nil {
+		return reservationentity.ErrStartAtRequired
+	}
+
+	if a.Duration < 0 {
+		return reservationentity.ErrDurationInvalid
+	}
+
+	return nil
+}
+
+func (a *AvailabilityInput) ToModel() (partySize int, startAt time.Time, endAt time.Time, err error) {
+	if err := a.validate(); err != nil {
+		return 0, time.Time{}, time.Time{}, err
+	}
+
+	duration := a.Duration
+	if duration == 0 {
+		duration = reservationentity.DefaultDuration
+	}
+
+	return a.PartySize, *a.StartAt, a.StartAt.Add(time.Duration(duration) * time.Minute), nil
+}
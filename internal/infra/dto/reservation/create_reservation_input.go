@@ -0,0 +1,46 @@
+package reservationdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
+)
+
+type CreateReservationInput struct {
+	ClientID    *uuid.UUID  `json:"client_id"`
+	Name        string      `json:"name"`
+	Contact     string      `json:"contact"`
+	PartySize   int         `json:"party_size"`
+	StartAt     *time.Time  `json:"start_at"`
+	Duration    int         `json:"duration"`
+	TableIDs    []uuid.UUID `json:"table_ids"`
+	Observation string      `json:"observation"`
+}
+
+func (c *CreateReservationInput) validate() error {
+	if c.StartAt == nil {
+		return reservationentity.ErrStartAtRequired
+	}
+
+	return nil
+}
+
+func (c *CreateReservationInput) ToModel() (*reservationentity.Reservation, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	reservationCommonAttributes := reservationentity.ReservationCommonAttributes{
+		ClientID:    c.ClientID,
+		Name:        c.Name,
+		Contact:     c.Contact,
+		PartySize:   c.PartySize,
+		StartAt:     *c.StartAt,
+		Duration:    c.Duration,
+		TableIDs:    c.TableIDs,
+		Observation: c.Observation,
+	}
+
+	return reservationentity.NewReservation(reservationCommonAttributes), nil
+}
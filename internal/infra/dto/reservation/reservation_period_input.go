@@ -0,0 +1,36 @@
+package reservationdto
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrPeriodRequired = errors.New("start and end dates are required")
+	ErrEndBeforeStart = errors.New("end date must be after start date")
+)
+
+type ReservationPeriodInput struct {
+	StartAt *time.Time `json:"start_at"`
+	EndAt   *time.Time `json:"end_at"`
+}
+
+func (r *ReservationPeriodInput) validate() error {
+	if r.StartAt == nil || r.EndAt == nil {
+		return ErrPeriodRequired
+	}
+
+	if r.EndAt.Before(*r.StartAt) {
+		return ErrEndBeforeStart
+	}
+
+	return nil
+}
+
+func (r *ReservationPeriodInput) ToModel() (startAt time.Time, endAt time.Time, err error) {
+	if err := r.validate(); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return *r.StartAt, *r.EndAt, nil
+}
@@ -0,0 +1,31 @@
+package reservationdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWaiterIDRequired = errors.New("waiter_id is required")
+)
+
+type SeatReservationInput struct {
+	WaiterID uuid.UUID `json:"waiter_id"`
+}
+
+func (s *SeatReservationInput) validate() error {
+	if s.WaiterID == uuid.Nil {
+		return ErrWaiterIDRequired
+	}
+
+	return nil
+}
+
+func (s *SeatReservationInput) ToModel() (uuid.UUID, error) {
+	if err := s.validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return s.WaiterID, nil
+}
@@ -0,0 +1,39 @@
+package reservationdto
+
+import (
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
+)
+
+type UpdateReservationInput struct {
+	reservationentity.PatchReservation
+}
+
+func (u *UpdateReservationInput) UpdateModel(model *reservationentity.Reservation) error {
+	if model.Status != reservationentity.ReservationStatusBooked {
+		return reservationentity.ErrReservationMustBeBooked
+	}
+
+	if u.Name != nil {
+		model.Name = *u.Name
+	}
+	if u.Contact != nil {
+		model.Contact = *u.Contact
+	}
+	if u.PartySize != nil {
+		model.PartySize = *u.PartySize
+	}
+	if u.StartAt != nil {
+		model.StartAt = *u.StartAt
+	}
+	if u.Duration != nil {
+		model.Duration = *u.Duration
+	}
+	if u.TableIDs != nil {
+		model.TableIDs = u.TableIDs
+	}
+	if u.Observation != nil {
+		model.Observation = *u.Observation
+	}
+
+	return model.Validate()
+}
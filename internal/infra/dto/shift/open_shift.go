@@ -9,7 +9,6 @@ import (
 
 var (
 	ErrAttendantIDRequired         = errors.New("attendant id is required")
-	ErrDayIsRequired               = errors.New("day is required")
 	ErrStartChangeRequired         = errors.New("start change is required")
 	ErrEndChangeNotUsedToOpenShift = errors.New("end change is not used to open shift")
 )
@@ -23,10 +22,6 @@ func (o *OpenShift) validate() (err error) {
 		return ErrAttendantIDRequired
 	}
 
-	if o.Day == nil {
-		return ErrDayIsRequired
-	}
-
 	if o.StartChange == 0 {
 		return ErrStartChangeRequired
 	}
@@ -20,6 +20,10 @@ func (o *CreateTableInput) validate() error {
 		return ErrNameRequired
 	}
 
+	if o.Capacity < 0 {
+		return tableentity.ErrCapacityInvalid
+	}
+
 	return nil
 }
 
@@ -31,6 +35,11 @@ func (o *CreateTableInput) ToModel() (*tableentity.Table, error) {
 	tableCommonAttributes := tableentity.TableCommonAttributes{
 		Name:        o.Name,
 		IsAvailable: true,
+		Capacity:    o.Capacity,
+	}
+
+	if tableCommonAttributes.Capacity == 0 {
+		tableCommonAttributes.Capacity = tableentity.DefaultTableCapacity
 	}
 
 	table := &tableentity.Table{
@@ -0,0 +1,36 @@
+package tabledto
+
+import (
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+)
+
+type UpdateTableInput struct {
+	tableentity.PatchTable
+}
+
+func (u *UpdateTableInput) validate() error {
+	if u.Name != nil && *u.Name == "" {
+		return ErrNameRequired
+	}
+
+	if u.Capacity != nil && *u.Capacity <= 0 {
+		return tableentity.ErrCapacityInvalid
+	}
+
+	return nil
+}
+
+func (u *UpdateTableInput) UpdateModel(model *tableentity.Table) error {
+	if err := u.validate(); err != nil {
+		return err
+	}
+
+	if u.Name != nil {
+		model.Name = *u.Name
+	}
+	if u.Capacity != nil {
+		model.Capacity = *u.Capacity
+	}
+
+	return nil
+}
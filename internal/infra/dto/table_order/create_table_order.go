@@ -15,8 +15,9 @@ var (
 )
 
 type CreateTableOrderInput struct {
-	WaiterID uuid.UUID `json:"waiter_id"`
-	TableID  uuid.UUID `json:"table_id"`
+	WaiterID uuid.UUID  `json:"waiter_id"`
+	TableID  uuid.UUID  `json:"table_id"`
+	ClientID *uuid.UUID `json:"client_id"`
 }
 
 func (o *CreateTableOrderInput) validate() error {
@@ -39,6 +40,7 @@ func (o *CreateTableOrderInput) ToModel() (*orderentity.TableOrder, error) {
 	tableCommonAttributes := orderentity.TableOrderCommonAttributes{
 		WaiterID: o.WaiterID,
 		TableID:  o.TableID,
+		ClientID: o.ClientID,
 	}
 
 	table := &orderentity.TableOrder{
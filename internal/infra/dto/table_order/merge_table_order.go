@@ -0,0 +1,31 @@
+package tableorderdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTargetTableOrderIDRequired = errors.New("target_table_order_id is required")
+)
+
+type MergeTableOrderInput struct {
+	TargetTableOrderID uuid.UUID `json:"target_table_order_id"`
+}
+
+func (m *MergeTableOrderInput) validate() error {
+	if m.TargetTableOrderID == uuid.Nil {
+		return ErrTargetTableOrderIDRequired
+	}
+
+	return nil
+}
+
+func (m *MergeTableOrderInput) ToModel() (uuid.UUID, error) {
+	if err := m.validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return m.TargetTableOrderID, nil
+}
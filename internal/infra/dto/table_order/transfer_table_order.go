@@ -0,0 +1,37 @@
+package tableorderdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrGroupsOrItemsRequired = errors.New("group_ids or item_ids are required")
+)
+
+type TransferTableOrderInput struct {
+	TargetTableOrderID uuid.UUID   `json:"target_table_order_id"`
+	GroupIDs           []uuid.UUID `json:"group_ids"`
+	ItemIDs            []uuid.UUID `json:"item_ids"`
+}
+
+func (t *TransferTableOrderInput) validate() error {
+	if t.TargetTableOrderID == uuid.Nil {
+		return ErrTargetTableOrderIDRequired
+	}
+
+	if len(t.GroupIDs) == 0 && len(t.ItemIDs) == 0 {
+		return ErrGroupsOrItemsRequired
+	}
+
+	return nil
+}
+
+func (t *TransferTableOrderInput) ToModel() (targetTableOrderID uuid.UUID, groupIDs []uuid.UUID, itemIDs []uuid.UUID, err error) {
+	if err := t.validate(); err != nil {
+		return uuid.Nil, nil, nil, err
+	}
+
+	return t.TargetTableOrderID, t.GroupIDs, t.ItemIDs, nil
+}
@@ -0,0 +1,74 @@
+package timeclockdto
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	timeclockentity "github.com/willjrcom/sales-backend-go/internal/domain/time_clock"
+)
+
+var attendanceCSVHeader = []string{
+	"employee", "date", "weekday", "first_in", "last_out", "worked", "break", "expected", "overtime", "missing", "incomplete",
+}
+
+// WriteAttendanceCSV writes the monthly attendance for payroll, durations are in HH:MM.
+func WriteAttendanceCSV(w io.Writer, employeeName string, attendance *timeclockentity.MonthlyAttendance) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(attendanceCSVHeader); err != nil {
+		return err
+	}
+
+	for _, day := range attendance.Days {
+		firstIn, lastOut := "", ""
+		for _, entry := range day.Entries {
+			if entry.Type == timeclockentity.PunchTypeIn && firstIn == "" {
+				firstIn = entry.At.In(day.Day.Location()).Format("15:04")
+			}
+			if entry.Type == timeclockentity.PunchTypeOut {
+				lastOut = entry.At.In(day.Day.Location()).Format("15:04")
+			}
+		}
+
+		if err := writer.Write([]string{
+			employeeName,
+			day.Day.Format("2006-01-02"),
+			day.Day.Weekday().String(),
+			firstIn,
+			lastOut,
+			formatMinutes(day.WorkedMinutes),
+			formatMinutes(day.BreakMinutes),
+			formatMinutes(day.ExpectedMinutes),
+			formatMinutes(day.OvertimeMinutes),
+			formatMinutes(day.MissingMinutes),
+			strconv.FormatBool(day.Incomplete),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.Write([]string{
+		employeeName,
+		"total",
+		"",
+		"",
+		"",
+		formatMinutes(attendance.WorkedMinutes),
+		formatMinutes(attendance.BreakMinutes),
+		formatMinutes(attendance.ExpectedMinutes),
+		formatMinutes(attendance.OvertimeMinutes),
+		formatMinutes(attendance.MissingMinutes),
+		"",
+	}); err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatMinutes(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,100 @@
+package timeclockdto
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	timeclockentity "github.com/willjrcom/sales-backend-go/internal/domain/time_clock"
+)
+
+var (
+	ErrEmployeeIDRequired = errors.New("employee id is required")
+	ErrAtRequired         = errors.New("at is required")
+)
+
+type CreateEntryInput struct {
+	EmployeeID uuid.UUID                 `json:"employee_id"`
+	Type       timeclockentity.PunchType `json:"type"`
+	At         *time.Time                `json:"at"`
+	Reason     string                    `json:"reason"`
+}
+
+func (c *CreateEntryInput) validate() error {
+	if c.EmployeeID == uuid.Nil {
+		return ErrEmployeeIDRequired
+	}
+
+	if c.At == nil {
+		return ErrAtRequired
+	}
+
+	if c.Reason == "" {
+		return timeclockentity.ErrCorrectionReason
+	}
+
+	return nil
+}
+
+func (c *CreateEntryInput) ToModel() (*timeclockentity.TimeClockEntry, string, error) {
+	if err := c.validate(); err != nil {
+		return nil, "", err
+	}
+
+	entry, err := timeclockentity.NewTimeClockEntry(c.EmployeeID, c.Type, *c.At, timeclockentity.PunchSourceManager, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return entry, c.Reason, nil
+}
+
+type UpdateEntryInput struct {
+	Type   *timeclockentity.PunchType `json:"type"`
+	At     *time.Time                 `json:"at"`
+	Reason string                     `json:"reason"`
+}
+
+func (u *UpdateEntryInput) validate() error {
+	if u.Reason == "" {
+		return timeclockentity.ErrCorrectionReason
+	}
+
+	return nil
+}
+
+func (u *UpdateEntryInput) ToModel(model *timeclockentity.TimeClockEntry) (punchType timeclockentity.PunchType, at time.Time, reason string, err error) {
+	if err := u.validate(); err != nil {
+		return "", time.Time{}, "", err
+	}
+
+	punchType, at = model.Type, model.At
+	if u.Type != nil {
+		punchType = *u.Type
+	}
+	if u.At != nil {
+		at = *u.At
+	}
+
+	return punchType, at, u.Reason, nil
+}
+
+type VoidEntryInput struct {
+	Reason string `json:"reason"`
+}
+
+func (v *VoidEntryInput) validate() error {
+	if v.Reason == "" {
+		return timeclockentity.ErrCorrectionReason
+	}
+
+	return nil
+}
+
+func (v *VoidEntryInput) ToModel() (string, error) {
+	if err := v.validate(); err != nil {
+		return "", err
+	}
+
+	return v.Reason, nil
+}
@@ -0,0 +1,61 @@
+package timeclockdto
+
+import (
+	"errors"
+	"time"
+
+	timeclockentity "github.com/willjrcom/sales-backend-go/internal/domain/time_clock"
+)
+
+var (
+	ErrPeriodRequired = errors.New("start and end dates are required")
+	ErrEndBeforeStart = errors.New("end date must be after start date")
+	ErrPeriodTooLong  = errors.New("period must be up to 62 days")
+	ErrMonthInvalid   = errors.New("month must be in the format YYYY-MM")
+)
+
+const maxTimesheetDays = 62
+
+type TimesheetInput struct {
+	StartAt *time.Time `json:"start_at"`
+	EndAt   *time.Time `json:"end_at"`
+}
+
+func (t *TimesheetInput) validate() error {
+	if t.StartAt == nil || t.EndAt == nil {
+		return ErrPeriodRequired
+	}
+
+	if t.EndAt.Before(*t.StartAt) {
+		return ErrEndBeforeStart
+	}
+
+	if t.EndAt.Sub(*t.StartAt) > maxTimesheetDays*24*time.Hour {
+		return ErrPeriodTooLong
+	}
+
+	return nil
+}
+
+func (t *TimesheetInput) ToModel() (startAt time.Time, endAt time.Time, err error) {
+	if err := t.validate(); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return *t.StartAt, *t.EndAt, nil
+}
+
+type AttendanceInput struct {
+	Month string `json:"month"`
+}
+
+// ToModel returns the period of the month in the timezone of the company.
+func (a *AttendanceInput) ToModel(loc *time.Location) (startAt time.Time, endAt time.Time, err error) {
+	month, err := time.ParseInLocation("2006-01", a.Month, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, ErrMonthInvalid
+	}
+
+	startAt, endAt = timeclockentity.MonthPeriod(month)
+	return startAt, endAt, nil
+}
@@ -0,0 +1,44 @@
+package timeclockdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	timeclockentity "github.com/willjrcom/sales-backend-go/internal/domain/time_clock"
+)
+
+var (
+	ErrGeofenceInvalid = errors.New("geofence latitude or longitude invalid")
+)
+
+type PunchInput struct {
+	Type       timeclockentity.PunchType `json:"type"`
+	EmployeeID *uuid.UUID                `json:"employee_id"`
+	Pin        string                    `json:"pin"`
+	Geofence   *timeclockentity.Geofence `json:"geofence"`
+}
+
+func (p *PunchInput) validate() error {
+	if !timeclockentity.IsValidPunchType(p.Type) {
+		return timeclockentity.ErrPunchTypeInvalid
+	}
+
+	if p.EmployeeID != nil && !employeeentity.IsValidPin(p.Pin) {
+		return employeeentity.ErrPinInvalid
+	}
+
+	if p.Geofence != nil && (p.Geofence.Latitude < -90 || p.Geofence.Latitude > 90 || p.Geofence.Longitude < -180 || p.Geofence.Longitude > 180) {
+		return ErrGeofenceInvalid
+	}
+
+	return nil
+}
+
+func (p *PunchInput) ToModel() (punchType timeclockentity.PunchType, employeeID *uuid.UUID, pin string, geofence *timeclockentity.Geofence, err error) {
+	if err := p.validate(); err != nil {
+		return "", nil, "", nil, err
+	}
+
+	return p.Type, p.EmployeeID, p.Pin, p.Geofence, nil
+}
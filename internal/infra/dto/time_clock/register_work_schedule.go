@@ -0,0 +1,25 @@
+package timeclockdto
+
+import (
+	timeclockentity "github.com/willjrcom/sales-backend-go/internal/domain/time_clock"
+)
+
+type RegisterWorkScheduleInput struct {
+	timeclockentity.WorkScheduleCommonAttributes
+}
+
+func (r *RegisterWorkScheduleInput) validate() error {
+	if len(r.DailyMinutes) == 0 {
+		return timeclockentity.ErrScheduleDaysInvalid
+	}
+
+	return nil
+}
+
+func (r *RegisterWorkScheduleInput) ToModel() (*timeclockentity.WorkSchedule, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	return timeclockentity.NewWorkSchedule(r.WorkScheduleCommonAttributes)
+}
@@ -0,0 +1,32 @@
+package timeclockdto
+
+import (
+	timeclockentity "github.com/willjrcom/sales-backend-go/internal/domain/time_clock"
+)
+
+type UpdateWorkScheduleInput struct {
+	timeclockentity.PatchWorkSchedule
+}
+
+func (u *UpdateWorkScheduleInput) validate() error {
+	if u.ToleranceMinutes != nil && *u.ToleranceMinutes < 0 {
+		return timeclockentity.ErrToleranceMinutesInvalid
+	}
+
+	return nil
+}
+
+func (u *UpdateWorkScheduleInput) UpdateModel(model *timeclockentity.WorkSchedule) error {
+	if err := u.validate(); err != nil {
+		return err
+	}
+
+	if u.DailyMinutes != nil {
+		model.DailyMinutes = u.DailyMinutes
+	}
+	if u.ToleranceMinutes != nil {
+		model.ToleranceMinutes = *u.ToleranceMinutes
+	}
+
+	return model.Validate()
+}
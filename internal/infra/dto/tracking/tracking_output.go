@@ -0,0 +1,44 @@
+package trackingdto
+
+import (
+	"strings"
+	"time"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	trackingentity "github.com/willjrcom/sales-backend-go/internal/domain/tracking"
+)
+
+// TrackingOutput is the view of the order shown to the customer, without personal data.
+type TrackingOutput struct {
+	OrderNumber     int                             `json:"order_number"`
+	Stage           trackingentity.Stage            `json:"stage"`
+	DeliveryStatus  orderentity.StatusDeliveryOrder `json:"delivery_status"`
+	LaunchedAt      *time.Time                      `json:"launched_at,omitempty"`
+	DeliveredAt     *time.Time                      `json:"delivered_at,omitempty"`
+	DriverFirstName string                          `json:"driver_first_name,omitempty"`
+	EtaMinutes      int                             `json:"eta_minutes,omitempty"`
+	EstimatedAt     *time.Time                      `json:"estimated_at,omitempty"`
+}
+
+func (t *TrackingOutput) FromModel(order *orderentity.Order, delivery *orderentity.DeliveryOrder) {
+	t.OrderNumber = order.OrderNumber
+	t.Stage = trackingentity.GetStage(order, delivery)
+	t.DeliveryStatus = delivery.Status
+	t.LaunchedAt = delivery.LaunchedAt
+	t.DeliveredAt = delivery.DeliveredAt
+
+	if delivery.Driver != nil {
+		if names := strings.Fields(delivery.Driver.Name); len(names) > 0 {
+			t.DriverFirstName = names[0]
+		}
+	}
+
+	if delivery.DeliveryZone != nil {
+		t.EtaMinutes = delivery.DeliveryZone.EtaMinutes
+	}
+
+	if t.EtaMinutes > 0 && delivery.LaunchedAt != nil && delivery.DeliveredAt == nil {
+		t.EstimatedAt = &time.Time{}
+		*t.EstimatedAt = delivery.LaunchedAt.Add(time.Duration(t.EtaMinutes) * time.Minute)
+	}
+}
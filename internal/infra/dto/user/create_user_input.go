@@ -10,6 +10,7 @@ import (
 var (
 	ErrEmailInvalid             = errors.New("email is invalid")
 	ErrPasswordInvalid          = errors.New("password is invalid")
+	ErrPasswordRequired         = errors.New("password is required")
 	ErrMustHaveAtLeastOneSchema = errors.New("must have at least one schema")
 )
 
@@ -36,7 +37,13 @@ func (u *CreateUserInput) ToModel() (*companyentity.User, error) {
 	}
 
 	if u.GeneratePassword {
-		u.Password = "12345"
+		password, err := utils.GeneratePassword()
+		if err != nil {
+			return nil, err
+		}
+
+		u.Password = password
+		u.MustChangePassword = true
 	}
 
 	return companyentity.NewUser(u.UserCommonAttributes), nil
@@ -0,0 +1,25 @@
+package userdto
+
+import (
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/utils"
+)
+
+type ForgotPasswordInput struct {
+	Email string `json:"email"`
+}
+
+func (r *ForgotPasswordInput) validate() error {
+	if !utils.IsEmailValid(r.Email) {
+		return ErrEmailInvalid
+	}
+
+	return nil
+}
+
+func (r *ForgotPasswordInput) ToModel() (string, error) {
+	if err := r.validate(); err != nil {
+		return "", err
+	}
+
+	return r.Email, nil
+}
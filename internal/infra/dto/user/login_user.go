@@ -14,8 +14,9 @@ func (u *LoginUserInput) validate() error {
 		return ErrEmailInvalid
 	}
 
-	if !utils.IsValidPassword(u.Password) && u.Password != "12345" {
-		return ErrPasswordInvalid
+	// The complexity is checked only when the password is set, old passwords must still log in
+	if u.Password == "" {
+		return ErrPasswordRequired
 	}
 
 	return nil
@@ -0,0 +1,29 @@
+package userdto
+
+import (
+	"errors"
+)
+
+var (
+	ErrRefreshTokenRequired = errors.New("refresh token required")
+)
+
+type RefreshTokenInput struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+func (r *RefreshTokenInput) validate() error {
+	if r.RefreshToken == "" {
+		return ErrRefreshTokenRequired
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenInput) ToModel() (string, error) {
+	if err := r.validate(); err != nil {
+		return "", err
+	}
+
+	return r.RefreshToken, nil
+}
@@ -0,0 +1,36 @@
+package userdto
+
+import (
+	"errors"
+
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/utils"
+)
+
+var (
+	ErrTokenRequired = errors.New("token required")
+)
+
+type ResetPasswordInput struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+func (r *ResetPasswordInput) validate() error {
+	if r.Token == "" {
+		return ErrTokenRequired
+	}
+
+	if !utils.IsValidPassword(r.NewPassword) {
+		return ErrPasswordInvalid
+	}
+
+	return nil
+}
+
+func (r *ResetPasswordInput) ToModel() (token string, newPassword string, err error) {
+	if err := r.validate(); err != nil {
+		return "", "", err
+	}
+
+	return r.Token, r.NewPassword, nil
+}
@@ -3,6 +3,10 @@ package userdto
 import companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 
 type TokenAndSchemasOutput struct {
-	AccessToken string                           `json:"accessToken"`
-	Companies   []companyentity.CompanyWithUsers `json:"companies"`
+	AccessToken        string                           `json:"accessToken,omitempty"`
+	RefreshToken       string                           `json:"refreshToken,omitempty"`
+	MustChangePassword bool                             `json:"mustChangePassword"`
+	TwoFactorRequired  bool                             `json:"twoFactorRequired"`
+	TwoFactorToken     string                           `json:"twoFactorToken,omitempty"`
+	Companies          []companyentity.CompanyWithUsers `json:"companies,omitempty"`
 }
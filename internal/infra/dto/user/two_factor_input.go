@@ -0,0 +1,52 @@
+package userdto
+
+import (
+	"errors"
+)
+
+var (
+	ErrTwoFactorTokenRequired = errors.New("two factor token required")
+	ErrCodeRequired           = errors.New("code or recovery code required")
+)
+
+type TwoFactorCodeInput struct {
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+func (r *TwoFactorCodeInput) validate() error {
+	if r.Code == "" && r.RecoveryCode == "" {
+		return ErrCodeRequired
+	}
+
+	return nil
+}
+
+func (r *TwoFactorCodeInput) ToModel() (code string, recoveryCode string, err error) {
+	if err := r.validate(); err != nil {
+		return "", "", err
+	}
+
+	return r.Code, r.RecoveryCode, nil
+}
+
+type TwoFactorLoginInput struct {
+	TwoFactorToken string `json:"two_factor_token"`
+	TwoFactorCodeInput
+}
+
+func (r *TwoFactorLoginInput) validate() error {
+	if r.TwoFactorToken == "" {
+		return ErrTwoFactorTokenRequired
+	}
+
+	return r.TwoFactorCodeInput.validate()
+}
+
+func (r *TwoFactorLoginInput) ToModel() (token string, code string, recoveryCode string, err error) {
+	if err := r.validate(); err != nil {
+		return "", "", "", err
+	}
+
+	return r.TwoFactorToken, r.Code, r.RecoveryCode, nil
+}
@@ -0,0 +1,11 @@
+package userdto
+
+type TwoFactorEnrollmentOutput struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+type TwoFactorRecoveryCodesOutput struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+	AccessToken   string   `json:"accessToken,omitempty"`
+}
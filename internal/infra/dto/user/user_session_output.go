@@ -0,0 +1,26 @@
+package userdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+)
+
+type UserSessionOutput struct {
+	ID         uuid.UUID  `json:"id"`
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Current    bool       `json:"current"`
+}
+
+func (o *UserSessionOutput) FromModel(model *companyentity.UserSession, currentSessionID uuid.UUID) {
+	o.ID = model.ID
+	o.UserAgent = model.UserAgent
+	o.IPAddress = model.IPAddress
+	o.CreatedAt = model.CreatedAt
+	o.LastUsedAt = model.LastUsedAt
+	o.Current = model.ID == currentSessionID
+}
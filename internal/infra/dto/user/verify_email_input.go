@@ -0,0 +1,21 @@
+package userdto
+
+type VerifyEmailInput struct {
+	Token string `json:"token"`
+}
+
+func (r *VerifyEmailInput) validate() error {
+	if r.Token == "" {
+		return ErrTokenRequired
+	}
+
+	return nil
+}
+
+func (r *VerifyEmailInput) ToModel() (string, error) {
+	if err := r.validate(); err != nil {
+		return "", err
+	}
+
+	return r.Token, nil
+}
@@ -0,0 +1,9 @@
+package waitercommissiondto
+
+type CloseWaiterCommissionInput struct {
+	Observation string `json:"observation"`
+}
+
+func (c *CloseWaiterCommissionInput) ToModel() (observation string) {
+	return c.Observation
+}
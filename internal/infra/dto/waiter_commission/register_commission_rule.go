@@ -0,0 +1,31 @@
+package waitercommissiondto
+
+import (
+	"errors"
+
+	waitercommissionentity "github.com/willjrcom/sales-backend-go/internal/domain/waiter_commission"
+)
+
+var (
+	ErrCommissionTypeRequired = errors.New("commission type is required")
+)
+
+type RegisterCommissionRuleInput struct {
+	waitercommissionentity.CommissionRuleCommonAttributes
+}
+
+func (r *RegisterCommissionRuleInput) validate() error {
+	if r.Type == "" {
+		return ErrCommissionTypeRequired
+	}
+
+	return nil
+}
+
+func (r *RegisterCommissionRuleInput) ToModel() (*waitercommissionentity.CommissionRule, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	return waitercommissionentity.NewCommissionRule(r.CommissionRuleCommonAttributes)
+}
@@ -0,0 +1,38 @@
+package waitercommissiondto
+
+import (
+	waitercommissionentity "github.com/willjrcom/sales-backend-go/internal/domain/waiter_commission"
+)
+
+type UpdateCommissionRuleInput struct {
+	waitercommissionentity.PatchCommissionRule
+}
+
+func (u *UpdateCommissionRuleInput) validate() error {
+	if u.Type != nil && *u.Type == "" {
+		return ErrCommissionTypeRequired
+	}
+
+	return nil
+}
+
+func (u *UpdateCommissionRuleInput) UpdateModel(model *waitercommissionentity.CommissionRule) error {
+	if err := u.validate(); err != nil {
+		return err
+	}
+
+	if u.Type != nil {
+		model.Type = *u.Type
+	}
+	if u.Percentage != nil {
+		model.Percentage = *u.Percentage
+	}
+	if u.ServiceChargeRate != nil {
+		model.ServiceChargeRate = *u.ServiceChargeRate
+	}
+	if u.CategoryPercentage != nil {
+		model.CategoryPercentage = u.CategoryPercentage
+	}
+
+	return model.Validate()
+}
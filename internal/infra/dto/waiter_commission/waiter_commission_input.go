@@ -0,0 +1,60 @@
+package waitercommissiondto
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWaiterIDRequired = errors.New("waiter id is required")
+	ErrPeriodRequired   = errors.New("start and end dates are required")
+	ErrEndBeforeStart   = errors.New("end date must be after start date")
+)
+
+type WaiterCommissionInput struct {
+	WaiterID uuid.UUID `json:"waiter_id"`
+	WaiterReportInput
+}
+
+func (w *WaiterCommissionInput) validate() error {
+	if w.WaiterID == uuid.Nil {
+		return ErrWaiterIDRequired
+	}
+
+	return w.WaiterReportInput.validate()
+}
+
+func (w *WaiterCommissionInput) ToModel() (waiterID uuid.UUID, startAt time.Time, endAt time.Time, err error) {
+	if err := w.validate(); err != nil {
+		return uuid.Nil, time.Time{}, time.Time{}, err
+	}
+
+	return w.WaiterID, *w.StartAt, *w.EndAt, nil
+}
+
+type WaiterReportInput struct {
+	StartAt *time.Time `json:"start_at"`
+	EndAt   *time.Time `json:"end_at"`
+}
+
+func (w *WaiterReportInput) validate() error {
+	if w.StartAt == nil || w.EndAt == nil {
+		return ErrPeriodRequired
+	}
+
+	if w.EndAt.Before(*w.StartAt) {
+		return ErrEndBeforeStart
+	}
+
+	return nil
+}
+
+func (w *WaiterReportInput) ToModel() (startAt time.Time, endAt time.Time, err error) {
+	if err := w.validate(); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return *w.StartAt, *w.EndAt, nil
+}
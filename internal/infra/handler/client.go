@@ -6,22 +6,28 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	clientanalyticsentity "github.com/willjrcom/sales-backend-go/internal/domain/client_analytics"
+	addressdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/address"
 	clientdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/client"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	keysdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/keys"
+	loyaltydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/loyalty"
 	clientusecases "github.com/willjrcom/sales-backend-go/internal/usecases/client"
+	loyaltyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/loyalty"
 	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
 )
 
 type handlerClientImpl struct {
-	s *clientusecases.Service
+	s  *clientusecases.Service
+	sl *loyaltyusecases.Service
 }
 
-func NewHandlerClient(clientService *clientusecases.Service) *handler.Handler {
+func NewHandlerClient(clientService *clientusecases.Service, loyaltyService *loyaltyusecases.Service) *handler.Handler {
 	c := chi.NewRouter()
 
 	h := &handlerClientImpl{
-		s: clientService,
+		s:  clientService,
+		sl: loyaltyService,
 	}
 
 	route := "/client"
@@ -33,6 +39,23 @@ func NewHandlerClient(clientService *clientusecases.Service) *handler.Handler {
 		c.Get("/{id}", h.handlerGetClientById)
 		c.Post("/by-contact", h.handlerGetClientByContact)
 		c.Get("/all", h.handlerGetAllClients)
+		c.Get("/{id}/loyalty", h.handlerGetClientLoyalty)
+		c.Post("/{id}/loyalty/redeem", h.handlerRedeemLoyaltyPoints)
+		c.Get("/{id}/history", h.handlerGetClientHistory)
+		c.Post("/{id}/address/new", h.handlerRegisterClientAddress)
+		c.Patch("/{id}/address/update/{address_id}", h.handlerUpdateClientAddress)
+		c.Post("/{id}/address/default/{address_id}", h.handlerSetDefaultClientAddress)
+		c.Delete("/{id}/address/delete/{address_id}", h.handlerDeleteClientAddress)
+		c.Get("/{id}/address/all", h.handlerGetClientAddresses)
+		c.Get("/rfm", h.handlerGetClientsRFM)
+		c.Get("/rfm/{segment}", h.handlerGetClientsRFM)
+		c.Get("/segments", h.handlerGetAllSegments)
+		c.Get("/duplicates", h.handlerFindDuplicateClients)
+		c.Post("/merge", h.handlerMergeClients)
+		c.Get("/merge/all", h.handlerGetAllClientMerges)
+		c.Get("/{id}/privacy/export", h.handlerExportClientData)
+		c.Post("/{id}/privacy/erase", h.handlerEraseClientData)
+		c.Get("/privacy/requests", h.handlerGetAllPrivacyRequests)
 	})
 
 	unprotectedRoutes := []string{}
@@ -135,3 +158,271 @@ func (h *handlerClientImpl) handlerGetAllClients(w http.ResponseWriter, r *http.
 		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: categories})
 	}
 }
+
+func (h *handlerClientImpl) handlerGetClientLoyalty(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if loyalty, err := h.sl.GetClientLoyalty(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: loyalty})
+	}
+}
+
+func (h *handlerClientImpl) handlerRedeemLoyaltyPoints(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	redeem := &loyaltydto.RedeemPointsInput{}
+	jsonpkg.ParseBody(r, redeem)
+
+	if discount, err := h.sl.RedeemPoints(ctx, dtoId, redeem); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: discount})
+	}
+}
+
+func (h *handlerClientImpl) handlerGetClientHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if history, err := h.s.GetClientHistory(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: history})
+	}
+}
+
+func (h *handlerClientImpl) handlerGetClientsRFM(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	segment := clientanalyticsentity.Segment(chi.URLParam(r, "segment"))
+
+	if clients, err := h.s.GetClientsRFM(ctx, segment); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: clients})
+	}
+}
+
+func (h *handlerClientImpl) handlerGetAllSegments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: h.s.GetAllSegments(ctx)})
+}
+
+func (h *handlerClientImpl) handlerRegisterClientAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	address := &addressdto.RegisterAddressInput{}
+	jsonpkg.ParseBody(r, address)
+
+	if addressID, err := h.s.RegisterClientAddress(ctx, dtoId, address); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: addressID})
+	}
+}
+
+func (h *handlerClientImpl) handlerUpdateClientAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+	addressID := chi.URLParam(r, "address_id")
+
+	if id == "" || addressID == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id and address_id are required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+	dtoAddressId := &entitydto.IdRequest{ID: uuid.MustParse(addressID)}
+
+	address := &addressdto.UpdateAddressInput{}
+	jsonpkg.ParseBody(r, address)
+
+	if err := h.s.UpdateClientAddress(ctx, dtoId, dtoAddressId, address); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerClientImpl) handlerSetDefaultClientAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+	addressID := chi.URLParam(r, "address_id")
+
+	if id == "" || addressID == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id and address_id are required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+	dtoAddressId := &entitydto.IdRequest{ID: uuid.MustParse(addressID)}
+
+	if err := h.s.SetDefaultClientAddress(ctx, dtoId, dtoAddressId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerClientImpl) handlerDeleteClientAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+	addressID := chi.URLParam(r, "address_id")
+
+	if id == "" || addressID == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id and address_id are required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+	dtoAddressId := &entitydto.IdRequest{ID: uuid.MustParse(addressID)}
+
+	if err := h.s.DeleteClientAddress(ctx, dtoId, dtoAddressId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerClientImpl) handlerGetClientAddresses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if addresses, err := h.s.GetClientAddresses(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: addresses})
+	}
+}
+
+func (h *handlerClientImpl) handlerFindDuplicateClients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if candidates, err := h.s.FindDuplicateClients(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: candidates})
+	}
+}
+
+func (h *handlerClientImpl) handlerMergeClients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	merge := &clientdto.MergeClientsInput{}
+	jsonpkg.ParseBody(r, merge)
+
+	if record, err := h.s.MergeClients(ctx, merge); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: record})
+	}
+}
+
+func (h *handlerClientImpl) handlerGetAllClientMerges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if merges, err := h.s.GetAllClientMerges(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: merges})
+	}
+}
+
+func (h *handlerClientImpl) handlerExportClientData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if export, err := h.s.ExportClientData(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: export})
+	}
+}
+
+func (h *handlerClientImpl) handlerEraseClientData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	erase := &clientdto.EraseClientInput{}
+	jsonpkg.ParseBody(r, erase)
+
+	if err := h.s.EraseClientData(ctx, dtoId, erase); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerClientImpl) handlerGetAllPrivacyRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if requests, err := h.s.GetAllPrivacyRequests(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: requests})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	addressdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/address"
 	companydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/company"
 	companyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/company"
 	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
@@ -27,6 +28,10 @@ func NewHandlerCompany(companyService *companyusecases.Service) *handler.Handler
 	c.With().Group(func(c chi.Router) {
 		c.Post("/new", h.handlerNewCompany)
 		c.Get("/", h.handlerGetCompany)
+		c.Put("/update/address", h.handlerUpdateCompanyAddress)
+		c.Put("/update/two-factor-policy", h.handlerUpdateTwoFactorPolicy)
+		c.Get("/settings", h.handlerGetCompanySettings)
+		c.Put("/settings", h.handlerUpdateCompanySettings)
 		c.Post("/add/user", h.handlerAddUserToCompany)
 		c.Delete("/remove/user", h.handlerRemoveUserFromCompany)
 	})
@@ -65,6 +70,19 @@ func (h *handlerCompanyImpl) handlerGetCompany(w http.ResponseWriter, r *http.Re
 	}
 }
 
+func (h *handlerCompanyImpl) handlerUpdateCompanyAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	address := &addressdto.UpdateAddressInput{}
+	jsonpkg.ParseBody(r, address)
+
+	if err := h.s.UpdateCompanyAddress(ctx, address); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
 func (h *handlerCompanyImpl) handlerAddUserToCompany(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -90,3 +108,39 @@ func (h *handlerCompanyImpl) handlerRemoveUserFromCompany(w http.ResponseWriter,
 		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
 	}
 }
+
+func (h *handlerCompanyImpl) handlerUpdateTwoFactorPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	policy := &companydto.TwoFactorPolicyInput{}
+	jsonpkg.ParseBody(r, policy)
+
+	if err := h.s.UpdateTwoFactorPolicy(ctx, policy); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerCompanyImpl) handlerGetCompanySettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if settings, err := h.s.GetCompanySettings(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: settings})
+	}
+}
+
+func (h *handlerCompanyImpl) handlerUpdateCompanySettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	settings := &companydto.CompanySettingsInput{}
+	jsonpkg.ParseBody(r, settings)
+
+	if err := h.s.UpdateCompanySettings(ctx, settings); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
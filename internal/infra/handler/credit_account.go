@@ -0,0 +1,178 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	creditaccountdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/credit_account"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	creditaccountusecases "github.com/willjrcom/sales-backend-go/internal/usecases/credit_account"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerCreditAccountImpl struct {
+	s *creditaccountusecases.Service
+}
+
+func NewHandlerCreditAccount(creditAccountService *creditaccountusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerCreditAccountImpl{
+		s: creditAccountService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreateCreditAccount)
+		c.Patch("/update/{id}", h.handlerUpdateCreditAccount)
+		c.Get("/{id}", h.handlerGetCreditAccountById)
+		c.Get("/by-client/{id}", h.handlerGetCreditAccountByClientId)
+		c.Post("/charge", h.handlerChargeOrder)
+		c.Post("/{id}/settle", h.handlerSettleCreditAccount)
+		c.Post("/{id}/statement", h.handlerGetStatement)
+		c.Get("/receivables", h.handlerGetReceivables)
+	})
+
+	return handler.NewHandler("/credit-account", c)
+}
+
+func (h *handlerCreditAccountImpl) handlerCreateCreditAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	account := &creditaccountdto.CreateCreditAccountInput{}
+	jsonpkg.ParseBody(r, account)
+
+	if output, err := h.s.CreateCreditAccount(ctx, account); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: output})
+	}
+}
+
+func (h *handlerCreditAccountImpl) handlerUpdateCreditAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	account := &creditaccountdto.UpdateCreditAccountInput{}
+	jsonpkg.ParseBody(r, account)
+
+	if err := h.s.UpdateCreditAccount(ctx, dtoId, account); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerCreditAccountImpl) handlerGetCreditAccountById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if account, err := h.s.GetCreditAccountById(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: account})
+	}
+}
+
+func (h *handlerCreditAccountImpl) handlerGetCreditAccountByClientId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if account, err := h.s.GetCreditAccountByClientId(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: account})
+	}
+}
+
+func (h *handlerCreditAccountImpl) handlerChargeOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	charge := &creditaccountdto.ChargeOrderInput{}
+	jsonpkg.ParseBody(r, charge)
+
+	if payment, err := h.s.ChargeOrder(ctx, charge); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: payment})
+	}
+}
+
+func (h *handlerCreditAccountImpl) handlerSettleCreditAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	settle := &creditaccountdto.SettleInput{}
+	jsonpkg.ParseBody(r, settle)
+
+	if account, err := h.s.Settle(ctx, dtoId, settle); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: account})
+	}
+}
+
+func (h *handlerCreditAccountImpl) handlerGetStatement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	period := &creditaccountdto.StatementInput{}
+	jsonpkg.ParseBody(r, period)
+
+	if statement, err := h.s.GetStatement(ctx, dtoId, period); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: statement})
+	}
+}
+
+func (h *handlerCreditAccountImpl) handlerGetReceivables(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if receivables, err := h.s.GetReceivables(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: receivables})
+	}
+}
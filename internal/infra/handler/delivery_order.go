@@ -25,8 +25,15 @@ func NewHandlerDeliveryOrder(orderService deliveryorderusecases.IService) *handl
 		c.Post("/new", h.handlerRegisterDeliveryOrder)
 		c.Get("/{id}", h.handlerGetDeliveryById)
 		c.Get("/all", h.handlerGetAllDeliveries)
+		c.Get("/by-client/{id}", h.handlerGetDeliveryOrderByClientId)
 		c.Post("/update/launch/{id}", h.handlerLaunchDeliveryOrder)
 		c.Post("/update/finish/{id}", h.handlerFinishDeliveryOrder)
+		c.Post("/update/fail/{id}", h.handlerFailDeliveryOrder)
+		c.Post("/update/return/{id}", h.handlerReturnDeliveryOrder)
+		c.Post("/update/retry/{id}", h.handlerRetryDeliveryOrder)
+		c.Post("/update/cancel/{id}", h.handlerCancelUndeliveredOrder)
+		c.Get("/failure-reasons", h.handlerGetAllDeliveryFailureReasons)
+		c.Get("/tracking-token/{id}", h.handlerGetTrackingToken)
 		c.Put("/update/driver/{id}", h.handlerUpdateDriver)
 		c.Put("/update/address/{id}", h.handlerUpdateDeliveryAddress)
 	})
@@ -74,6 +81,26 @@ func (h *handlerDeliveryOrderImpl) handlerGetAllDeliveries(w http.ResponseWriter
 		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: orders})
 	}
 }
+
+func (h *handlerDeliveryOrderImpl) handlerGetDeliveryOrderByClientId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if deliveries, err := h.IService.GetDeliveryOrderByClientId(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: deliveries})
+	}
+}
+
 func (h *handlerDeliveryOrderImpl) handlerLaunchDeliveryOrder(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -161,3 +188,111 @@ func (h *handlerDeliveryOrderImpl) handlerUpdateDriver(w http.ResponseWriter, r
 		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
 	}
 }
+
+func (h *handlerDeliveryOrderImpl) handlerFailDeliveryOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	delivery := &deliveryorderdto.FailDeliveryOrder{}
+	jsonpkg.ParseBody(r, delivery)
+
+	if err := h.IService.FailDeliveryOrder(ctx, dtoId, delivery); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerDeliveryOrderImpl) handlerReturnDeliveryOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.IService.ReturnDeliveryOrder(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerDeliveryOrderImpl) handlerRetryDeliveryOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	delivery := &deliveryorderdto.RetryDeliveryOrder{}
+	jsonpkg.ParseBody(r, delivery)
+
+	if err := h.IService.RetryDeliveryOrder(ctx, dtoId, delivery); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerDeliveryOrderImpl) handlerCancelUndeliveredOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.IService.CancelUndeliveredOrder(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerDeliveryOrderImpl) handlerGetAllDeliveryFailureReasons(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reasons := h.IService.GetAllDeliveryFailureReasons(ctx)
+	jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: reasons})
+}
+
+func (h *handlerDeliveryOrderImpl) handlerGetTrackingToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if token, err := h.IService.GetTrackingToken(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: token})
+	}
+}
@@ -0,0 +1,106 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	deliveryroutedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery_route"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	deliveryrouteusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_route"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerDeliveryRouteImpl struct {
+	s *deliveryrouteusecases.Service
+}
+
+func NewHandlerDeliveryRoute(deliveryRouteService *deliveryrouteusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerDeliveryRouteImpl{
+		s: deliveryRouteService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Get("/board", h.handlerGetDispatchBoard)
+		c.Post("/launch", h.handlerLaunchDeliveryRoute)
+		c.Post("/return/{id}", h.handlerReturnDeliveryRoute)
+		c.Get("/{id}", h.handlerGetDeliveryRouteById)
+		c.Get("/all", h.handlerGetAllDeliveryRoutes)
+	})
+
+	return handler.NewHandler("/delivery-route", c)
+}
+
+func (h *handlerDeliveryRouteImpl) handlerGetDispatchBoard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if board, err := h.s.GetDispatchBoard(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: board})
+	}
+}
+
+func (h *handlerDeliveryRouteImpl) handlerLaunchDeliveryRoute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	route := &deliveryroutedto.LaunchDeliveryRouteInput{}
+	jsonpkg.ParseBody(r, route)
+
+	if id, err := h.s.LaunchDeliveryRoute(ctx, route); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerDeliveryRouteImpl) handlerReturnDeliveryRoute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.ReturnDeliveryRoute(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerDeliveryRouteImpl) handlerGetDeliveryRouteById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if route, err := h.s.GetDeliveryRouteById(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: route})
+	}
+}
+
+func (h *handlerDeliveryRouteImpl) handlerGetAllDeliveryRoutes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if routes, err := h.s.GetAllDeliveryRoutes(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: routes})
+	}
+}
@@ -0,0 +1,137 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	deliveryzonedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery_zone"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	deliveryzoneusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_zone"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerDeliveryZoneImpl struct {
+	s *deliveryzoneusecases.Service
+}
+
+func NewHandlerDeliveryZone(deliveryZoneService *deliveryzoneusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerDeliveryZoneImpl{
+		s: deliveryZoneService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerRegisterDeliveryZone)
+		c.Patch("/update/{id}", h.handlerUpdateDeliveryZone)
+		c.Delete("/delete/{id}", h.handlerDeleteDeliveryZone)
+		c.Get("/{id}", h.handlerGetDeliveryZoneById)
+		c.Get("/all", h.handlerGetAllDeliveryZones)
+		c.Get("/by-address/{id}", h.handlerGetDeliveryZoneByAddressId)
+	})
+
+	return handler.NewHandler("/delivery-zone", c)
+}
+
+func (h *handlerDeliveryZoneImpl) handlerRegisterDeliveryZone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zone := &deliveryzonedto.RegisterDeliveryZoneInput{}
+	jsonpkg.ParseBody(r, zone)
+
+	if id, err := h.s.CreateDeliveryZone(ctx, zone); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerDeliveryZoneImpl) handlerUpdateDeliveryZone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	zone := &deliveryzonedto.UpdateDeliveryZoneInput{}
+	jsonpkg.ParseBody(r, zone)
+
+	if err := h.s.UpdateDeliveryZone(ctx, dtoId, zone); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerDeliveryZoneImpl) handlerDeleteDeliveryZone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeleteDeliveryZone(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerDeliveryZoneImpl) handlerGetDeliveryZoneById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if zone, err := h.s.GetDeliveryZoneById(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: zone})
+	}
+}
+
+func (h *handlerDeliveryZoneImpl) handlerGetAllDeliveryZones(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if zones, err := h.s.GetAllDeliveryZones(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: zones})
+	}
+}
+
+func (h *handlerDeliveryZoneImpl) handlerGetDeliveryZoneByAddressId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if zone, err := h.s.GetDeliveryZoneByAddressId(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: zone})
+	}
+}
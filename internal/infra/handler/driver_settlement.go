@@ -0,0 +1,200 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	driversettlementdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/driver_settlement"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	driversettlementusecases "github.com/willjrcom/sales-backend-go/internal/usecases/driver_settlement"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerDriverSettlementImpl struct {
+	s *driversettlementusecases.Service
+}
+
+func NewHandlerDriverSettlement(driverSettlementService *driversettlementusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerDriverSettlementImpl{
+		s: driverSettlementService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/rule/new", h.handlerCreatePayoutRule)
+		c.Patch("/rule/update/{id}", h.handlerUpdatePayoutRule)
+		c.Delete("/rule/delete/{id}", h.handlerDeletePayoutRule)
+		c.Get("/rule/all", h.handlerGetAllPayoutRules)
+		c.Post("/preview", h.handlerPreviewDriverSettlement)
+		c.Post("/new", h.handlerCreateDriverSettlement)
+		c.Post("/close/{id}", h.handlerCloseDriverSettlement)
+		c.Get("/{id}", h.handlerGetDriverSettlementById)
+		c.Get("/all", h.handlerGetAllDriverSettlements)
+		c.Get("/by-driver/{id}", h.handlerGetDriverSettlementsByDriverId)
+	})
+
+	return handler.NewHandler("/driver-settlement", c)
+}
+
+func (h *handlerDriverSettlementImpl) handlerCreatePayoutRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rule := &driversettlementdto.RegisterPayoutRuleInput{}
+	jsonpkg.ParseBody(r, rule)
+
+	if id, err := h.s.CreatePayoutRule(ctx, rule); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerDriverSettlementImpl) handlerUpdatePayoutRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	rule := &driversettlementdto.UpdatePayoutRuleInput{}
+	jsonpkg.ParseBody(r, rule)
+
+	if err := h.s.UpdatePayoutRule(ctx, dtoId, rule); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerDriverSettlementImpl) handlerDeletePayoutRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeletePayoutRule(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerDriverSettlementImpl) handlerGetAllPayoutRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if rules, err := h.s.GetAllPayoutRules(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: rules})
+	}
+}
+
+func (h *handlerDriverSettlementImpl) handlerPreviewDriverSettlement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	settlement := &driversettlementdto.DriverSettlementInput{}
+	jsonpkg.ParseBody(r, settlement)
+
+	if preview, err := h.s.PreviewDriverSettlement(ctx, settlement); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: preview})
+	}
+}
+
+func (h *handlerDriverSettlementImpl) handlerCreateDriverSettlement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	settlement := &driversettlementdto.DriverSettlementInput{}
+	jsonpkg.ParseBody(r, settlement)
+
+	if id, err := h.s.CreateDriverSettlement(ctx, settlement); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerDriverSettlementImpl) handlerCloseDriverSettlement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	closeSettlement := &driversettlementdto.CloseDriverSettlementInput{}
+	jsonpkg.ParseBody(r, closeSettlement)
+
+	if err := h.s.CloseDriverSettlement(ctx, dtoId, closeSettlement); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerDriverSettlementImpl) handlerGetDriverSettlementById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if settlement, err := h.s.GetDriverSettlementById(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: settlement})
+	}
+}
+
+func (h *handlerDriverSettlementImpl) handlerGetAllDriverSettlements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if settlements, err := h.s.GetAllDriverSettlements(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: settlements})
+	}
+}
+
+func (h *handlerDriverSettlementImpl) handlerGetDriverSettlementsByDriverId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if settlements, err := h.s.GetDriverSettlementsByDriverId(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: settlements})
+	}
+}
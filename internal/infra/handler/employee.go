@@ -1,6 +1,7 @@
 package handlerimpl
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -8,6 +9,7 @@ import (
 	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
 	employeedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/employee"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	ratelimitservice "github.com/willjrcom/sales-backend-go/internal/infra/service/ratelimit"
 	employeeusecases "github.com/willjrcom/sales-backend-go/internal/usecases/employee"
 	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
 )
@@ -29,6 +31,10 @@ func NewHandlerEmployee(employeeService *employeeusecases.Service) *handler.Hand
 		c.Delete("/delete/{id}", h.handlerDeleteEmployee)
 		c.Get("/{id}", h.handlerGetEmployee)
 		c.Get("/all", h.handlerGetAllEmployees)
+		c.Put("/update/pin/{id}", h.handlerSetPin)
+		c.Post("/terminal/bind", h.handlerBindTerminal)
+		c.Post("/terminal/switch", h.handlerSwitchEmployee)
+		c.Post("/terminal/release", h.handlerReleaseEmployee)
 	})
 
 	return handler.NewHandler("/employee", c)
@@ -115,3 +121,65 @@ func (h *handlerEmployeeImpl) handlerGetAllEmployees(w http.ResponseWriter, r *h
 		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: categories})
 	}
 }
+
+func (h *handlerEmployeeImpl) handlerSetPin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	pin := &employeedto.PinInput{}
+	jsonpkg.ParseBody(r, pin)
+
+	if err := h.s.SetPin(ctx, dtoId, pin); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerEmployeeImpl) handlerBindTerminal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	terminal := &employeedto.BindTerminalInput{}
+	jsonpkg.ParseBody(r, terminal)
+
+	if err := h.s.BindTerminal(ctx, terminal); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerEmployeeImpl) handlerSwitchEmployee(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	switchEmployee := &employeedto.SwitchEmployeeInput{}
+	jsonpkg.ParseBody(r, switchEmployee)
+
+	lockedErr := &ratelimitservice.LockedError{}
+
+	if employee, err := h.s.SwitchEmployee(ctx, switchEmployee); errors.As(err, &lockedErr) {
+		ratelimitservice.WriteTooManyRequests(w, r, lockedErr.RetryAfter, ratelimitservice.ErrLocked.Error())
+	} else if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusUnauthorized, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: employee})
+	}
+}
+
+func (h *handlerEmployeeImpl) handlerReleaseEmployee(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := h.s.ReleaseEmployee(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
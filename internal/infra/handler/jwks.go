@@ -0,0 +1,46 @@
+package handlerimpl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerJwksImpl struct{}
+
+func NewHandlerJwks() *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerJwksImpl{}
+
+	route := "/.well-known"
+
+	c.With().Group(func(c chi.Router) {
+		c.Get("/jwks.json", h.handlerGetJwks)
+	})
+
+	unprotectedRoutes := []string{
+		fmt.Sprintf("%s/jwks.json", route),
+	}
+	return handler.NewHandler(route, c, unprotectedRoutes...)
+}
+
+// handlerGetJwks answers the raw key set, other services read it with standard jwks clients.
+func (h *handlerJwksImpl) handlerGetJwks(w http.ResponseWriter, r *http.Request) {
+	jwks, err := jwtservice.GetJWKS()
+
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(jwks)
+}
@@ -0,0 +1,53 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	loyaltydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/loyalty"
+	loyaltyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/loyalty"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerLoyaltyProgramImpl struct {
+	s *loyaltyusecases.Service
+}
+
+func NewHandlerLoyaltyProgram(loyaltyService *loyaltyusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerLoyaltyProgramImpl{
+		s: loyaltyService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Get("/", h.handlerGetLoyaltyProgram)
+		c.Patch("/update", h.handlerUpdateLoyaltyProgram)
+	})
+
+	return handler.NewHandler("/loyalty-program", c)
+}
+
+func (h *handlerLoyaltyProgramImpl) handlerGetLoyaltyProgram(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if program, err := h.s.GetLoyaltyProgram(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: program})
+	}
+}
+
+func (h *handlerLoyaltyProgramImpl) handlerUpdateLoyaltyProgram(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	program := &loyaltydto.UpdateLoyaltyProgramInput{}
+	jsonpkg.ParseBody(r, program)
+
+	if err := h.s.UpdateLoyaltyProgram(ctx, program); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
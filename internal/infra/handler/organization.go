@@ -0,0 +1,233 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	organizationdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/organization"
+	organizationusecases "github.com/willjrcom/sales-backend-go/internal/usecases/organization"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerOrganizationImpl struct {
+	s *organizationusecases.Service
+}
+
+func NewHandlerOrganization(organizationService *organizationusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerOrganizationImpl{
+		s: organizationService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreateOrganization)
+		c.Patch("/update/{id}", h.handlerUpdateOrganization)
+		c.Get("/all", h.handlerGetUserOrganizations)
+		c.Get("/{id}", h.handlerGetOrganizationById)
+		c.Post("/company/add/{id}", h.handlerAddCompany)
+		c.Post("/company/remove/{id}", h.handlerRemoveCompany)
+		c.Post("/catalog-template/new/{id}", h.handlerCreateCatalogTemplate)
+		c.Get("/catalog-template/all/{id}", h.handlerGetCatalogTemplates)
+		c.Post("/catalog-template/apply/{id}", h.handlerApplyCatalogTemplate)
+		c.Post("/report/{id}", h.handlerGetConsolidatedReport)
+	})
+
+	return handler.NewHandler("/organization", c)
+}
+
+func (h *handlerOrganizationImpl) handlerCreateOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	organization := &organizationdto.OrganizationInput{}
+	jsonpkg.ParseBody(r, organization)
+
+	if id, err := h.s.CreateOrganization(ctx, organization); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerOrganizationImpl) handlerGetUserOrganizations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if organizations, err := h.s.GetUserOrganizations(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: organizations})
+	}
+}
+
+func (h *handlerOrganizationImpl) handlerUpdateOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	organization := &organizationdto.OrganizationInput{}
+	jsonpkg.ParseBody(r, organization)
+
+	if err := h.s.UpdateOrganization(ctx, dtoId, organization); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerOrganizationImpl) handlerGetOrganizationById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if organization, err := h.s.GetOrganizationById(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: organization})
+	}
+}
+
+func (h *handlerOrganizationImpl) handlerAddCompany(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	company := &organizationdto.OrganizationCompanyInput{}
+	jsonpkg.ParseBody(r, company)
+
+	if err := h.s.AddCompany(ctx, dtoId, company); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerOrganizationImpl) handlerRemoveCompany(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	company := &organizationdto.OrganizationCompanyInput{}
+	jsonpkg.ParseBody(r, company)
+
+	if err := h.s.RemoveCompany(ctx, dtoId, company); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerOrganizationImpl) handlerCreateCatalogTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	template := &organizationdto.CatalogTemplateInput{}
+	jsonpkg.ParseBody(r, template)
+
+	if templateID, err := h.s.CreateCatalogTemplate(ctx, dtoId, template); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: templateID})
+	}
+}
+
+func (h *handlerOrganizationImpl) handlerGetCatalogTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if templates, err := h.s.GetCatalogTemplates(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: templates})
+	}
+}
+
+func (h *handlerOrganizationImpl) handlerApplyCatalogTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	company := &organizationdto.OrganizationCompanyInput{}
+	jsonpkg.ParseBody(r, company)
+
+	if plan, err := h.s.ApplyCatalogTemplate(ctx, dtoId, company); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: plan})
+	}
+}
+
+func (h *handlerOrganizationImpl) handlerGetConsolidatedReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	period := &organizationdto.ConsolidatedReportInput{}
+	jsonpkg.ParseBody(r, period)
+
+	if report, err := h.s.GetConsolidatedReport(ctx, dtoId, period); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: report})
+	}
+}
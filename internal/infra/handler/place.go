@@ -0,0 +1,172 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	placedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/place"
+	placeusecases "github.com/willjrcom/sales-backend-go/internal/usecases/place"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerPlaceImpl struct {
+	s *placeusecases.Service
+}
+
+func NewHandlerPlace(placeService *placeusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerPlaceImpl{
+		s: placeService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreatePlace)
+		c.Patch("/update/{id}", h.handlerUpdatePlace)
+		c.Put("/update/layout/{id}", h.handlerUpdateLayout)
+		c.Delete("/delete/{id}", h.handlerDeletePlace)
+		c.Get("/live", h.handlerGetAllPlacesView)
+		c.Get("/{id}/live", h.handlerGetPlaceView)
+		c.Get("/{id}", h.handlerGetPlaceById)
+		c.Get("/all", h.handlerGetAllPlaces)
+	})
+
+	return handler.NewHandler("/place", c)
+}
+
+func (h *handlerPlaceImpl) handlerCreatePlace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	place := &placedto.CreatePlaceInput{}
+	jsonpkg.ParseBody(r, place)
+
+	if id, err := h.s.CreatePlace(ctx, place); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerPlaceImpl) handlerUpdatePlace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	place := &placedto.UpdatePlaceInput{}
+	jsonpkg.ParseBody(r, place)
+
+	if err := h.s.UpdatePlace(ctx, dtoId, place); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerPlaceImpl) handlerUpdateLayout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	layout := &placedto.UpdateLayoutInput{}
+	jsonpkg.ParseBody(r, layout)
+
+	if err := h.s.UpdateLayout(ctx, dtoId, layout); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerPlaceImpl) handlerDeletePlace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeletePlace(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerPlaceImpl) handlerGetPlaceById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if place, err := h.s.GetPlaceById(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: place})
+	}
+}
+
+func (h *handlerPlaceImpl) handlerGetAllPlaces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if places, err := h.s.GetAllPlaces(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: places})
+	}
+}
+
+func (h *handlerPlaceImpl) handlerGetPlaceView(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if view, err := h.s.GetPlaceView(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: view})
+	}
+}
+
+func (h *handlerPlaceImpl) handlerGetAllPlacesView(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if views, err := h.s.GetAllPlacesView(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: views})
+	}
+}
@@ -0,0 +1,178 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	reservationdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/reservation"
+	reservationusecases "github.com/willjrcom/sales-backend-go/internal/usecases/reservation"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerReservationImpl struct {
+	s *reservationusecases.Service
+}
+
+func NewHandlerReservation(reservationService *reservationusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerReservationImpl{
+		s: reservationService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreateReservation)
+		c.Patch("/update/{id}", h.handlerUpdateReservation)
+		c.Post("/update/seat/{id}", h.handlerSeatReservation)
+		c.Post("/update/no-show/{id}", h.handlerMarkNoShow)
+		c.Post("/update/cancel/{id}", h.handlerCancelReservation)
+		c.Post("/availability", h.handlerGetAvailability)
+		c.Post("/period", h.handlerGetReservationsByPeriod)
+		c.Get("/{id}", h.handlerGetReservationById)
+	})
+
+	return handler.NewHandler("/reservation", c)
+}
+
+func (h *handlerReservationImpl) handlerCreateReservation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reservation := &reservationdto.CreateReservationInput{}
+	jsonpkg.ParseBody(r, reservation)
+
+	if id, err := h.s.CreateReservation(ctx, reservation); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerReservationImpl) handlerUpdateReservation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	reservation := &reservationdto.UpdateReservationInput{}
+	jsonpkg.ParseBody(r, reservation)
+
+	if err := h.s.UpdateReservation(ctx, dtoId, reservation); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerReservationImpl) handlerSeatReservation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	seat := &reservationdto.SeatReservationInput{}
+	jsonpkg.ParseBody(r, seat)
+
+	if tableOrderID, err := h.s.SeatReservation(ctx, dtoId, seat); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: tableOrderID})
+	}
+}
+
+func (h *handlerReservationImpl) handlerMarkNoShow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.MarkNoShow(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerReservationImpl) handlerCancelReservation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.CancelReservation(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerReservationImpl) handlerGetAvailability(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	availability := &reservationdto.AvailabilityInput{}
+	jsonpkg.ParseBody(r, availability)
+
+	if suggestions, err := h.s.GetAvailability(ctx, availability); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: suggestions})
+	}
+}
+
+func (h *handlerReservationImpl) handlerGetReservationsByPeriod(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	period := &reservationdto.ReservationPeriodInput{}
+	jsonpkg.ParseBody(r, period)
+
+	if reservations, err := h.s.GetReservationsByPeriod(ctx, period); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: reservations})
+	}
+}
+
+func (h *handlerReservationImpl) handlerGetReservationById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if reservation, err := h.s.GetReservationById(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: reservation})
+	}
+}
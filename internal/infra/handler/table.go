@@ -25,6 +25,8 @@ func NewHandlerTable(orderService *tableusecases.Service) *handler.Handler {
 
 	c.With().Group(func(c chi.Router) {
 		c.Post("/new", h.handlerRegisterTable)
+		c.Patch("/update/{id}", h.handlerUpdateTable)
+		c.Post("/update/clean/{id}", h.handlerCleanTable)
 		c.Delete("/delete/{id}", h.handlerDeleteTableById)
 		c.Get("/{id}", h.handlerGetTableById)
 		c.Get("/all", h.handlerGetAllTables)
@@ -45,6 +47,47 @@ func (h *handlerTableImpl) handlerRegisterTable(w http.ResponseWriter, r *http.R
 	}
 }
 
+func (h *handlerTableImpl) handlerUpdateTable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	table := &tabledto.UpdateTableInput{}
+	jsonpkg.ParseBody(r, table)
+
+	if err := h.s.UpdateTable(ctx, dtoId, table); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerTableImpl) handlerCleanTable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.CleanTable(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
 func (h *handlerTableImpl) handlerDeleteTableById(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
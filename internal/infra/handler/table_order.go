@@ -28,6 +28,9 @@ func NewHandlerTableOrder(orderService *tableorderusecases.Service) *handler.Han
 		c.Delete("/delete/{id}", h.handlerDeleteTableOrderById)
 		c.Post("/update/change-table/{id}", h.handlerChangeTable)
 		c.Post("/update/finish/{id}", h.handlerFinishTableOrder)
+		c.Post("/update/merge/{id}", h.handlerMergeTableOrders)
+		c.Post("/update/transfer/{id}", h.handlerTransferTableOrder)
+		c.Get("/{id}/transfers", h.handlerGetTableOrderTransfers)
 		c.Get("/{id}", h.handlerGetTableOrderById)
 		c.Get("/all", h.handlerGetAllTables)
 	})
@@ -135,3 +138,66 @@ func (h *handlerTableOrderImpl) handlerGetAllTables(w http.ResponseWriter, r *ht
 		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: orders})
 	}
 }
+
+func (h *handlerTableOrderImpl) handlerMergeTableOrders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	merge := &tableorderdto.MergeTableOrderInput{}
+	jsonpkg.ParseBody(r, merge)
+
+	if transfer, err := h.s.MergeTableOrders(ctx, dtoId, merge); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: transfer})
+	}
+}
+
+func (h *handlerTableOrderImpl) handlerTransferTableOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	transferInput := &tableorderdto.TransferTableOrderInput{}
+	jsonpkg.ParseBody(r, transferInput)
+
+	if transfer, err := h.s.TransferTableOrder(ctx, dtoId, transferInput); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: transfer})
+	}
+}
+
+func (h *handlerTableOrderImpl) handlerGetTableOrderTransfers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if transfers, err := h.s.GetTableOrderTransfers(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: transfers})
+	}
+}
@@ -0,0 +1,277 @@
+package handlerimpl
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	timeclockdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/time_clock"
+	ratelimitservice "github.com/willjrcom/sales-backend-go/internal/infra/service/ratelimit"
+	timeclockusecases "github.com/willjrcom/sales-backend-go/internal/usecases/time_clock"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerTimeClockImpl struct {
+	s *timeclockusecases.Service
+}
+
+func NewHandlerTimeClock(timeClockService *timeclockusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerTimeClockImpl{
+		s: timeClockService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/punch", h.handlerPunch)
+		c.Post("/entry/new", h.handlerCreateEntry)
+		c.Patch("/entry/update/{id}", h.handlerUpdateEntry)
+		c.Post("/entry/void/{id}", h.handlerVoidEntry)
+		c.Get("/corrections/{id}", h.handlerGetCorrectionsByEmployeeId)
+		c.Post("/timesheet/{id}", h.handlerGetTimesheets)
+		c.Post("/attendance/{id}", h.handlerGetMonthlyAttendance)
+		c.Post("/attendance/{id}/csv", h.handlerExportMonthlyAttendance)
+		c.Post("/schedule/new", h.handlerCreateWorkSchedule)
+		c.Patch("/schedule/update/{id}", h.handlerUpdateWorkSchedule)
+		c.Delete("/schedule/delete/{id}", h.handlerDeleteWorkSchedule)
+		c.Get("/schedule/all", h.handlerGetAllWorkSchedules)
+	})
+
+	return handler.NewHandler("/time-clock", c)
+}
+
+func (h *handlerTimeClockImpl) handlerPunch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	punch := &timeclockdto.PunchInput{}
+	jsonpkg.ParseBody(r, punch)
+
+	lockedErr := &ratelimitservice.LockedError{}
+
+	if entry, err := h.s.Punch(ctx, punch); errors.As(err, &lockedErr) {
+		ratelimitservice.WriteTooManyRequests(w, r, lockedErr.RetryAfter, ratelimitservice.ErrLocked.Error())
+	} else if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: entry})
+	}
+}
+
+func (h *handlerTimeClockImpl) handlerCreateEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	entry := &timeclockdto.CreateEntryInput{}
+	jsonpkg.ParseBody(r, entry)
+
+	if id, err := h.s.CreateEntry(ctx, entry); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerTimeClockImpl) handlerUpdateEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	entry := &timeclockdto.UpdateEntryInput{}
+	jsonpkg.ParseBody(r, entry)
+
+	if err := h.s.UpdateEntry(ctx, dtoId, entry); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerTimeClockImpl) handlerVoidEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	void := &timeclockdto.VoidEntryInput{}
+	jsonpkg.ParseBody(r, void)
+
+	if err := h.s.VoidEntry(ctx, dtoId, void); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerTimeClockImpl) handlerGetCorrectionsByEmployeeId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if corrections, err := h.s.GetCorrectionsByEmployeeId(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: corrections})
+	}
+}
+
+func (h *handlerTimeClockImpl) handlerGetTimesheets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	period := &timeclockdto.TimesheetInput{}
+	jsonpkg.ParseBody(r, period)
+
+	if timesheets, err := h.s.GetTimesheets(ctx, dtoId, period); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: timesheets})
+	}
+}
+
+func (h *handlerTimeClockImpl) handlerGetMonthlyAttendance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	month := &timeclockdto.AttendanceInput{}
+	jsonpkg.ParseBody(r, month)
+
+	if attendance, _, err := h.s.GetMonthlyAttendance(ctx, dtoId, month); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: attendance})
+	}
+}
+
+func (h *handlerTimeClockImpl) handlerExportMonthlyAttendance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	month := &timeclockdto.AttendanceInput{}
+	jsonpkg.ParseBody(r, month)
+
+	attendance, employee, err := h.s.GetMonthlyAttendance(ctx, dtoId, month)
+
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", "attachment; filename=attendance-"+month.Month+".csv")
+	w.WriteHeader(http.StatusOK)
+
+	if err := timeclockdto.WriteAttendanceCSV(w, employee.Name, attendance); err != nil {
+		log.Printf("error writing attendance csv: %v", err)
+	}
+}
+
+func (h *handlerTimeClockImpl) handlerCreateWorkSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	schedule := &timeclockdto.RegisterWorkScheduleInput{}
+	jsonpkg.ParseBody(r, schedule)
+
+	if id, err := h.s.CreateWorkSchedule(ctx, schedule); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerTimeClockImpl) handlerUpdateWorkSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	schedule := &timeclockdto.UpdateWorkScheduleInput{}
+	jsonpkg.ParseBody(r, schedule)
+
+	if err := h.s.UpdateWorkSchedule(ctx, dtoId, schedule); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerTimeClockImpl) handlerDeleteWorkSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeleteWorkSchedule(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerTimeClockImpl) handlerGetAllWorkSchedules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if schedules, err := h.s.GetAllWorkSchedules(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: schedules})
+	}
+}
@@ -0,0 +1,54 @@
+package handlerimpl
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	ratelimitservice "github.com/willjrcom/sales-backend-go/internal/infra/service/ratelimit"
+	trackingusecases "github.com/willjrcom/sales-backend-go/internal/usecases/tracking"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerTrackingImpl struct {
+	s *trackingusecases.Service
+}
+
+func NewHandlerTracking(trackingService *trackingusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerTrackingImpl{
+		s: trackingService,
+	}
+
+	limiter := ratelimitservice.NewLimiter(30, time.Minute)
+
+	route := "/order-tracking"
+	c.With(limiter.Middleware).Group(func(c chi.Router) {
+		c.Get("/{token}", h.handlerGetTracking)
+	})
+
+	unprotectedRoutes := []string{
+		fmt.Sprintf("%s/", route),
+	}
+	return handler.NewHandler(route, c, unprotectedRoutes...)
+}
+
+func (h *handlerTrackingImpl) handlerGetTracking(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	token := chi.URLParam(r, "token")
+
+	if token == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "token is required"})
+		return
+	}
+
+	if tracking, err := h.s.GetTracking(ctx, token); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusNotFound, jsonpkg.Error{Message: "tracking not found"})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: tracking})
+	}
+}
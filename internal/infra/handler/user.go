@@ -1,14 +1,19 @@
 package handlerimpl
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	userdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/user"
 	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
 	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+	ratelimitservice "github.com/willjrcom/sales-backend-go/internal/infra/service/ratelimit"
 	userusecases "github.com/willjrcom/sales-backend-go/internal/usecases/user"
 	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
 )
@@ -32,11 +37,30 @@ func NewHandlerUser(userService *userusecases.Service) *handler.Handler {
 		c.Post("/login", h.handlerLoginUser)
 		c.Post("/access", h.handlerAccess)
 		c.Delete("/delete", h.handlerDeleteUser)
+		c.Post("/refresh", h.handlerRefreshToken)
+		c.Post("/logout", h.handlerLogout)
+		c.Post("/logout/all", h.handlerLogoutAll)
+		c.Get("/sessions", h.handlerGetSessions)
+		c.Delete("/sessions/{id}", h.handlerRevokeSession)
+		c.Post("/forgot-password", h.handlerForgotPassword)
+		c.Post("/reset-password", h.handlerResetPassword)
+		c.Post("/verify-email", h.handlerVerifyEmail)
+		c.Post("/send-verification", h.handlerSendEmailVerification)
+		c.Post("/login/two-factor", h.handlerLoginTwoFactor)
+		c.Post("/two-factor/enroll", h.handlerStartTwoFactorEnrollment)
+		c.Post("/two-factor/confirm", h.handlerConfirmTwoFactorEnrollment)
+		c.Post("/two-factor/disable", h.handlerDisableTwoFactor)
+		c.Post("/two-factor/recovery-codes", h.handlerRegenerateRecoveryCodes)
 	})
 
 	unprotectedRoutes := []string{
 		fmt.Sprintf("%s/login", route),
 		fmt.Sprintf("%s/access", route),
+		fmt.Sprintf("%s/refresh", route),
+		fmt.Sprintf("%s/forgot-password", route),
+		fmt.Sprintf("%s/reset-password", route),
+		fmt.Sprintf("%s/verify-email", route),
+		fmt.Sprintf("%s/two-factor", route),
 	}
 	return handler.NewHandler(route, c, unprotectedRoutes...)
 }
@@ -73,7 +97,11 @@ func (h *handlerUserImpl) handlerLoginUser(w http.ResponseWriter, r *http.Reques
 	user := &userdto.LoginUserInput{}
 	jsonpkg.ParseBody(r, user)
 
-	if token, err := h.s.LoginUser(ctx, user); err != nil {
+	lockedErr := &ratelimitservice.LockedError{}
+
+	if token, err := h.s.LoginUser(ctx, user, r.UserAgent(), ratelimitservice.ClientIP(r)); errors.As(err, &lockedErr) {
+		ratelimitservice.WriteTooManyRequests(w, r, lockedErr.RetryAfter, ratelimitservice.ErrLocked.Error())
+	} else if err != nil {
 		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
 	} else {
 		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: token})
@@ -112,3 +140,213 @@ func (h *handlerUserImpl) handlerDeleteUser(w http.ResponseWriter, r *http.Reque
 		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
 	}
 }
+
+func (h *handlerUserImpl) handlerRefreshToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	refreshToken := &userdto.RefreshTokenInput{}
+	jsonpkg.ParseBody(r, refreshToken)
+
+	if token, err := h.s.RefreshToken(ctx, refreshToken); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusUnauthorized, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: token})
+	}
+}
+
+func (h *handlerUserImpl) handlerLogout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := h.s.Logout(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerUserImpl) handlerLogoutAll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := h.s.LogoutAll(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerUserImpl) handlerGetSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if sessions, err := h.s.GetSessions(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: sessions})
+	}
+}
+
+func (h *handlerUserImpl) handlerRevokeSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.RevokeSession(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerUserImpl) handlerForgotPassword(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	forgotPassword := &userdto.ForgotPasswordInput{}
+	jsonpkg.ParseBody(r, forgotPassword)
+
+	if err := h.s.ForgotPassword(ctx, forgotPassword); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerUserImpl) handlerResetPassword(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resetPassword := &userdto.ResetPasswordInput{}
+	jsonpkg.ParseBody(r, resetPassword)
+
+	if err := h.s.ResetPassword(ctx, resetPassword); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerUserImpl) handlerVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	verifyEmail := &userdto.VerifyEmailInput{}
+	jsonpkg.ParseBody(r, verifyEmail)
+
+	if err := h.s.VerifyEmail(ctx, verifyEmail); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerUserImpl) handlerSendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := h.s.SendEmailVerification(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerUserImpl) handlerLoginTwoFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	twoFactor := &userdto.TwoFactorLoginInput{}
+	jsonpkg.ParseBody(r, twoFactor)
+
+	lockedErr := &ratelimitservice.LockedError{}
+
+	if token, err := h.s.LoginTwoFactor(ctx, twoFactor, r.UserAgent(), ratelimitservice.ClientIP(r)); errors.As(err, &lockedErr) {
+		ratelimitservice.WriteTooManyRequests(w, r, lockedErr.RetryAfter, ratelimitservice.ErrLocked.Error())
+	} else if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusUnauthorized, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: token})
+	}
+}
+
+// validateAccessToken is used by the two factor routes, they run before choosing a company so there is no id-token.
+func (h *handlerUserImpl) validateAccessToken(w http.ResponseWriter, r *http.Request) (*jwt.Token, bool) {
+	headerToken, _ := headerservice.GetAccessTokenHeader(r)
+	accessToken, err := jwtservice.ValidateToken(r.Context(), headerToken)
+
+	if err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusUnauthorized, jsonpkg.Error{Message: err.Error()})
+		return nil, false
+	}
+
+	return accessToken, true
+}
+
+func (h *handlerUserImpl) handlerStartTwoFactorEnrollment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	accessToken, ok := h.validateAccessToken(w, r)
+	if !ok {
+		return
+	}
+
+	if enrollment, err := h.s.StartTwoFactorEnrollment(ctx, accessToken); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: enrollment})
+	}
+}
+
+func (h *handlerUserImpl) handlerConfirmTwoFactorEnrollment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	accessToken, ok := h.validateAccessToken(w, r)
+	if !ok {
+		return
+	}
+
+	code := &userdto.TwoFactorCodeInput{}
+	jsonpkg.ParseBody(r, code)
+
+	if recoveryCodes, err := h.s.ConfirmTwoFactorEnrollment(ctx, code, accessToken); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: recoveryCodes})
+	}
+}
+
+func (h *handlerUserImpl) handlerDisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	accessToken, ok := h.validateAccessToken(w, r)
+	if !ok {
+		return
+	}
+
+	code := &userdto.TwoFactorCodeInput{}
+	jsonpkg.ParseBody(r, code)
+
+	if err := h.s.DisableTwoFactor(ctx, code, accessToken); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerUserImpl) handlerRegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	accessToken, ok := h.validateAccessToken(w, r)
+	if !ok {
+		return
+	}
+
+	code := &userdto.TwoFactorCodeInput{}
+	jsonpkg.ParseBody(r, code)
+
+	if recoveryCodes, err := h.s.RegenerateRecoveryCodes(ctx, code, accessToken); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: recoveryCodes})
+	}
+}
@@ -0,0 +1,214 @@
+package handlerimpl
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	waitercommissiondto "github.com/willjrcom/sales-backend-go/internal/infra/dto/waiter_commission"
+	waitercommissionusecases "github.com/willjrcom/sales-backend-go/internal/usecases/waiter_commission"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerWaiterCommissionImpl struct {
+	s *waitercommissionusecases.Service
+}
+
+func NewHandlerWaiterCommission(waiterCommissionService *waitercommissionusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerWaiterCommissionImpl{
+		s: waiterCommissionService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/rule/new", h.handlerCreateCommissionRule)
+		c.Patch("/rule/update/{id}", h.handlerUpdateCommissionRule)
+		c.Delete("/rule/delete/{id}", h.handlerDeleteCommissionRule)
+		c.Get("/rule/all", h.handlerGetAllCommissionRules)
+		c.Post("/preview", h.handlerPreviewWaiterCommission)
+		c.Post("/new", h.handlerCreateWaiterCommission)
+		c.Post("/close/{id}", h.handlerCloseWaiterCommission)
+		c.Get("/{id}", h.handlerGetWaiterCommissionById)
+		c.Get("/all", h.handlerGetAllWaiterCommissions)
+		c.Get("/by-waiter/{id}", h.handlerGetWaiterCommissionsByWaiterId)
+		c.Post("/report", h.handlerGetWaiterReport)
+	})
+
+	return handler.NewHandler("/waiter-commission", c)
+}
+
+func (h *handlerWaiterCommissionImpl) handlerCreateCommissionRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rule := &waitercommissiondto.RegisterCommissionRuleInput{}
+	jsonpkg.ParseBody(r, rule)
+
+	if id, err := h.s.CreateCommissionRule(ctx, rule); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerWaiterCommissionImpl) handlerUpdateCommissionRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	rule := &waitercommissiondto.UpdateCommissionRuleInput{}
+	jsonpkg.ParseBody(r, rule)
+
+	if err := h.s.UpdateCommissionRule(ctx, dtoId, rule); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerWaiterCommissionImpl) handlerDeleteCommissionRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeleteCommissionRule(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerWaiterCommissionImpl) handlerGetAllCommissionRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if rules, err := h.s.GetAllCommissionRules(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: rules})
+	}
+}
+
+func (h *handlerWaiterCommissionImpl) handlerPreviewWaiterCommission(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	commission := &waitercommissiondto.WaiterCommissionInput{}
+	jsonpkg.ParseBody(r, commission)
+
+	if preview, err := h.s.PreviewWaiterCommission(ctx, commission); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: preview})
+	}
+}
+
+func (h *handlerWaiterCommissionImpl) handlerCreateWaiterCommission(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	commission := &waitercommissiondto.WaiterCommissionInput{}
+	jsonpkg.ParseBody(r, commission)
+
+	if id, err := h.s.CreateWaiterCommission(ctx, commission); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusCreated, jsonpkg.HTTPResponse{Data: id})
+	}
+}
+
+func (h *handlerWaiterCommissionImpl) handlerCloseWaiterCommission(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	closeSettlement := &waitercommissiondto.CloseWaiterCommissionInput{}
+	jsonpkg.ParseBody(r, closeSettlement)
+
+	if err := h.s.CloseWaiterCommission(ctx, dtoId, closeSettlement); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+	}
+}
+
+func (h *handlerWaiterCommissionImpl) handlerGetWaiterCommissionById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if commission, err := h.s.GetWaiterCommissionById(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: commission})
+	}
+}
+
+func (h *handlerWaiterCommissionImpl) handlerGetAllWaiterCommissions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if commissions, err := h.s.GetAllWaiterCommissions(ctx); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: commissions})
+	}
+}
+
+func (h *handlerWaiterCommissionImpl) handlerGetWaiterCommissionsByWaiterId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseJson(w, r, http.StatusBadRequest, jsonpkg.Error{Message: "id is required"})
+		return
+	}
+
+	dtoId := &entitydto.IdRequest{ID: uuid.MustParse(id)}
+
+	if commissions, err := h.s.GetWaiterCommissionsByWaiterId(ctx, dtoId); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: commissions})
+	}
+}
+
+func (h *handlerWaiterCommissionImpl) handlerGetWaiterReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	period := &waitercommissiondto.WaiterReportInput{}
+	jsonpkg.ParseBody(r, period)
+
+	if report, err := h.s.GetWaiterReport(ctx, period); err != nil {
+		jsonpkg.ResponseJson(w, r, http.StatusInternalServerError, jsonpkg.Error{Message: err.Error()})
+	} else {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, jsonpkg.HTTPResponse{Data: report})
+	}
+}
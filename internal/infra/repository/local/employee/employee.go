@@ -21,6 +21,10 @@ func (r *EmployeeRepositoryLocal) UpdateEmployee(ctx context.Context, p *employe
 	return nil
 }
 
+func (r *EmployeeRepositoryLocal) UpdateEmployeePin(ctx context.Context, p *employeeentity.Employee) error {
+	return nil
+}
+
 func (r *EmployeeRepositoryLocal) DeleteEmployee(ctx context.Context, id string) error {
 	return nil
 }
@@ -29,6 +33,10 @@ func (r *EmployeeRepositoryLocal) GetEmployeeById(ctx context.Context, id string
 	return nil, nil
 }
 
+func (r *EmployeeRepositoryLocal) GetEmployeeByUserId(ctx context.Context, userID string) (*employeeentity.Employee, error) {
+	return nil, nil
+}
+
 func (r *EmployeeRepositoryLocal) GetAllEmployees(ctx context.Context) ([]employeeentity.Employee, error) {
 	return nil, nil
 }
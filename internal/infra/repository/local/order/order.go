@@ -63,6 +63,30 @@ func (r *OrderRepositoryLocal) GetAllOrders(ctx context.Context) ([]orderentity.
 	return orders, nil
 }
 
+func (r *OrderRepositoryLocal) GetOrdersByClientId(ctx context.Context, clientID string) ([]orderentity.Order, error) {
+	orders := make([]orderentity.Order, 0)
+
+	for _, p := range r.orders {
+		if id := p.GetClientID(); id != nil && id.String() == clientID {
+			orders = append(orders, *p)
+		}
+	}
+
+	return orders, nil
+}
+
+func (r *OrderRepositoryLocal) GetAllClientOrders(ctx context.Context) ([]orderentity.Order, error) {
+	orders := make([]orderentity.Order, 0)
+
+	for _, p := range r.orders {
+		if p.GetClientID() != nil {
+			orders = append(orders, *p)
+		}
+	}
+
+	return orders, nil
+}
+
 func (r *OrderRepositoryLocal) UpdateDeliveryOrder(ctx context.Context, delivery *orderentity.DeliveryOrder) error {
 	return nil
 }
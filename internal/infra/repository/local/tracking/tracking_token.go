@@ -0,0 +1,87 @@
+package trackingrepositorylocal
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	trackingentity "github.com/willjrcom/sales-backend-go/internal/domain/tracking"
+)
+
+var (
+	errTrackingTokenExists   = errors.New("tracking token already exists")
+	errTrackingTokenNotFound = errors.New("tracking token not found")
+)
+
+type TrackingTokenRepositoryLocal struct {
+	mu         sync.Mutex
+	tokens     map[uuid.UUID]*trackingentity.TrackingToken
+	Deliveries map[uuid.UUID]*orderentity.DeliveryOrder
+}
+
+func NewTrackingTokenRepositoryLocal() *TrackingTokenRepositoryLocal {
+	return &TrackingTokenRepositoryLocal{
+		tokens:     make(map[uuid.UUID]*trackingentity.TrackingToken),
+		Deliveries: make(map[uuid.UUID]*orderentity.DeliveryOrder),
+	}
+}
+
+func (r *TrackingTokenRepositoryLocal) CreateTrackingToken(_ context.Context, token *trackingentity.TrackingToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[token.ID]; ok {
+		return errTrackingTokenExists
+	}
+
+	r.tokens[token.ID] = token
+	return nil
+}
+
+func (r *TrackingTokenRepositoryLocal) CreateTrackingTokenWithDelivery(ctx context.Context, delivery *orderentity.DeliveryOrder, token *trackingentity.TrackingToken) error {
+	if err := r.CreateTrackingToken(ctx, token); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Deliveries[delivery.ID] = delivery
+	return nil
+}
+
+func (r *TrackingTokenRepositoryLocal) UpdateTrackingToken(_ context.Context, token *trackingentity.TrackingToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens[token.ID] = token
+	return nil
+}
+
+func (r *TrackingTokenRepositoryLocal) GetTrackingTokenByToken(_ context.Context, token string) (*trackingentity.TrackingToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, trackingToken := range r.tokens {
+		if trackingToken.Token == token {
+			return trackingToken, nil
+		}
+	}
+
+	return nil, errTrackingTokenNotFound
+}
+
+func (r *TrackingTokenRepositoryLocal) GetTrackingTokenByDeliveryId(_ context.Context, deliveryID string) (*trackingentity.TrackingToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, trackingToken := range r.tokens {
+		if trackingToken.DeliveryID.String() == deliveryID {
+			return trackingToken, nil
+		}
+	}
+
+	return nil, errTrackingTokenNotFound
+}
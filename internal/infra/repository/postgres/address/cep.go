@@ -0,0 +1,57 @@
+package addressrepositorybun
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+)
+
+type CepRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewCepRepositoryBun(db *bun.DB) *CepRepositoryBun {
+	return &CepRepositoryBun{db: db}
+}
+
+func (r *CepRepositoryBun) SaveCepAddress(ctx context.Context, cep *addressentity.CepAddress) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(cep).On("CONFLICT (cep) DO UPDATE").
+		Set("street = EXCLUDED.street").
+		Set("neighborhood = EXCLUDED.neighborhood").
+		Set("city = EXCLUDED.city").
+		Set("state = EXCLUDED.state").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CepRepositoryBun) GetCepAddress(ctx context.Context, cep string) (*addressentity.CepAddress, error) {
+	cepAddress := &addressentity.CepAddress{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(cepAddress).Where("cep = ?", cep).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return cepAddress, nil
+}
@@ -1,13 +1,18 @@
 package clientrepositorybun
 
 import (
+	"database/sql"
+	"errors"
 	"sync"
 
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/bootstrap/database"
 	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	creditaccountentity "github.com/willjrcom/sales-backend-go/internal/domain/credit_account"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
 
 	"golang.org/x/net/context"
 )
@@ -72,27 +77,11 @@ func (r *ClientRepositoryBun) UpdateClient(ctx context.Context, c *cliententity.
 	}
 
 	if _, err := tx.NewUpdate().Model(c).Where("id = ?", c.ID).Exec(ctx); err != nil {
-		return err
+		return rollback(&tx, err)
 	}
 
 	if c.Contact != nil {
-		if _, err := tx.NewDelete().Model(&personentity.Contact{}).Where("object_id = ?", c.ID).Exec(ctx); err != nil {
-			return rollback(&tx, err)
-		}
-
-		// Register contact
-		if _, err := tx.NewInsert().Model(c.Contact).Exec(ctx); err != nil {
-			return rollback(&tx, err)
-		}
-	}
-
-	if c.Address != nil {
-		if _, err := tx.NewDelete().Model(&addressentity.Address{}).Where("object_id = ?", c.ID).Exec(ctx); err != nil {
-			return rollback(&tx, err)
-		}
-
-		// Register addresse
-		if _, err := tx.NewInsert().Model(c.Address).Exec(ctx); err != nil {
+		if _, err := tx.NewUpdate().Model(c.Contact).WherePK().Exec(ctx); err != nil {
 			return rollback(&tx, err)
 		}
 	}
@@ -150,10 +139,12 @@ func (r *ClientRepositoryBun) GetClientById(ctx context.Context, id string) (*cl
 		return nil, err
 	}
 
-	if err := r.db.NewSelect().Model(client).Where("client.id = ?", id).Relation("Address").Relation("Contact").Scan(ctx); err != nil {
+	if err := r.db.NewSelect().Model(client).Where("client.id = ?", id).Where("client.deleted_at IS NULL").Relation("Addresses").Relation("Contacts", orderContacts).Scan(ctx); err != nil {
 		return nil, err
 	}
 
+	client.LoadDefaultContact()
+	client.LoadDefaultAddress()
 	return client, nil
 }
 
@@ -166,13 +157,351 @@ func (r *ClientRepositoryBun) GetAllClients(ctx context.Context) ([]cliententity
 		return nil, err
 	}
 
-	if err := r.db.NewSelect().Model(&clients).Relation("Address").Relation("Contact").Scan(ctx); err != nil {
+	if err := r.db.NewSelect().Model(&clients).Where("client.deleted_at IS NULL").Relation("Addresses").Relation("Contacts", orderContacts).Scan(ctx); err != nil {
 		return nil, err
 	}
 
+	for i := range clients {
+		clients[i].LoadDefaultContact()
+		clients[i].LoadDefaultAddress()
+	}
+
 	return clients, nil
 }
 
+// SaveClientAddresses syncs the addresses of the client, the addresses removed from the client are deleted.
+func (r *ClientRepositoryBun) SaveClientAddresses(ctx context.Context, c *cliententity.Client) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	ids := []uuid.UUID{}
+	for i := range c.Addresses {
+		ids = append(ids, c.Addresses[i].ID)
+
+		if _, err := tx.NewInsert().Model(&c.Addresses[i]).On("CONFLICT (id) DO UPDATE").Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+	}
+
+	query := tx.NewDelete().Model(&addressentity.Address{}).Where("object_id = ?", c.ID)
+
+	if len(ids) > 0 {
+		query = query.Where("id NOT IN (?)", bun.In(ids))
+	}
+
+	if _, err := query.Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MergeClients points every reference of the duplicate to the survivor and soft deletes the duplicate.
+func (r *ClientRepositoryBun) MergeClients(ctx context.Context, survivor *cliententity.Client, duplicate *cliententity.Client, merge *cliententity.ClientMerge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	moved := map[string]*int{
+		"delivery_orders":      &merge.MovedDeliveryOrders,
+		"table_orders":         &merge.MovedTableOrders,
+		"loyalty_transactions": &merge.MovedLoyaltyTransactions,
+		"reservations":         &merge.MovedReservations,
+	}
+
+	for table, count := range moved {
+		result, err := tx.NewUpdate().Table(table).Set("client_id = ?", survivor.ID).Where("client_id = ?", duplicate.ID).Exec(ctx)
+		if err != nil {
+			return rollback(&tx, err)
+		}
+
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return rollback(&tx, err)
+		}
+
+		*count = int(rows)
+	}
+
+	for i := range survivor.Contacts {
+		if _, err := tx.NewUpdate().Model(&survivor.Contacts[i]).WherePK().Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+	}
+
+	for i := range survivor.Addresses {
+		if _, err := tx.NewUpdate().Model(&survivor.Addresses[i]).WherePK().Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+	}
+
+	// Deliveries of the duplicated addresses point to the equal address of the survivor
+	for duplicateAddressID, survivorAddressID := range merge.ReplacedAddresses {
+		if _, err := tx.NewUpdate().Table("delivery_orders").Set("address_id = ?", survivorAddressID).Where("address_id = ?", duplicateAddressID).Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+	}
+
+	if merge.MergedCreditAccountID, err = mergeCreditAccounts(ctx, &tx, survivor.ID, duplicate.ID); err != nil {
+		return rollback(&tx, err)
+	}
+
+	// The duplicated contacts and addresses are not moved
+	if _, err := tx.NewDelete().Model(&personentity.Contact{}).Where("object_id = ?", duplicate.ID).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if _, err := tx.NewDelete().Model(&addressentity.Address{}).Where("object_id = ?", duplicate.ID).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if _, err := tx.NewUpdate().Model(survivor).WherePK().Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if _, err := tx.NewUpdate().Model(duplicate).WherePK().Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if _, err := tx.NewInsert().Model(merge).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// mergeCreditAccounts moves the account of the duplicate to the survivor, when both have an account
+// the entries and the balance of the duplicate go to the account of the survivor.
+func mergeCreditAccounts(ctx context.Context, tx *bun.Tx, survivorID uuid.UUID, duplicateID uuid.UUID) (*uuid.UUID, error) {
+	duplicateAccount := &creditaccountentity.CreditAccount{}
+	if err := tx.NewSelect().Model(duplicateAccount).Where("client_id = ?", duplicateID).For("UPDATE").Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	survivorAccount := &creditaccountentity.CreditAccount{}
+	err := tx.NewSelect().Model(survivorAccount).Where("client_id = ?", survivorID).For("UPDATE").Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		if _, err := tx.NewUpdate().Model(duplicateAccount).Set("client_id = ?", survivorID).WherePK().Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		return &duplicateAccount.ID, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.NewUpdate().Model(&creditaccountentity.CreditEntry{}).Set("account_id = ?", survivorAccount.ID).Where("account_id = ?", duplicateAccount.ID).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.NewUpdate().Model(survivorAccount).Set("balance = balance + ?", duplicateAccount.Balance).WherePK().Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.NewDelete().Model(duplicateAccount).WherePK().Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return &duplicateAccount.ID, nil
+}
+
+func (r *ClientRepositoryBun) GetAllClientMerges(ctx context.Context) ([]cliententity.ClientMerge, error) {
+	merges := []cliententity.ClientMerge{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&merges).Order("created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return merges, nil
+}
+
+// GetMergedClients returns the soft deleted clients merged into the survivor, including the merges of the merged ones.
+func (r *ClientRepositoryBun) GetMergedClients(ctx context.Context, survivorID string) ([]cliententity.Client, error) {
+	clients := []cliententity.Client{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	survivors := []string{survivorID}
+	seen := map[string]bool{survivorID: true}
+
+	for len(survivors) > 0 {
+		mergedIDs := []string{}
+		if err := r.db.NewSelect().Model((*cliententity.ClientMerge)(nil)).Column("merged_id").
+			Where("survivor_id IN (?)", bun.In(survivors)).Scan(ctx, &mergedIDs); err != nil {
+			return nil, err
+		}
+
+		survivors = []string{}
+		for _, id := range mergedIDs {
+			if !seen[id] {
+				seen[id] = true
+				survivors = append(survivors, id)
+			}
+		}
+
+		if len(survivors) == 0 {
+			break
+		}
+
+		merged := []cliententity.Client{}
+		if err := r.db.NewSelect().Model(&merged).Where("client.id IN (?)", bun.In(survivors)).Scan(ctx); err != nil {
+			return nil, err
+		}
+
+		clients = append(clients, merged...)
+	}
+
+	return clients, nil
+}
+
+// AnonymizeClient scrubs the client, the clients merged into it, their contacts, addresses, reservations
+// and the snapshots of their merges, the orders and the credit entries are kept.
+func (r *ClientRepositoryBun) AnonymizeClient(ctx context.Context, c *cliententity.Client, merged []cliententity.Client, request *cliententity.PrivacyRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	ids := []uuid.UUID{c.ID}
+
+	if _, err := tx.NewUpdate().Model(c).WherePK().Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	for i := range merged {
+		ids = append(ids, merged[i].ID)
+
+		if _, err := tx.NewUpdate().Model(&merged[i]).WherePK().Exec(ctx); err != nil {
+			return rollback(&tx, err)
+		}
+	}
+
+	if _, err := tx.NewDelete().Model(&personentity.Contact{}).Where("object_id IN (?)", bun.In(ids)).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if _, err := tx.NewDelete().Model(&addressentity.Address{}).Where("object_id IN (?)", bun.In(ids)).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if _, err := tx.NewUpdate().Model(&cliententity.ClientMerge{}).Set("merged_client = ?", "{}").
+		Where("survivor_id IN (?)", bun.In(ids)).WhereOr("merged_id IN (?)", bun.In(ids)).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if _, err := tx.NewUpdate().Model(&reservationentity.Reservation{}).
+		Set("name = ?", cliententity.AnonymizedName).Set("contact = ''").Set("observation = ''").
+		Where("client_id IN (?)", bun.In(ids)).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	// The debt is kept for accounting, the account can't be charged anymore
+	if _, err := tx.NewUpdate().Model(&creditaccountentity.CreditAccount{}).Set("is_blocked = ?", true).
+		Where("client_id IN (?)", bun.In(ids)).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if _, err := tx.NewInsert().Model(request).Exec(ctx); err != nil {
+		return rollback(&tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ClientRepositoryBun) CreatePrivacyRequest(ctx context.Context, request *cliententity.PrivacyRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(request).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ClientRepositoryBun) GetAllPrivacyRequests(ctx context.Context) ([]cliententity.PrivacyRequest, error) {
+	requests := []cliententity.PrivacyRequest{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&requests).Order("created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func orderContacts(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("contact.created_at")
+}
+
 func rollback(tx *bun.Tx, err error) error {
 	if errRoolback := tx.Rollback(); errRoolback != nil {
 		return errRoolback
@@ -83,7 +83,77 @@ func (r *CompanyRepositoryBun) GetCompany(ctx context.Context) (*companyentity.C
 	return company, err
 }
 
-func (r *CompanyRepositoryBun) AddUserToPublicCompany(ctx context.Context, userID uuid.UUID) error {
+// UpdateTwoFactorPolicy saves the policy on the company of the schema and on the public copy read on login.
+func (r *CompanyRepositoryBun) UpdateTwoFactorPolicy(ctx context.Context, company *companyentity.Company) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(company).Column("two_factor_required_roles").WherePK().Exec(ctx); err != nil {
+		return err
+	}
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	companyWithUsers := &companyentity.CompanyWithUsers{CompanyCommonAttributes: company.CompanyCommonAttributes}
+	if _, err := r.db.NewUpdate().
+		Model(companyWithUsers).
+		Column("two_factor_required_roles").
+		Where("schema_name = ?", company.SchemaName).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CompanyRepositoryBun) GetUserRole(ctx context.Context, userID uuid.UUID) (companyentity.UserRole, error) {
+	schema := ctx.Value(schemaentity.Schema("schema")).(string)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return "", err
+	}
+
+	companyToUsers := &companyentity.CompanyToUsers{}
+	if err := r.db.NewSelect().
+		Model(companyToUsers).
+		Join("JOIN companies AS c ON c.id = company_to_users.company_with_users_id").
+		Where("c.schema_name = ?", schema).
+		Where("company_to_users.user_id = ?", userID).
+		Scan(ctx); err != nil {
+		return "", err
+	}
+
+	return companyToUsers.Role, nil
+}
+
+func (r *CompanyRepositoryBun) CountUsersByRole(ctx context.Context, role companyentity.UserRole) (int, error) {
+	schema := ctx.Value(schemaentity.Schema("schema")).(string)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return 0, err
+	}
+
+	return r.db.NewSelect().
+		Model((*companyentity.CompanyToUsers)(nil)).
+		Join("JOIN companies AS c ON c.id = company_to_users.company_with_users_id").
+		Where("c.schema_name = ?", schema).
+		Where("company_to_users.role = ?", role).
+		Count(ctx)
+}
+
+func (r *CompanyRepositoryBun) AddUserToPublicCompany(ctx context.Context, userID uuid.UUID, role companyentity.UserRole) error {
 	schema := ctx.Value(schemaentity.Schema("schema")).(string)
 
 	r.mu.Lock()
@@ -98,7 +168,7 @@ func (r *CompanyRepositoryBun) AddUserToPublicCompany(ctx context.Context, userI
 		return err
 	}
 
-	_, err := r.db.NewInsert().Model(&companyentity.CompanyToUsers{CompanyWithUsersID: companyWithUsers.ID, UserID: userID}).Exec(ctx)
+	_, err := r.db.NewInsert().Model(&companyentity.CompanyToUsers{CompanyWithUsersID: companyWithUsers.ID, UserID: userID, Role: role}).Exec(ctx)
 
 	return err
 
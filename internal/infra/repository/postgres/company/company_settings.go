@@ -0,0 +1,49 @@
+package companyrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+)
+
+// GetCompanySettings returns the default settings while the company hasn't saved its own.
+func (r *CompanyRepositoryBun) GetCompanySettings(ctx context.Context) (*companyentity.CompanySettings, error) {
+	settings := &companyentity.CompanySettings{}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	// Schemas saved before the fixed id may have more than one row, the last saved one is used
+	err := r.db.NewSelect().Model(settings).Order("updated_at DESC").Limit(1).Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return companyentity.NewDefaultCompanySettings(), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (r *CompanyRepositoryBun) UpdateCompanySettings(ctx context.Context, settings *companyentity.CompanySettings) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(settings).On("CONFLICT (id) DO UPDATE").Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
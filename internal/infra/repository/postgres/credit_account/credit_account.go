@@ -0,0 +1,227 @@
+package creditaccountrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	creditaccountentity "github.com/willjrcom/sales-backend-go/internal/domain/credit_account"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type CreditAccountRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewCreditAccountRepositoryBun(db *bun.DB) *CreditAccountRepositoryBun {
+	return &CreditAccountRepositoryBun{db: db}
+}
+
+func (r *CreditAccountRepositoryBun) CreateCreditAccount(ctx context.Context, account *creditaccountentity.CreditAccount) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(account).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CreditAccountRepositoryBun) UpdateCreditAccount(ctx context.Context, account *creditaccountentity.CreditAccount) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(account).Where("id = ?", account.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CreditAccountRepositoryBun) GetCreditAccountById(ctx context.Context, id string) (*creditaccountentity.CreditAccount, error) {
+	return r.getCreditAccount(ctx, "credit_account.id = ?", id)
+}
+
+func (r *CreditAccountRepositoryBun) GetCreditAccountByClientId(ctx context.Context, clientID string) (*creditaccountentity.CreditAccount, error) {
+	return r.getCreditAccount(ctx, "credit_account.client_id = ?", clientID)
+}
+
+func (r *CreditAccountRepositoryBun) GetCreditAccountByOrderId(ctx context.Context, orderID string) (*creditaccountentity.CreditAccount, error) {
+	return r.getCreditAccount(ctx, "credit_account.id IN (SELECT account_id FROM credit_entries WHERE order_id = ?)", orderID)
+}
+
+func (r *CreditAccountRepositoryBun) getCreditAccount(ctx context.Context, query string, args ...interface{}) (*creditaccountentity.CreditAccount, error) {
+	account := &creditaccountentity.CreditAccount{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(account).Where(query, args...).
+		Relation("Client").
+		Relation("Entries", orderEntries).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (r *CreditAccountRepositoryBun) GetAllCreditAccounts(ctx context.Context) ([]creditaccountentity.CreditAccount, error) {
+	accounts := []creditaccountentity.CreditAccount{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&accounts).
+		Relation("Client").
+		Relation("Entries", orderEntries).
+		Order("credit_account.balance DESC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+func (r *CreditAccountRepositoryBun) SaveCreditEntries(ctx context.Context, account *creditaccountentity.CreditAccount, created []creditaccountentity.CreditEntry, updated []creditaccountentity.CreditEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	amount := 0.0
+	for i := range created {
+		amount += created[i].Amount
+	}
+
+	if err = addToBalance(ctx, &tx, account, amount); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for i := range updated {
+		if _, err = tx.NewUpdate().Model(&updated[i]).Where("id = ?", updated[i].ID).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	for i := range created {
+		if _, err = tx.NewInsert().Model(&created[i]).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ChargeOrder saves the charge, the payment and the order in the same transaction.
+func (r *CreditAccountRepositoryBun) ChargeOrder(ctx context.Context, account *creditaccountentity.CreditAccount, charge *creditaccountentity.CreditEntry, order *orderentity.Order, payment *orderentity.PaymentOrder) error {
+	order.CalculateTotalChange()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if err = addToBalance(ctx, &tx, account, charge.Amount); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.NewInsert().Model(charge).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.NewInsert().Model(payment).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.NewUpdate().Model(order).Where("id = ?", order.ID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// addToBalance changes the balance relative to the stored one, a charge only passes while the
+// account is unblocked and within the limit and a payment never leaves the balance negative.
+func addToBalance(ctx context.Context, tx *bun.Tx, account *creditaccountentity.CreditAccount, amount float64) error {
+	query := tx.NewUpdate().Model(account).
+		Set("balance = ROUND(CAST(balance + ? AS numeric), 2)", amount).
+		Set("updated_at = ?", time.Now()).
+		Where("id = ?", account.ID)
+
+	if amount > 0 {
+		query = query.Where("is_blocked = false").Where("ROUND(CAST(balance + ? AS numeric), 2) <= credit_limit", amount)
+	} else {
+		query = query.Where("ROUND(CAST(balance + ? AS numeric), 2) >= 0", amount)
+	}
+
+	result, err := query.Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 && amount > 0 {
+		return creditaccountentity.ErrCreditLimitExceeded
+	}
+
+	if rows == 0 {
+		return creditaccountentity.ErrSettlementAboveBalance
+	}
+
+	return nil
+}
+
+func orderEntries(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("credit_entry.created_at")
+}
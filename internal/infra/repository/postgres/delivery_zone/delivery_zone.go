@@ -0,0 +1,115 @@
+package deliveryzonerepositorybun
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+)
+
+type DeliveryZoneRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewDeliveryZoneRepositoryBun(db *bun.DB) *DeliveryZoneRepositoryBun {
+	return &DeliveryZoneRepositoryBun{db: db}
+}
+
+func (r *DeliveryZoneRepositoryBun) CreateDeliveryZone(ctx context.Context, zone *deliveryzoneentity.DeliveryZone) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(zone).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *DeliveryZoneRepositoryBun) UpdateDeliveryZone(ctx context.Context, zone *deliveryzoneentity.DeliveryZone) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(zone).Where("id = ?", zone.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *DeliveryZoneRepositoryBun) DeleteDeliveryZone(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewDelete().Model(&deliveryzoneentity.DeliveryZone{}).Where("id = ?", id).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *DeliveryZoneRepositoryBun) GetDeliveryZoneById(ctx context.Context, id string) (*deliveryzoneentity.DeliveryZone, error) {
+	zone := &deliveryzoneentity.DeliveryZone{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(zone).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return zone, nil
+}
+
+func (r *DeliveryZoneRepositoryBun) GetAllDeliveryZones(ctx context.Context) ([]deliveryzoneentity.DeliveryZone, error) {
+	zones := []deliveryzoneentity.DeliveryZone{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&zones).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+func (r *DeliveryZoneRepositoryBun) GetActiveDeliveryZones(ctx context.Context) ([]deliveryzoneentity.DeliveryZone, error) {
+	zones := []deliveryzoneentity.DeliveryZone{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&zones).Where("is_active = ?", true).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
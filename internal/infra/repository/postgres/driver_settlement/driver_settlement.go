@@ -0,0 +1,128 @@
+package driversettlementrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	driversettlemententity "github.com/willjrcom/sales-backend-go/internal/domain/driver_settlement"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type DriverSettlementRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewDriverSettlementRepositoryBun(db *bun.DB) *DriverSettlementRepositoryBun {
+	return &DriverSettlementRepositoryBun{db: db}
+}
+
+func (r *DriverSettlementRepositoryBun) CreateDriverSettlement(ctx context.Context, settlement *driversettlemententity.DriverSettlement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.NewInsert().Model(settlement).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	deliveryIDs := []interface{}{}
+	for _, delivery := range settlement.Deliveries {
+		deliveryIDs = append(deliveryIDs, delivery.DeliveryID)
+	}
+
+	if len(deliveryIDs) > 0 {
+		// The settlement_id condition keeps two concurrent settlements from paying the same delivery
+		res, err := tx.NewUpdate().Model((*orderentity.DeliveryOrder)(nil)).Set("settlement_id = ?", settlement.ID).Where("id IN (?)", bun.In(deliveryIDs)).Where("settlement_id IS NULL").Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if rows, _ := res.RowsAffected(); int(rows) != len(deliveryIDs) {
+			tx.Rollback()
+			return driversettlemententity.ErrDeliveryAlreadySettled
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *DriverSettlementRepositoryBun) UpdateDriverSettlement(ctx context.Context, settlement *driversettlemententity.DriverSettlement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(settlement).Where("id = ?", settlement.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *DriverSettlementRepositoryBun) GetDriverSettlementById(ctx context.Context, id string) (*driversettlemententity.DriverSettlement, error) {
+	settlement := &driversettlemententity.DriverSettlement{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(settlement).Where("driver_settlement.id = ?", id).Relation("Driver").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return settlement, nil
+}
+
+func (r *DriverSettlementRepositoryBun) GetAllDriverSettlements(ctx context.Context) ([]driversettlemententity.DriverSettlement, error) {
+	settlements := []driversettlemententity.DriverSettlement{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&settlements).Relation("Driver").Order("driver_settlement.end_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return settlements, nil
+}
+
+func (r *DriverSettlementRepositoryBun) GetDriverSettlementsByDriverId(ctx context.Context, driverID string) ([]driversettlemententity.DriverSettlement, error) {
+	settlements := []driversettlemententity.DriverSettlement{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&settlements).Where("driver_settlement.driver_id = ?", driverID).Order("driver_settlement.end_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return settlements, nil
+}
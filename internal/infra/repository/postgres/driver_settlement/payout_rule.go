@@ -0,0 +1,98 @@
+package driversettlementrepositorybun
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	driversettlemententity "github.com/willjrcom/sales-backend-go/internal/domain/driver_settlement"
+)
+
+type PayoutRuleRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewPayoutRuleRepositoryBun(db *bun.DB) *PayoutRuleRepositoryBun {
+	return &PayoutRuleRepositoryBun{db: db}
+}
+
+func (r *PayoutRuleRepositoryBun) CreatePayoutRule(ctx context.Context, rule *driversettlemententity.PayoutRule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(rule).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *PayoutRuleRepositoryBun) UpdatePayoutRule(ctx context.Context, rule *driversettlemententity.PayoutRule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(rule).Where("id = ?", rule.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *PayoutRuleRepositoryBun) DeletePayoutRule(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewDelete().Model(&driversettlemententity.PayoutRule{}).Where("id = ?", id).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *PayoutRuleRepositoryBun) GetPayoutRuleById(ctx context.Context, id string) (*driversettlemententity.PayoutRule, error) {
+	rule := &driversettlemententity.PayoutRule{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(rule).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (r *PayoutRuleRepositoryBun) GetAllPayoutRules(ctx context.Context) ([]driversettlemententity.PayoutRule, error) {
+	rules := []driversettlemententity.PayoutRule{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&rules).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
@@ -123,6 +123,21 @@ func (r *EmployeeRepositoryBun) UpdateEmployee(ctx context.Context, p *employeee
 	return nil
 }
 
+func (r *EmployeeRepositoryBun) UpdateEmployeePin(ctx context.Context, p *employeeentity.Employee) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(p).Column("pin_hash").Where("employee.id = ?", p.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *EmployeeRepositoryBun) DeleteEmployee(ctx context.Context, id string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -176,6 +191,23 @@ func (r *EmployeeRepositoryBun) GetEmployeeById(ctx context.Context, id string)
 	return employee, nil
 }
 
+func (r *EmployeeRepositoryBun) GetEmployeeByUserId(ctx context.Context, userID string) (*employeeentity.Employee, error) {
+	employee := &employeeentity.Employee{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(employee).Where("employee.user_id = ?", userID).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return employee, nil
+}
+
 func (r *EmployeeRepositoryBun) GetAllEmployees(ctx context.Context) ([]employeeentity.Employee, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -0,0 +1,66 @@
+package loyaltyrepositorybun
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+)
+
+type LoyaltyProgramRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewLoyaltyProgramRepositoryBun(db *bun.DB) *LoyaltyProgramRepositoryBun {
+	return &LoyaltyProgramRepositoryBun{db: db}
+}
+
+func (r *LoyaltyProgramRepositoryBun) CreateLoyaltyProgram(ctx context.Context, program *loyaltyentity.LoyaltyProgram) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(program).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *LoyaltyProgramRepositoryBun) UpdateLoyaltyProgram(ctx context.Context, program *loyaltyentity.LoyaltyProgram) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(program).Where("id = ?", program.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *LoyaltyProgramRepositoryBun) GetLoyaltyProgram(ctx context.Context) (*loyaltyentity.LoyaltyProgram, error) {
+	program := &loyaltyentity.LoyaltyProgram{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(program).Order("created_at").Limit(1).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return program, nil
+}
@@ -0,0 +1,68 @@
+package loyaltyrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+)
+
+type LoyaltyTransactionRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewLoyaltyTransactionRepositoryBun(db *bun.DB) *LoyaltyTransactionRepositoryBun {
+	return &LoyaltyTransactionRepositoryBun{db: db}
+}
+
+func (r *LoyaltyTransactionRepositoryBun) SaveLoyaltyTransactions(ctx context.Context, created []loyaltyentity.LoyaltyTransaction, updated []loyaltyentity.LoyaltyTransaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	for i := range updated {
+		if _, err = tx.NewUpdate().Model(&updated[i]).Where("id = ?", updated[i].ID).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	for i := range created {
+		if _, err = tx.NewInsert().Model(&created[i]).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *LoyaltyTransactionRepositoryBun) GetLoyaltyTransactionsByClientId(ctx context.Context, clientID string) ([]loyaltyentity.LoyaltyTransaction, error) {
+	transactions := []loyaltyentity.LoyaltyTransaction{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&transactions).Where("client_id = ?", clientID).Order("created_at").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
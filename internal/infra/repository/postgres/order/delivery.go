@@ -2,7 +2,9 @@ package orderrepositorybun
 
 import (
 	"context"
+	"database/sql"
 	"sync"
+	"time"
 
 	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/bootstrap/database"
@@ -48,6 +50,34 @@ func (r *DeliveryOrderRepositoryBun) UpdateDeliveryOrder(ctx context.Context, de
 	return nil
 }
 
+// AddDeliveryAttempt updates the delivery and saves the attempt in the same transaction.
+func (r *DeliveryOrderRepositoryBun) AddDeliveryAttempt(ctx context.Context, delivery *orderentity.DeliveryOrder, attempt *orderentity.DeliveryAttempt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.NewUpdate().Model(delivery).Where("id = ?", delivery.ID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.NewInsert().Model(attempt).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (r *DeliveryOrderRepositoryBun) DeleteDeliveryOrder(ctx context.Context, id string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -80,6 +110,23 @@ func (r *DeliveryOrderRepositoryBun) GetAllDeliveries(ctx context.Context) ([]or
 	return deliveries, nil
 }
 
+func (r *DeliveryOrderRepositoryBun) GetDeliveriesByClientId(ctx context.Context, clientID string) ([]orderentity.DeliveryOrder, error) {
+	deliveries := []orderentity.DeliveryOrder{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&deliveries).Where("delivery_order.client_id = ?", clientID).Relation("Address").Relation("Driver").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
 func (r *DeliveryOrderRepositoryBun) GetDeliveryById(ctx context.Context, id string) (*orderentity.DeliveryOrder, error) {
 	delivery := &orderentity.DeliveryOrder{}
 
@@ -90,9 +137,69 @@ func (r *DeliveryOrderRepositoryBun) GetDeliveryById(ctx context.Context, id str
 		return nil, err
 	}
 
-	if err := r.db.NewSelect().Model(delivery).Where("id = ?", id).Scan(ctx); err != nil {
+	if err := r.db.NewSelect().Model(delivery).Where("delivery_order.id = ?", id).Relation("Address").Relation("DeliveryZone").Relation("Driver").Relation("Attempts", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Order("number")
+	}).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (r *DeliveryOrderRepositoryBun) GetDeliveryByOrderId(ctx context.Context, orderID string) (*orderentity.DeliveryOrder, error) {
+	delivery := &orderentity.DeliveryOrder{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(delivery).Where("delivery_order.order_id = ?", orderID).Scan(ctx); err != nil {
 		return nil, err
 	}
 
 	return delivery, nil
 }
+
+func (r *DeliveryOrderRepositoryBun) GetDeliveriesByStatus(ctx context.Context, status orderentity.StatusDeliveryOrder) ([]orderentity.DeliveryOrder, error) {
+	deliveries := []orderentity.DeliveryOrder{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&deliveries).Where("delivery_order.status = ?", status).Relation("Client").Relation("Address").Relation("DeliveryZone").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *DeliveryOrderRepositoryBun) GetDeliveriesToSettle(ctx context.Context, driverID string, startAt time.Time, endAt time.Time) ([]orderentity.DeliveryOrder, error) {
+	deliveries := []orderentity.DeliveryOrder{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	query := r.db.NewSelect().Model(&deliveries).
+		Where("delivery_order.driver_id = ?", driverID).
+		Where("delivery_order.status = ?", orderentity.DeliveryOrderStatusDelivered).
+		Where("delivery_order.settlement_id IS NULL").
+		Where("delivery_order.delivered_at BETWEEN ? AND ?", startAt, endAt).
+		Order("delivery_order.delivered_at")
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
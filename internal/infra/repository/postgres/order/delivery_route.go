@@ -0,0 +1,136 @@
+package orderrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type DeliveryRouteRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewDeliveryRouteRepositoryBun(db *bun.DB) *DeliveryRouteRepositoryBun {
+	return &DeliveryRouteRepositoryBun{db: db}
+}
+
+func (r *DeliveryRouteRepositoryBun) CreateDeliveryRoute(ctx context.Context, route *orderentity.DeliveryRoute) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.NewInsert().Model(route).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for i := range route.Deliveries {
+		if _, err = tx.NewUpdate().Model(&route.Deliveries[i]).Where("id = ?", route.Deliveries[i].ID).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *DeliveryRouteRepositoryBun) UpdateDeliveryRoute(ctx context.Context, route *orderentity.DeliveryRoute) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.NewUpdate().Model(route).Where("id = ?", route.ID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for i := range route.Deliveries {
+		if _, err = tx.NewUpdate().Model(&route.Deliveries[i]).Where("id = ?", route.Deliveries[i].ID).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *DeliveryRouteRepositoryBun) GetDeliveryRouteById(ctx context.Context, id string) (*orderentity.DeliveryRoute, error) {
+	route := &orderentity.DeliveryRoute{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(route).Where("delivery_route.id = ?", id).
+		Relation("Driver").
+		Relation("Deliveries", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("route_stop ASC")
+		}).
+		Relation("Deliveries.Address").
+		Relation("Deliveries.Client").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return route, nil
+}
+
+func (r *DeliveryRouteRepositoryBun) GetAllDeliveryRoutes(ctx context.Context) ([]orderentity.DeliveryRoute, error) {
+	routes := []orderentity.DeliveryRoute{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&routes).Relation("Driver").Relation("Deliveries").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
+
+func (r *DeliveryRouteRepositoryBun) GetDeliveryRoutesByStatus(ctx context.Context, status orderentity.StatusDeliveryRoute) ([]orderentity.DeliveryRoute, error) {
+	routes := []orderentity.DeliveryRoute{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&routes).Where("delivery_route.status = ?", status).Relation("Driver").Relation("Deliveries").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
@@ -190,6 +190,52 @@ func (r *OrderRepositoryBun) GetAllOrders(ctx context.Context) ([]orderentity.Or
 	return orders, nil
 }
 
+// GetOrdersByClientId returns the orders where the client was attached to the delivery or to the table.
+func (r *OrderRepositoryBun) GetOrdersByClientId(ctx context.Context, clientID string) ([]orderentity.Order, error) {
+	orders := []orderentity.Order{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&orders).
+		Where("order.id IN (SELECT order_id FROM delivery_orders WHERE client_id = ?)", clientID).
+		WhereOr("order.id IN (SELECT order_id FROM table_orders WHERE client_id = ?)", clientID).
+		Relation("Groups.Items").Relation("Payments").Relation("Delivery").Relation("Table").
+		Order("order.order_number DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	for i := range orders {
+		orders[i].CalculateTotalChange()
+	}
+
+	return orders, nil
+}
+
+func (r *OrderRepositoryBun) GetAllClientOrders(ctx context.Context) ([]orderentity.Order, error) {
+	orders := []orderentity.Order{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&orders).
+		Where("order.id IN (SELECT order_id FROM delivery_orders)").
+		WhereOr("order.id IN (SELECT order_id FROM table_orders WHERE client_id IS NOT NULL)").
+		Relation("Groups.Items").Relation("Delivery").Relation("Table").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
 func (r *OrderRepositoryBun) AddPaymentOrder(ctx context.Context, payment *orderentity.PaymentOrder) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
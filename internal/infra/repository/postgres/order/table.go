@@ -2,11 +2,17 @@ package orderrepositorybun
 
 import (
 	"context"
+	"database/sql"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	creditaccountentity "github.com/willjrcom/sales-backend-go/internal/domain/credit_account"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 )
 
@@ -82,6 +88,23 @@ func (r *TableOrderRepositoryBun) GetTableOrderById(ctx context.Context, id stri
 	return table, err
 }
 
+func (r *TableOrderRepositoryBun) GetTableOrderByOrderId(ctx context.Context, orderID string) (*orderentity.TableOrder, error) {
+	table := &orderentity.TableOrder{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(table).Where("table_order.order_id = ?", orderID).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
 func (r *TableOrderRepositoryBun) GetAllTableOrders(ctx context.Context) (tables []orderentity.TableOrder, err error) {
 	tables = make([]orderentity.TableOrder, 0)
 
@@ -98,3 +121,162 @@ func (r *TableOrderRepositoryBun) GetAllTableOrders(ctx context.Context) (tables
 
 	return tables, err
 }
+
+// GetOpenTableOrders returns the table orders whose order is not finished or canceled yet.
+func (r *TableOrderRepositoryBun) GetOpenTableOrders(ctx context.Context) (tables []orderentity.TableOrder, err error) {
+	tables = make([]orderentity.TableOrder, 0)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	openStatus := []orderentity.StatusOrder{orderentity.OrderStatusStaging, orderentity.OrderStatusPending}
+
+	if err = r.db.NewSelect().Model(&tables).
+		Where("table_order.order_id IN (SELECT id FROM orders WHERE status IN (?))", bun.In(openStatus)).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return tables, err
+}
+
+// GetFinishedTableOrders returns the table orders whose order was finished in the period, used by the waiter commissions.
+func (r *TableOrderRepositoryBun) GetFinishedTableOrders(ctx context.Context, startAt time.Time, endAt time.Time) (tables []orderentity.TableOrder, err error) {
+	tables = make([]orderentity.TableOrder, 0)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	finishedStatus := []orderentity.StatusOrder{orderentity.OrderStatusFinished, orderentity.OrderStatusArchived}
+
+	if err = r.db.NewSelect().Model(&tables).
+		Where("table_order.order_id IN (SELECT id FROM orders WHERE status IN (?) AND finished_at BETWEEN ? AND ?)", bun.In(finishedStatus), startAt, endAt).
+		Relation("Waiter").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return tables, err
+}
+
+// SaveTableOrderTransfer saves the groups, items, payments and ledger entries moved between the orders with the audit entry.
+func (r *TableOrderRepositoryBun) SaveTableOrderTransfer(ctx context.Context, result *orderentity.TransferResult, source *orderentity.Order, target *orderentity.Order) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	groups := append([]groupitementity.GroupItem{}, source.Groups...)
+	groups = append(groups, target.Groups...)
+
+	if len(groups) > 0 {
+		if _, err = tx.NewInsert().Model(&groups).On("CONFLICT (id) DO UPDATE").Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	for i := range result.MovedItems {
+		if _, err = tx.NewUpdate().Model(&itementity.Item{}).
+			Set("group_item_id = ?", result.MovedItems[i].GroupItemID).
+			Where("id = ?", result.MovedItems[i].ID).
+			Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if len(result.DeletedGroupIDs) > 0 {
+		if _, err = tx.NewDelete().Model(&itementity.Item{}).Where("group_item_id IN (?)", bun.In(result.DeletedGroupIDs)).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if _, err = tx.NewDelete().Model(&groupitementity.GroupItem{}).Where("id IN (?)", bun.In(result.DeletedGroupIDs)).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if result.Transfer.MovedPayments > 0 {
+		if _, err = tx.NewUpdate().Model(&orderentity.PaymentOrder{}).
+			Set("order_id = ?", target.ID).
+			Where("order_id = ?", source.ID).
+			Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if result.Transfer.Type == orderentity.TransferTypeMerge {
+		// The fiado charges and the loyalty points follow the payments and the discount
+		if _, err = tx.NewUpdate().Model(&creditaccountentity.CreditEntry{}).
+			Set("order_id = ?", target.ID).
+			Where("order_id = ?", source.ID).
+			Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if _, err = tx.NewUpdate().Model(&loyaltyentity.LoyaltyTransaction{}).
+			Set("order_id = ?", target.ID).
+			Where("order_id = ?", source.ID).
+			Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	for _, order := range []*orderentity.Order{source, target} {
+		if _, err = tx.NewUpdate().Model(order).
+			Column("status", "canceled_at", "total_payable", "total_paid", "total_change", "discount", "quantity_items", "observation").
+			WherePK().
+			Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if _, err = tx.NewInsert().Model(result.Transfer).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *TableOrderRepositoryBun) GetTableOrderTransfers(ctx context.Context, tableOrderID string) ([]orderentity.TableOrderTransfer, error) {
+	transfers := []orderentity.TableOrderTransfer{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&transfers).
+		Where("source_table_order_id = ? OR target_table_order_id = ?", tableOrderID, tableOrderID).
+		Order("created_at DESC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
@@ -0,0 +1,130 @@
+package organizationrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	organizationentity "github.com/willjrcom/sales-backend-go/internal/domain/organization"
+)
+
+type CatalogTemplateRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewCatalogTemplateRepositoryBun(db *bun.DB) *CatalogTemplateRepositoryBun {
+	return &CatalogTemplateRepositoryBun{db: db}
+}
+
+func (r *CatalogTemplateRepositoryBun) CreateCatalogTemplate(ctx context.Context, template *organizationentity.CatalogTemplate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(template).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CatalogTemplateRepositoryBun) GetCatalogTemplateById(ctx context.Context, id string) (*organizationentity.CatalogTemplate, error) {
+	template := &organizationentity.CatalogTemplate{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(template).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (r *CatalogTemplateRepositoryBun) GetCatalogTemplatesByOrganizationId(ctx context.Context, organizationID string) ([]organizationentity.CatalogTemplate, error) {
+	templates := []organizationentity.CatalogTemplate{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&templates).Where("organization_id = ?", organizationID).Order("created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// ApplyCatalogPlan writes the plan in the schema of the context, all or nothing.
+func (r *CatalogTemplateRepositoryBun) ApplyCatalogPlan(ctx context.Context, plan *organizationentity.CatalogPlan) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if len(plan.Categories) > 0 {
+		if _, err = tx.NewInsert().Model(&plan.Categories).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if len(plan.Sizes) > 0 {
+		if _, err = tx.NewInsert().Model(&plan.Sizes).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if len(plan.Quantities) > 0 {
+		if _, err = tx.NewInsert().Model(&plan.Quantities).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if len(plan.ProcessRules) > 0 {
+		if _, err = tx.NewInsert().Model(&plan.ProcessRules).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if len(plan.Products) > 0 {
+		if _, err = tx.NewInsert().Model(&plan.Products).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	for i := range plan.UpdatedProducts {
+		product := &plan.UpdatedProducts[i]
+		if _, err = tx.NewUpdate().Model(product).Where("id = ?", product.ID).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
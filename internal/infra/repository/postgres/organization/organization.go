@@ -0,0 +1,130 @@
+package organizationrepositorybun
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	organizationentity "github.com/willjrcom/sales-backend-go/internal/domain/organization"
+)
+
+type OrganizationRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewOrganizationRepositoryBun(db *bun.DB) *OrganizationRepositoryBun {
+	return &OrganizationRepositoryBun{db: db}
+}
+
+func (r *OrganizationRepositoryBun) CreateOrganization(ctx context.Context, organization *organizationentity.Organization) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(organization).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepositoryBun) UpdateOrganization(ctx context.Context, organization *organizationentity.Organization) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(organization).Where("id = ?", organization.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepositoryBun) GetOrganizationById(ctx context.Context, id string) (*organizationentity.Organization, error) {
+	organization := &organizationentity.Organization{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(organization).Where("organization.id = ?", id).Relation("Companies").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+// GetOrganizationsByUserId returns the organizations owned by the user or grouping any company of the user.
+func (r *OrganizationRepositoryBun) GetOrganizationsByUserId(ctx context.Context, userID uuid.UUID) ([]organizationentity.Organization, error) {
+	organizations := []organizationentity.Organization{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&organizations).
+		Where("organization.owner_id = ?", userID).
+		WhereOr("organization.id IN (SELECT otc.organization_id FROM organization_to_companies AS otc JOIN company_to_users AS ctu ON ctu.company_with_users_id = otc.company_id WHERE ctu.user_id = ?)", userID).
+		Relation("Companies").
+		Order("organization.name ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return organizations, nil
+}
+
+func (r *OrganizationRepositoryBun) AddCompany(ctx context.Context, organizationID uuid.UUID, companyID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	exists, err := r.db.NewSelect().Model((*organizationentity.OrganizationToCompanies)(nil)).Where("company_id = ?", companyID).Exists(ctx)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return organizationentity.ErrCompanyAlreadyInOrg
+	}
+
+	organizationToCompany := &organizationentity.OrganizationToCompanies{OrganizationID: organizationID, CompanyID: companyID}
+	if _, err := r.db.NewInsert().Model(organizationToCompany).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepositoryBun) RemoveCompany(ctx context.Context, organizationID uuid.UUID, companyID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewDelete().Model((*organizationentity.OrganizationToCompanies)(nil)).Where("organization_id = ? AND company_id = ?", organizationID, companyID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
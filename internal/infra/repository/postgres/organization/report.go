@@ -0,0 +1,77 @@
+package organizationrepositorybun
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	groupitementity "github.com/willjrcom/sales-backend-go/internal/domain/group_item"
+	itementity "github.com/willjrcom/sales-backend-go/internal/domain/item"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	organizationentity "github.com/willjrcom/sales-backend-go/internal/domain/organization"
+)
+
+type ReportRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewReportRepositoryBun(db *bun.DB) *ReportRepositoryBun {
+	return &ReportRepositoryBun{db: db}
+}
+
+// GetBranchSales aggregates the orders finished in the period in the schema of the context.
+func (r *ReportRepositoryBun) GetBranchSales(ctx context.Context, startAt time.Time, endAt time.Time) (*organizationentity.SalesSummary, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	finishedStatus := []orderentity.StatusOrder{orderentity.OrderStatusFinished, orderentity.OrderStatusArchived}
+	summary := &organizationentity.SalesSummary{
+		TopProducts: []organizationentity.ProductSales{},
+		PaymentMix:  []organizationentity.PaymentSales{},
+	}
+
+	if err := r.db.NewSelect().
+		TableExpr("orders AS o").
+		ColumnExpr("COUNT(*)").
+		ColumnExpr("COALESCE(SUM(o.total_payable), 0)").
+		Where("o.status IN (?) AND o.finished_at BETWEEN ? AND ?", bun.In(finishedStatus), startAt, endAt).
+		Scan(ctx, &summary.TotalOrders, &summary.TotalSales); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().
+		TableExpr("items AS i").
+		Join("JOIN group_items AS g ON g.id = i.group_item_id").
+		Join("JOIN orders AS o ON o.id = g.order_id").
+		ColumnExpr("i.name AS name").
+		ColumnExpr("SUM(i.quantity) AS quantity").
+		ColumnExpr("SUM(i.total_price) AS total").
+		Where("o.status IN (?) AND o.finished_at BETWEEN ? AND ?", bun.In(finishedStatus), startAt, endAt).
+		Where("i.status != ?", itementity.StatusItemCanceled).
+		Where("g.status != ?", groupitementity.StatusGroupCanceled).
+		Group("i.name").
+		Scan(ctx, &summary.TopProducts); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().
+		TableExpr("payment_orders AS p").
+		Join("JOIN orders AS o ON o.id = p.order_id").
+		ColumnExpr("p.method AS method").
+		ColumnExpr("COUNT(*) AS count").
+		ColumnExpr("COALESCE(SUM(p.total_paid), 0) AS total").
+		Where("o.status IN (?) AND o.finished_at BETWEEN ? AND ?", bun.In(finishedStatus), startAt, endAt).
+		Group("p.method").
+		Scan(ctx, &summary.PaymentMix); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,153 @@
+package reservationrepositorybun
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
+)
+
+type ReservationRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewReservationRepositoryBun(db *bun.DB) *ReservationRepositoryBun {
+	return &ReservationRepositoryBun{db: db}
+}
+
+func (r *ReservationRepositoryBun) CreateReservation(ctx context.Context, reservation *reservationentity.Reservation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(reservation).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ReservationRepositoryBun) UpdateReservation(ctx context.Context, reservation *reservationentity.Reservation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(reservation).Where("id = ?", reservation.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ReservationRepositoryBun) GetReservationById(ctx context.Context, id string) (*reservationentity.Reservation, error) {
+	reservation := &reservationentity.Reservation{}
+	reservation.ID = uuid.MustParse(id)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(reservation).WherePK().Relation("Client").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+func (r *ReservationRepositoryBun) GetReservationByTableOrderId(ctx context.Context, tableOrderID string) (*reservationentity.Reservation, error) {
+	reservation := &reservationentity.Reservation{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(reservation).Where("reservation.table_order_id = ?", tableOrderID).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+func (r *ReservationRepositoryBun) GetReservationsByClientId(ctx context.Context, clientID string) ([]reservationentity.Reservation, error) {
+	reservations := []reservationentity.Reservation{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&reservations).
+		Where("reservation.client_id = ?", clientID).
+		Order("reservation.start_at").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// GetReservationsByPeriod returns the reservations starting in the period, with any status.
+func (r *ReservationRepositoryBun) GetReservationsByPeriod(ctx context.Context, startAt time.Time, endAt time.Time) ([]reservationentity.Reservation, error) {
+	reservations := []reservationentity.Reservation{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&reservations).
+		Relation("Client").
+		Where("reservation.start_at >= ? AND reservation.start_at < ?", startAt, endAt).
+		Order("reservation.start_at").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// GetActiveReservationsByPeriod returns the booked and seated reservations overlapping the period, and the
+// seated ones whose extra tables were not released yet.
+func (r *ReservationRepositoryBun) GetActiveReservationsByPeriod(ctx context.Context, startAt time.Time, endAt time.Time) ([]reservationentity.Reservation, error) {
+	reservations := []reservationentity.Reservation{}
+	activeStatus := []reservationentity.StatusReservation{reservationentity.ReservationStatusBooked, reservationentity.ReservationStatusSeated}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&reservations).
+		Where("reservation.status IN (?)", bun.In(activeStatus)).
+		Where("((reservation.start_at < ? AND reservation.start_at + reservation.duration * INTERVAL '1 minute' > ?) OR (reservation.status = ? AND reservation.released_at IS NULL))",
+			endAt, startAt, reservationentity.ReservationStatusSeated).
+		Order("reservation.start_at").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
@@ -97,6 +97,24 @@ func (r *ShiftRepositoryBun) GetOpenedShift(ctx context.Context) (*shiftentity.S
 	return shift, nil
 }
 
+// GetLastShift returns the last shift opened, used to continue the order numbers.
+func (r *ShiftRepositoryBun) GetLastShift(ctx context.Context) (*shiftentity.Shift, error) {
+	shift := &shiftentity.Shift{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(shift).Order("shift.opened_at DESC").Limit(1).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return shift, nil
+}
+
 func (r *ShiftRepositoryBun) GetAllShifts(ctx context.Context) ([]shiftentity.Shift, error) {
 	Shifts := []shiftentity.Shift{}
 
@@ -0,0 +1,147 @@
+package tablerepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+)
+
+type PlaceRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewPlaceRepositoryBun(db *bun.DB) *PlaceRepositoryBun {
+	return &PlaceRepositoryBun{db: db}
+}
+
+func (r *PlaceRepositoryBun) CreatePlace(ctx context.Context, place *tableentity.Place) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(place).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *PlaceRepositoryBun) UpdatePlace(ctx context.Context, place *tableentity.Place) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(place).Where("id = ?", place.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *PlaceRepositoryBun) DeletePlace(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.NewDelete().Model(&tableentity.PlaceTable{}).Where("place_id = ?", id).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.NewDelete().Model(&tableentity.Place{}).Where("id = ?", id).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PlaceRepositoryBun) GetPlaceById(ctx context.Context, id string) (*tableentity.Place, error) {
+	place := &tableentity.Place{}
+	place.ID = uuid.MustParse(id)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(place).WherePK().Relation("Tables", orderPlaceTables).Relation("Tables.Table").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return place, nil
+}
+
+func (r *PlaceRepositoryBun) GetAllPlaces(ctx context.Context) ([]tableentity.Place, error) {
+	places := []tableentity.Place{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&places).Relation("Tables", orderPlaceTables).Relation("Tables.Table").Order("place.name").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return places, nil
+}
+
+// SavePlaceTables replaces the layout of the place.
+func (r *PlaceRepositoryBun) SavePlaceTables(ctx context.Context, place *tableentity.Place) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.NewDelete().Model(&tableentity.PlaceTable{}).Where("place_id = ?", place.ID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if len(place.Tables) > 0 {
+		if _, err = tx.NewInsert().Model(&place.Tables).Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func orderPlaceTables(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("place_table.grid_row", "place_table.grid_column")
+}
@@ -0,0 +1,170 @@
+package timeclockrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	timeclockentity "github.com/willjrcom/sales-backend-go/internal/domain/time_clock"
+)
+
+type TimeClockRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewTimeClockRepositoryBun(db *bun.DB) *TimeClockRepositoryBun {
+	return &TimeClockRepositoryBun{db: db}
+}
+
+func (r *TimeClockRepositoryBun) CreateEntry(ctx context.Context, entry *timeclockentity.TimeClockEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(entry).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *TimeClockRepositoryBun) CreateCorrectedEntry(ctx context.Context, entry *timeclockentity.TimeClockEntry, correction *timeclockentity.TimeClockCorrection) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.NewInsert().Model(entry).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.NewInsert().Model(correction).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *TimeClockRepositoryBun) UpdateCorrectedEntry(ctx context.Context, entry *timeclockentity.TimeClockEntry, correction *timeclockentity.TimeClockCorrection) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.NewUpdate().Model(entry).Where("id = ?", entry.ID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.NewInsert().Model(correction).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *TimeClockRepositoryBun) GetEntryById(ctx context.Context, id string) (*timeclockentity.TimeClockEntry, error) {
+	entry := &timeclockentity.TimeClockEntry{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(entry).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (r *TimeClockRepositoryBun) GetLastEntryByEmployeeId(ctx context.Context, employeeID string) (*timeclockentity.TimeClockEntry, error) {
+	entry := &timeclockentity.TimeClockEntry{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().
+		Model(entry).
+		Where("employee_id = ?", employeeID).
+		Where("voided_at IS NULL").
+		Order("at DESC").
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (r *TimeClockRepositoryBun) GetEntriesByEmployeeId(ctx context.Context, employeeID string, startAt time.Time, endAt time.Time) ([]timeclockentity.TimeClockEntry, error) {
+	entries := []timeclockentity.TimeClockEntry{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().
+		Model(&entries).
+		Where("employee_id = ?", employeeID).
+		Where("voided_at IS NULL").
+		Where("at BETWEEN ? AND ?", startAt, endAt).
+		Order("at ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r *TimeClockRepositoryBun) GetCorrectionsByEmployeeId(ctx context.Context, employeeID string) ([]timeclockentity.TimeClockCorrection, error) {
+	corrections := []timeclockentity.TimeClockCorrection{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&corrections).Where("employee_id = ?", employeeID).Order("created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return corrections, nil
+}
@@ -0,0 +1,98 @@
+package timeclockrepositorybun
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	timeclockentity "github.com/willjrcom/sales-backend-go/internal/domain/time_clock"
+)
+
+type WorkScheduleRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewWorkScheduleRepositoryBun(db *bun.DB) *WorkScheduleRepositoryBun {
+	return &WorkScheduleRepositoryBun{db: db}
+}
+
+func (r *WorkScheduleRepositoryBun) CreateWorkSchedule(ctx context.Context, schedule *timeclockentity.WorkSchedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(schedule).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *WorkScheduleRepositoryBun) UpdateWorkSchedule(ctx context.Context, schedule *timeclockentity.WorkSchedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(schedule).Where("id = ?", schedule.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *WorkScheduleRepositoryBun) DeleteWorkSchedule(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewDelete().Model(&timeclockentity.WorkSchedule{}).Where("id = ?", id).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *WorkScheduleRepositoryBun) GetWorkScheduleById(ctx context.Context, id string) (*timeclockentity.WorkSchedule, error) {
+	schedule := &timeclockentity.WorkSchedule{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(schedule).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (r *WorkScheduleRepositoryBun) GetAllWorkSchedules(ctx context.Context) ([]timeclockentity.WorkSchedule, error) {
+	schedules := []timeclockentity.WorkSchedule{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&schedules).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
@@ -0,0 +1,115 @@
+package trackingrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	trackingentity "github.com/willjrcom/sales-backend-go/internal/domain/tracking"
+)
+
+type TrackingTokenRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewTrackingTokenRepositoryBun(db *bun.DB) *TrackingTokenRepositoryBun {
+	return &TrackingTokenRepositoryBun{db: db}
+}
+
+func (r *TrackingTokenRepositoryBun) CreateTrackingToken(ctx context.Context, token *trackingentity.TrackingToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(token).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateTrackingTokenWithDelivery inserts the delivery in the schema of the company and the token in the public schema in the same transaction.
+func (r *TrackingTokenRepositoryBun) CreateTrackingTokenWithDelivery(ctx context.Context, delivery *orderentity.DeliveryOrder, token *trackingentity.TrackingToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	schemaName, err := database.GetSchema(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.NewInsert().Model(delivery).ModelTableExpr("?.delivery_orders", bun.Ident(schemaName)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err = tx.NewInsert().Model(token).ModelTableExpr("public.tracking_tokens").Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *TrackingTokenRepositoryBun) UpdateTrackingToken(ctx context.Context, token *trackingentity.TrackingToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(token).Where("id = ?", token.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *TrackingTokenRepositoryBun) GetTrackingTokenByToken(ctx context.Context, token string) (*trackingentity.TrackingToken, error) {
+	trackingToken := &trackingentity.TrackingToken{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(trackingToken).Where("token = ?", token).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return trackingToken, nil
+}
+
+func (r *TrackingTokenRepositoryBun) GetTrackingTokenByDeliveryId(ctx context.Context, deliveryID string) (*trackingentity.TrackingToken, error) {
+	trackingToken := &trackingentity.TrackingToken{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(trackingToken).Where("delivery_id = ?", deliveryID).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return trackingToken, nil
+}
@@ -83,17 +83,50 @@ func (r *UserRepositoryBun) LoginUser(ctx context.Context, user *companyentity.U
 		return nil, err
 	}
 
+	if err := r.loadCompanies(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *UserRepositoryBun) GetUserById(ctx context.Context, id uuid.UUID) (*companyentity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	user := &companyentity.User{}
+	if err := r.db.NewSelect().
+		Model(user).
+		Where("u.id = ?", id).
+		Relation("CompanyToUsers").
+		ExcludeColumn("hash").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadCompanies(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *UserRepositoryBun) loadCompanies(ctx context.Context, user *companyentity.User) error {
 	for _, ctu := range user.CompanyToUsers {
 		company := &companyentity.CompanyWithUsers{}
 		if err := r.db.NewSelect().Model(company).Where("id = ?", ctu.CompanyWithUsersID).Scan(ctx); err != nil {
-			return nil, err
+			return err
 		}
 
 		company.Address = nil
 		user.Companies = append(user.Companies, *company)
 	}
 
-	return user, nil
+	return nil
 }
 
 func (r *UserRepositoryBun) GetIDByEmail(ctx context.Context, email string) (uuid.UUID, error) {
@@ -109,3 +142,76 @@ func (r *UserRepositoryBun) GetIDByEmail(ctx context.Context, email string) (uui
 
 	return user.ID, err
 }
+
+func (r *UserRepositoryBun) GetUserByEmail(ctx context.Context, email string) (*companyentity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	user := &companyentity.User{}
+	if err := r.db.NewSelect().Model(user).Where("u.email = ?", email).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *UserRepositoryBun) UpdateUserPassword(ctx context.Context, user *companyentity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().
+		Model(user).
+		Column("hash", "must_change_password", "email_verified_at", "updated_at").
+		WherePK().
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *UserRepositoryBun) UpdateUserEmailVerified(ctx context.Context, user *companyentity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().
+		Model(user).
+		Column("email_verified_at", "updated_at").
+		WherePK().
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *UserRepositoryBun) UpdateUserTwoFactor(ctx context.Context, user *companyentity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeToPublicSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().
+		Model(user).
+		Column("two_factor_secret", "two_factor_enabled_at", "two_factor_last_step", "recovery_codes", "updated_at").
+		WherePK().
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
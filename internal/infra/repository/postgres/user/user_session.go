@@ -0,0 +1,132 @@
+package userrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+)
+
+const (
+	userSessionsTable  = "public.user_sessions AS us"
+	refreshTokensTable = "public.refresh_tokens AS rt"
+)
+
+// UserSessionRepositoryBun qualifies the public tables instead of changing the search_path, the sessions
+// are checked on every request and must not change the schema of the company in use.
+type UserSessionRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewUserSessionRepositoryBun(db *bun.DB) *UserSessionRepositoryBun {
+	return &UserSessionRepositoryBun{db: db}
+}
+
+func (r *UserSessionRepositoryBun) CreateSession(ctx context.Context, session *companyentity.UserSession, token *companyentity.RefreshToken) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NewInsert().Model(session).ModelTableExpr(userSessionsTable).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.NewInsert().Model(token).ModelTableExpr(refreshTokensTable).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *UserSessionRepositoryBun) RotateRefreshToken(ctx context.Context, session *companyentity.UserSession, used *companyentity.RefreshToken, next *companyentity.RefreshToken) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	// The used_at condition keeps two concurrent refreshes from both succeeding
+	res, err := tx.NewUpdate().Model(used).ModelTableExpr(refreshTokensTable).WherePK().Where("used_at IS NULL").Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		tx.Rollback()
+		return companyentity.ErrRefreshTokenReused
+	}
+
+	if _, err := tx.NewInsert().Model(next).ModelTableExpr(refreshTokensTable).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.NewUpdate().Model(session).ModelTableExpr(userSessionsTable).WherePK().Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *UserSessionRepositoryBun) UpdateSession(ctx context.Context, session *companyentity.UserSession) error {
+	if _, err := r.db.NewUpdate().Model(session).ModelTableExpr(userSessionsTable).WherePK().Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *UserSessionRepositoryBun) RevokeUserSessions(ctx context.Context, userID uuid.UUID, reason string) error {
+	if _, err := r.db.NewUpdate().
+		Model((*companyentity.UserSession)(nil)).
+		ModelTableExpr(userSessionsTable).
+		Set("revoked_at = ?", time.Now()).
+		Set("revoked_reason = ?", reason).
+		Where("user_id = ?", userID).
+		Where("revoked_at IS NULL").
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *UserSessionRepositoryBun) GetSessionById(ctx context.Context, id uuid.UUID) (*companyentity.UserSession, error) {
+	session := &companyentity.UserSession{}
+	if err := r.db.NewSelect().Model(session).ModelTableExpr(userSessionsTable).Where("us.id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (r *UserSessionRepositoryBun) GetActiveSessionsByUserId(ctx context.Context, userID uuid.UUID) ([]companyentity.UserSession, error) {
+	sessions := []companyentity.UserSession{}
+	if err := r.db.NewSelect().
+		Model(&sessions).
+		ModelTableExpr(userSessionsTable).
+		Where("us.user_id = ?", userID).
+		Where("us.revoked_at IS NULL").
+		Order("us.last_used_at DESC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (r *UserSessionRepositoryBun) GetRefreshTokenByHash(ctx context.Context, hash string) (*companyentity.RefreshToken, error) {
+	token := &companyentity.RefreshToken{}
+	if err := r.db.NewSelect().Model(token).ModelTableExpr(refreshTokensTable).Where("rt.token_hash = ?", hash).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
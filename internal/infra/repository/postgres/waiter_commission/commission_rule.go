@@ -0,0 +1,98 @@
+package waitercommissionrepositorybun
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	waitercommissionentity "github.com/willjrcom/sales-backend-go/internal/domain/waiter_commission"
+)
+
+type CommissionRuleRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewCommissionRuleRepositoryBun(db *bun.DB) *CommissionRuleRepositoryBun {
+	return &CommissionRuleRepositoryBun{db: db}
+}
+
+func (r *CommissionRuleRepositoryBun) CreateCommissionRule(ctx context.Context, rule *waitercommissionentity.CommissionRule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewInsert().Model(rule).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CommissionRuleRepositoryBun) UpdateCommissionRule(ctx context.Context, rule *waitercommissionentity.CommissionRule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(rule).Where("id = ?", rule.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CommissionRuleRepositoryBun) DeleteCommissionRule(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewDelete().Model(&waitercommissionentity.CommissionRule{}).Where("id = ?", id).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CommissionRuleRepositoryBun) GetCommissionRuleById(ctx context.Context, id string) (*waitercommissionentity.CommissionRule, error) {
+	rule := &waitercommissionentity.CommissionRule{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(rule).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (r *CommissionRuleRepositoryBun) GetAllCommissionRules(ctx context.Context) ([]waitercommissionentity.CommissionRule, error) {
+	rules := []waitercommissionentity.CommissionRule{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&rules).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,128 @@
+package waitercommissionrepositorybun
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	waitercommissionentity "github.com/willjrcom/sales-backend-go/internal/domain/waiter_commission"
+)
+
+type WaiterCommissionRepositoryBun struct {
+	mu sync.Mutex
+	db *bun.DB
+}
+
+func NewWaiterCommissionRepositoryBun(db *bun.DB) *WaiterCommissionRepositoryBun {
+	return &WaiterCommissionRepositoryBun{db: db}
+}
+
+func (r *WaiterCommissionRepositoryBun) CreateWaiterCommission(ctx context.Context, commission *waitercommissionentity.WaiterCommission) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.NewInsert().Model(commission).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	tableOrderIDs := []interface{}{}
+	for _, table := range commission.Tables {
+		tableOrderIDs = append(tableOrderIDs, table.TableOrderID)
+	}
+
+	if len(tableOrderIDs) > 0 {
+		// The commission_id condition keeps two concurrent commissions from paying the same table
+		res, err := tx.NewUpdate().Model((*orderentity.TableOrder)(nil)).Set("commission_id = ?", commission.ID).Where("id IN (?)", bun.In(tableOrderIDs)).Where("commission_id IS NULL").Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if rows, _ := res.RowsAffected(); int(rows) != len(tableOrderIDs) {
+			tx.Rollback()
+			return waitercommissionentity.ErrTableAlreadyCommissioned
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *WaiterCommissionRepositoryBun) UpdateWaiterCommission(ctx context.Context, commission *waitercommissionentity.WaiterCommission) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return err
+	}
+
+	if _, err := r.db.NewUpdate().Model(commission).Where("id = ?", commission.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *WaiterCommissionRepositoryBun) GetWaiterCommissionById(ctx context.Context, id string) (*waitercommissionentity.WaiterCommission, error) {
+	commission := &waitercommissionentity.WaiterCommission{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(commission).Where("waiter_commission.id = ?", id).Relation("Waiter").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return commission, nil
+}
+
+func (r *WaiterCommissionRepositoryBun) GetAllWaiterCommissions(ctx context.Context) ([]waitercommissionentity.WaiterCommission, error) {
+	commissions := []waitercommissionentity.WaiterCommission{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&commissions).Relation("Waiter").Order("waiter_commission.end_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return commissions, nil
+}
+
+func (r *WaiterCommissionRepositoryBun) GetWaiterCommissionsByWaiterId(ctx context.Context, waiterID string) ([]waitercommissionentity.WaiterCommission, error) {
+	commissions := []waitercommissionentity.WaiterCommission{}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := database.ChangeSchema(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.NewSelect().Model(&commissions).Where("waiter_commission.waiter_id = ?", waiterID).Order("waiter_commission.end_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return commissions, nil
+}
@@ -0,0 +1,23 @@
+package cepservice
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrCepNotFound = errors.New("cep not found")
+)
+
+type Cep struct {
+	Cep          string `json:"cep"`
+	Street       string `json:"logradouro"`
+	Neighborhood string `json:"bairro"`
+	City         string `json:"localidade"`
+	State        string `json:"uf"`
+}
+
+// Provider looks up a cep with 8 digits, without mask.
+type Provider interface {
+	GetCep(ctx context.Context, cep string) (*Cep, error)
+}
@@ -0,0 +1,54 @@
+package cepservice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViaCepProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/01001000/json/":
+			w.Write([]byte(`{"cep": "01001-000", "logradouro": "Praça da Sé", "bairro": "Sé", "localidade": "São Paulo", "uf": "SP"}`))
+		case "/99999999/json/":
+			w.Write([]byte(`{"erro": true}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewViaCepProvider(server.URL + "/")
+
+	cep, err := provider.GetCep(context.Background(), "01001000")
+	assert.Nil(t, err)
+	assert.Equal(t, "Praça da Sé", cep.Street)
+	assert.Equal(t, "SP", cep.State)
+
+	_, err = provider.GetCep(context.Background(), "99999999")
+	assert.Equal(t, ErrCepNotFound, err)
+
+	_, err = provider.GetCep(context.Background(), "123")
+	assert.Equal(t, ErrCepNotFound, err)
+}
+
+func TestFixtureProviderFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ceps.json")
+	assert.Nil(t, os.WriteFile(path, []byte(`[{"cep": "01001-000", "logradouro": "Praça da Sé", "bairro": "Sé", "localidade": "São Paulo", "uf": "SP"}]`), 0o600))
+
+	provider, err := NewFixtureProviderFromFile(path)
+	assert.Nil(t, err)
+
+	cep, err := provider.GetCep(context.Background(), "01001000")
+	assert.Nil(t, err)
+	assert.Equal(t, "São Paulo", cep.City)
+
+	_, err = provider.GetCep(context.Background(), "02002000")
+	assert.Equal(t, ErrCepNotFound, err)
+}
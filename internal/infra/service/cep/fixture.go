@@ -0,0 +1,50 @@
+package cepservice
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"regexp"
+)
+
+var notDigits = regexp.MustCompile("[^0-9]")
+
+// FixtureProvider answers from memory, it is used on tests and when the server runs offline.
+type FixtureProvider struct {
+	ceps map[string]Cep
+}
+
+func NewFixtureProvider(ceps ...Cep) *FixtureProvider {
+	p := &FixtureProvider{ceps: map[string]Cep{}}
+
+	for _, cep := range ceps {
+		p.ceps[notDigits.ReplaceAllString(cep.Cep, "")] = cep
+	}
+
+	return p
+}
+
+// NewFixtureProviderFromFile loads a json array in the same format of viacep.
+func NewFixtureProviderFromFile(path string) (*FixtureProvider, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ceps := []Cep{}
+	if err := json.Unmarshal(file, &ceps); err != nil {
+		return nil, err
+	}
+
+	return NewFixtureProvider(ceps...), nil
+}
+
+func (p *FixtureProvider) GetCep(ctx context.Context, cep string) (*Cep, error) {
+	data, ok := p.ceps[cep]
+
+	if !ok {
+		return nil, ErrCepNotFound
+	}
+
+	return &data, nil
+}
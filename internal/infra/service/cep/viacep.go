@@ -0,0 +1,57 @@
+package cepservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const ViaCepUrl = "https://viacep.com.br/ws/"
+
+// ViaCepProvider works with any api compatible with viacep: {url}{cep}/json/.
+type ViaCepProvider struct {
+	url    string
+	client *http.Client
+}
+
+func NewViaCepProvider(url string) *ViaCepProvider {
+	return &ViaCepProvider{
+		url:    url,
+		client: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func (p *ViaCepProvider) GetCep(ctx context.Context, cep string) (*Cep, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+cep+"/json/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	// Viacep answers 400 to an invalid cep and {"erro": true} to an unknown one
+	if response.StatusCode == http.StatusBadRequest || response.StatusCode == http.StatusNotFound {
+		return nil, ErrCepNotFound
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cep provider answered with status %d", response.StatusCode)
+	}
+
+	data := &Cep{}
+	if err := json.NewDecoder(response.Body).Decode(data); err != nil {
+		return nil, err
+	}
+
+	if data.Cep == "" {
+		return nil, ErrCepNotFound
+	}
+
+	return data, nil
+}
@@ -0,0 +1,72 @@
+package jwtservice
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// JWK follows RFC 7517, only public keys are published, HS256 secrets never leave the server.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+func GetJWKS() (JWKS, error) {
+	set, err := getKeySet()
+	if err != nil {
+		return JWKS{}, err
+	}
+
+	return set.JWKS(time.Now()), nil
+}
+
+func (s *KeySet) JWKS(now time.Time) JWKS {
+	jwks := JWKS{Keys: []JWK{}}
+
+	for _, key := range s.keys {
+		if key.IsExpired(now) {
+			continue
+		}
+
+		switch publicKey := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				KeyType:   "RSA",
+				Use:       "sig",
+				Algorithm: key.Algorithm,
+				KeyID:     key.ID,
+				N:         base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+				E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				KeyType:   "OKP",
+				Use:       "sig",
+				Algorithm: key.Algorithm,
+				KeyID:     key.ID,
+				Curve:     "Ed25519",
+				X:         base64.RawURLEncoding.EncodeToString(publicKey),
+			})
+		}
+	}
+
+	sort.Slice(jwks.Keys, func(i, j int) bool {
+		return jwks.Keys[i].KeyID < jwks.Keys[j].KeyID
+	})
+
+	return jwks
+}
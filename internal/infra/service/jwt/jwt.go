@@ -2,6 +2,7 @@ package jwtservice
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
@@ -10,21 +11,23 @@ import (
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 )
 
-var secretKey = "sua_chave_secreta"
+var ErrSessionNotFoundInToken = errors.New("session not found in token")
 
-func CreateAccessToken(user *companyentity.User) (string, error) {
+func CreateAccessToken(user *companyentity.User, session *companyentity.UserSession) (string, error) {
 
 	claims := jwt.MapClaims{
 		"user_id":                user.ID,
+		"session_id":             session.ID,
+		"two_factor":             session.IsTwoFactorVerified(),
 		"user_email":             user.Email,
 		"available_user_schemas": user.GetSchemas(),
+		"must_change_password":   user.MustChangePassword,
 		"sub":                    "access-token",
 		"exp":                    time.Now().Add(time.Minute * 5).Unix(),
 	}
 
-	// Criar um token JWT usando a chave secreta
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secretKey))
+	// Criar um token JWT usando a chave ativa
+	return sign(claims)
 }
 
 func CreateIDToken(accessToken *jwt.Token, schema string) (string, error) {
@@ -34,33 +37,53 @@ func CreateIDToken(accessToken *jwt.Token, schema string) (string, error) {
 	claims := jwt.MapClaims{
 		"user_id":        oldClaims["user_id"],
 		"user_email":     oldClaims["user_email"],
+		"session_id":     oldClaims["session_id"],
+		"two_factor":     oldClaims["two_factor"],
 		"current_schema": schema,
 		"sub":            "id-token",
 		"exp":            time.Now().Add(time.Hour * 6).Unix(),
 	}
 
-	// Criar um token JWT usando a chave secreta
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secretKey))
+	// Criar um token JWT usando a chave ativa
+	return sign(claims)
 }
 
 func ValidateToken(ctx context.Context, tokenString string) (*jwt.Token, error) {
-	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, jwt.ErrSignatureInvalid
-		}
-		return []byte(secretKey), nil
-	})
+	return parse(tokenString)
 }
 
 func GetSchemasFromToken(token *jwt.Token) []interface{} {
 	return token.Claims.(jwt.MapClaims)["available_user_schemas"].([]interface{})
 }
 
+func MustChangePasswordFromToken(token *jwt.Token) bool {
+	mustChange, _ := token.Claims.(jwt.MapClaims)["must_change_password"].(bool)
+	return mustChange
+}
+
+func IsTwoFactorVerifiedFromToken(token *jwt.Token) bool {
+	verified, _ := token.Claims.(jwt.MapClaims)["two_factor"].(bool)
+	return verified
+}
+
+func GetUserIDFromToken(token *jwt.Token) (uuid.UUID, error) {
+	id, _ := token.Claims.(jwt.MapClaims)["user_id"].(string)
+	return uuid.Parse(id)
+}
+
 func GetSchemaFromToken(token *jwt.Token) string {
 	return token.Claims.(jwt.MapClaims)["current_schema"].(string)
 }
 
+func GetSessionIDFromToken(token *jwt.Token) (uuid.UUID, error) {
+	id, ok := token.Claims.(jwt.MapClaims)["session_id"].(string)
+	if !ok {
+		return uuid.Nil, ErrSessionNotFoundInToken
+	}
+
+	return uuid.Parse(id)
+}
+
 func GetUserFromToken(token *jwt.Token) companyentity.User {
 	id := token.Claims.(jwt.MapClaims)["user_id"].(string)
 	email := token.Claims.(jwt.MapClaims)["user_email"].(string)
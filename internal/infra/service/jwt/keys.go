@@ -0,0 +1,374 @@
+package jwtservice
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+var (
+	ErrKeyIDRequired        = errors.New("key id required")
+	ErrKeyIDDuplicated      = errors.New("key id duplicated")
+	ErrKeyNotFound          = errors.New("signing key not found")
+	ErrKeyExpired           = errors.New("signing key expired")
+	ErrKeyAlgorithmInvalid  = errors.New("key algorithm must be HS256, RS256 or EdDSA")
+	ErrKeyAlgorithmMismatch = errors.New("token algorithm does not match the key")
+	ErrKeySecretRequired    = errors.New("HS256 key requires a secret")
+	ErrKeyPublicRequired    = errors.New("key requires a public or private key file")
+	ErrKeyNotEd25519        = errors.New("key is not ed25519")
+	ErrActiveKeyCannotSign  = errors.New("active key must have a secret or a private key")
+	ErrActiveKeyExpired     = errors.New("active key is expired")
+	ErrJWTSecretRequired    = errors.New("JWT_SECRET or --jwt-keys is required")
+	ErrKeySetNotLoaded      = errors.New("jwt keys not loaded")
+)
+
+// Key verifies tokens while it is not expired, only the active key of the set signs new tokens.
+type Key struct {
+	ID         string
+	Algorithm  string
+	ExpiresAt  *time.Time
+	signKey    interface{}
+	verifyKey  interface{}
+	signMethod jwt.SigningMethod
+}
+
+func (k *Key) CanSign() bool {
+	return k.signKey != nil
+}
+
+func (k *Key) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && now.After(*k.ExpiresAt)
+}
+
+type KeySet struct {
+	active *Key
+	keys   map[string]*Key
+}
+
+// KeyConfig is one entry of the keys file, paths are relative to the file.
+type KeyConfig struct {
+	ID             string     `json:"kid"`
+	Algorithm      string     `json:"alg"`
+	Secret         string     `json:"secret,omitempty"`
+	SecretEnv      string     `json:"secret_env,omitempty"`
+	PrivateKeyFile string     `json:"private_key_file,omitempty"`
+	PublicKeyFile  string     `json:"public_key_file,omitempty"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+}
+
+type KeySetConfig struct {
+	Active string      `json:"active"`
+	Keys   []KeyConfig `json:"keys"`
+}
+
+var (
+	keysMu sync.RWMutex
+	keys   *KeySet
+)
+
+// legacyKeyID is the kid of the HS256 key read from JWT_SECRET when no keys file is configured.
+const legacyKeyID = "default"
+
+// LoadKeySetFromEnv signs the tokens with the JWT_SECRET, there is no default secret.
+func LoadKeySetFromEnv() error {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		return ErrJWTSecretRequired
+	}
+
+	key, err := NewHMACKey(legacyKeyID, []byte(secret), nil)
+	if err != nil {
+		return err
+	}
+
+	set, err := NewKeySet(key.ID, key)
+	if err != nil {
+		return err
+	}
+
+	SetKeySet(set)
+	return nil
+}
+
+func NewKeySet(activeID string, keys ...*Key) (*KeySet, error) {
+	set := &KeySet{keys: map[string]*Key{}}
+
+	for _, key := range keys {
+		if key.ID == "" {
+			return nil, ErrKeyIDRequired
+		}
+
+		if _, ok := set.keys[key.ID]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrKeyIDDuplicated, key.ID)
+		}
+
+		set.keys[key.ID] = key
+	}
+
+	active, ok := set.keys[activeID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, activeID)
+	}
+
+	if !active.CanSign() {
+		return nil, ErrActiveKeyCannotSign
+	}
+
+	if active.IsExpired(time.Now()) {
+		return nil, ErrActiveKeyExpired
+	}
+
+	set.active = active
+	return set, nil
+}
+
+// LoadKeySetFromFile reads a json KeySetConfig and replaces the keys used by the service.
+func LoadKeySetFromFile(path string) error {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	config := &KeySetConfig{}
+	if err := json.Unmarshal(file, config); err != nil {
+		return err
+	}
+
+	set, err := config.ToKeySet(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+
+	SetKeySet(set)
+	return nil
+}
+
+func SetKeySet(set *KeySet) {
+	keysMu.Lock()
+	defer keysMu.Unlock()
+	keys = set
+}
+
+func getKeySet() (*KeySet, error) {
+	keysMu.RLock()
+	defer keysMu.RUnlock()
+
+	if keys == nil {
+		return nil, ErrKeySetNotLoaded
+	}
+
+	return keys, nil
+}
+
+func (c *KeySetConfig) ToKeySet(baseDir string) (*KeySet, error) {
+	list := []*Key{}
+
+	for _, keyConfig := range c.Keys {
+		key, err := keyConfig.ToKey(baseDir)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", keyConfig.ID, err)
+		}
+
+		list = append(list, key)
+	}
+
+	return NewKeySet(c.Active, list...)
+}
+
+func (c *KeyConfig) ToKey(baseDir string) (*Key, error) {
+	switch c.Algorithm {
+	case AlgorithmHS256:
+		secret := c.Secret
+		if c.SecretEnv != "" {
+			secret = os.Getenv(c.SecretEnv)
+		}
+
+		return NewHMACKey(c.ID, []byte(secret), c.ExpiresAt)
+	case AlgorithmRS256, AlgorithmEdDSA:
+		privatePEM, err := readKeyFile(baseDir, c.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		publicPEM, err := readKeyFile(baseDir, c.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if c.Algorithm == AlgorithmRS256 {
+			return NewRSAKey(c.ID, privatePEM, publicPEM, c.ExpiresAt)
+		}
+
+		return NewEd25519Key(c.ID, privatePEM, publicPEM, c.ExpiresAt)
+	default:
+		return nil, ErrKeyAlgorithmInvalid
+	}
+}
+
+func readKeyFile(baseDir string, path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(baseDir, path)
+	}
+
+	return os.ReadFile(path)
+}
+
+func NewHMACKey(id string, secret []byte, expiresAt *time.Time) (*Key, error) {
+	if len(secret) == 0 {
+		return nil, ErrKeySecretRequired
+	}
+
+	return &Key{
+		ID:         id,
+		Algorithm:  AlgorithmHS256,
+		ExpiresAt:  expiresAt,
+		signKey:    secret,
+		verifyKey:  secret,
+		signMethod: jwt.SigningMethodHS256,
+	}, nil
+}
+
+// NewRSAKey accepts only the public key for keys kept just to verify old tokens.
+func NewRSAKey(id string, privatePEM []byte, publicPEM []byte, expiresAt *time.Time) (*Key, error) {
+	key := &Key{ID: id, Algorithm: AlgorithmRS256, ExpiresAt: expiresAt, signMethod: jwt.SigningMethodRS256}
+
+	if privatePEM != nil {
+		privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privatePEM)
+		if err != nil {
+			return nil, err
+		}
+
+		key.signKey = privateKey
+		key.verifyKey = &privateKey.PublicKey
+	}
+
+	if publicPEM != nil {
+		publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicPEM)
+		if err != nil {
+			return nil, err
+		}
+
+		key.verifyKey = publicKey
+	}
+
+	if key.verifyKey == nil {
+		return nil, ErrKeyPublicRequired
+	}
+
+	return key, nil
+}
+
+// NewEd25519Key expects PKCS8 private keys and PKIX public keys, as written by openssl genpkey.
+func NewEd25519Key(id string, privatePEM []byte, publicPEM []byte, expiresAt *time.Time) (*Key, error) {
+	key := &Key{ID: id, Algorithm: AlgorithmEdDSA, ExpiresAt: expiresAt, signMethod: SigningMethodEd25519}
+
+	if privatePEM != nil {
+		parsed, err := parsePEM(privatePEM, x509.ParsePKCS8PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKey, ok := parsed.(ed25519.PrivateKey)
+		if !ok {
+			return nil, ErrKeyNotEd25519
+		}
+
+		key.signKey = privateKey
+		key.verifyKey = privateKey.Public().(ed25519.PublicKey)
+	}
+
+	if publicPEM != nil {
+		parsed, err := parsePEM(publicPEM, x509.ParsePKIXPublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		publicKey, ok := parsed.(ed25519.PublicKey)
+		if !ok {
+			return nil, ErrKeyNotEd25519
+		}
+
+		key.verifyKey = publicKey
+	}
+
+	if key.verifyKey == nil {
+		return nil, ErrKeyPublicRequired
+	}
+
+	return key, nil
+}
+
+func parsePEM(data []byte, parse func([]byte) (interface{}, error)) (interface{}, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, jwt.ErrKeyMustBePEMEncoded
+	}
+
+	return parse(block.Bytes)
+}
+
+func sign(claims jwt.MapClaims) (string, error) {
+	set, err := getKeySet()
+	if err != nil {
+		return "", err
+	}
+
+	return set.sign(claims)
+}
+
+func parse(tokenString string) (*jwt.Token, error) {
+	set, err := getKeySet()
+	if err != nil {
+		return nil, err
+	}
+
+	return jwt.Parse(tokenString, set.keyFunc)
+}
+
+func (s *KeySet) sign(claims jwt.MapClaims) (string, error) {
+	token := jwt.NewWithClaims(s.active.signMethod, claims)
+	token.Header["kid"] = s.active.ID
+	return token.SignedString(s.active.signKey)
+}
+
+// keyFunc picks the key by kid and refuses expired keys and algorithm swaps.
+func (s *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, ErrKeyIDRequired
+	}
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+
+	if key.IsExpired(time.Now()) {
+		return nil, ErrKeyExpired
+	}
+
+	if token.Method.Alg() != key.Algorithm {
+		return nil, ErrKeyAlgorithmMismatch
+	}
+
+	return key.verifyKey, nil
+}
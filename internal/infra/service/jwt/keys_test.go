@@ -0,0 +1,145 @@
+package jwtservice
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func writePEM(t *testing.T, dir string, name string, blockType string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, name), data, 0600))
+}
+
+func newTestKeysDir(t *testing.T) string {
+	dir := t.TempDir()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	writePEM(t, dir, "rsa.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(edKey)
+	assert.Nil(t, err)
+	writePEM(t, dir, "ed.pem", "PRIVATE KEY", der)
+
+	publicDer, err := x509.MarshalPKIXPublicKey(edKey.Public())
+	assert.Nil(t, err)
+	writePEM(t, dir, "ed.pub.pem", "PUBLIC KEY", publicDer)
+
+	return dir
+}
+
+func loadTestKeySet(t *testing.T, dir string, config KeySetConfig) {
+	data, err := json.Marshal(config)
+	assert.Nil(t, err)
+
+	path := filepath.Join(dir, "keys.json")
+	assert.Nil(t, os.WriteFile(path, data, 0600))
+	assert.Nil(t, LoadKeySetFromFile(path))
+}
+
+func loadTestSecret(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	assert.Nil(t, LoadKeySetFromEnv())
+}
+
+func TestLoadKeySetFromEnv(t *testing.T) {
+	defer SetKeySet(nil)
+
+	// There is no default secret, the server must not start without one
+	t.Setenv("JWT_SECRET", "")
+	assert.ErrorIs(t, LoadKeySetFromEnv(), ErrJWTSecretRequired)
+
+	_, err := sign(jwt.MapClaims{"sub": "id-token"})
+	assert.ErrorIs(t, err, ErrKeySetNotLoaded)
+
+	loadTestSecret(t)
+	tokenString, err := sign(jwt.MapClaims{"sub": "id-token"})
+	assert.Nil(t, err)
+
+	token, err := ValidateToken(context.Background(), tokenString)
+	assert.Nil(t, err)
+	assert.Equal(t, legacyKeyID, token.Header["kid"])
+}
+
+func TestKeySetRotation(t *testing.T) {
+	defer SetKeySet(nil)
+	dir := newTestKeysDir(t)
+
+	loadTestKeySet(t, dir, KeySetConfig{Active: "rsa-1", Keys: []KeyConfig{
+		{ID: "rsa-1", Algorithm: AlgorithmRS256, PrivateKeyFile: "rsa.pem"},
+	}})
+
+	oldToken, err := sign(jwt.MapClaims{"sub": "id-token"})
+	assert.Nil(t, err)
+
+	// The ed25519 key signs from now on, the rsa key only verifies until it expires
+	expiresAt := time.Now().Add(time.Hour)
+	loadTestKeySet(t, dir, KeySetConfig{Active: "ed-1", Keys: []KeyConfig{
+		{ID: "ed-1", Algorithm: AlgorithmEdDSA, PrivateKeyFile: "ed.pem"},
+		{ID: "rsa-1", Algorithm: AlgorithmRS256, PrivateKeyFile: "rsa.pem", ExpiresAt: &expiresAt},
+		{ID: "hs-1", Algorithm: AlgorithmHS256, Secret: "secret"},
+	}})
+
+	newToken, err := sign(jwt.MapClaims{"sub": "id-token"})
+	assert.Nil(t, err)
+
+	token, err := ValidateToken(context.Background(), newToken)
+	assert.Nil(t, err)
+	assert.Equal(t, "ed-1", token.Header["kid"])
+	assert.Equal(t, AlgorithmEdDSA, token.Method.Alg())
+
+	_, err = ValidateToken(context.Background(), oldToken)
+	assert.Nil(t, err)
+
+	jwks, err := GetJWKS()
+	assert.Nil(t, err)
+	assert.Len(t, jwks.Keys, 2)
+	assert.Equal(t, "OKP", jwks.Keys[0].KeyType)
+	assert.Equal(t, "RSA", jwks.Keys[1].KeyType)
+
+	expiresAt = time.Now().Add(-time.Minute)
+	loadTestKeySet(t, dir, KeySetConfig{Active: "ed-1", Keys: []KeyConfig{
+		{ID: "ed-1", Algorithm: AlgorithmEdDSA, PublicKeyFile: "ed.pub.pem", PrivateKeyFile: "ed.pem"},
+		{ID: "rsa-1", Algorithm: AlgorithmRS256, PrivateKeyFile: "rsa.pem", ExpiresAt: &expiresAt},
+	}})
+
+	_, err = ValidateToken(context.Background(), oldToken)
+	assert.NotNil(t, err)
+	jwks, err = GetJWKS()
+	assert.Nil(t, err)
+	assert.Len(t, jwks.Keys, 1)
+}
+
+func TestKeySetRejectsAlgorithmMismatch(t *testing.T) {
+	key, err := NewHMACKey("hs-1", []byte("secret"), nil)
+	assert.Nil(t, err)
+
+	set, err := NewKeySet("hs-1", key)
+	assert.Nil(t, err)
+
+	// A token claiming another algorithm must not be checked with the key of the kid
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS512, jwt.MapClaims{"sub": "id-token"})
+	forged.Header["kid"] = "hs-1"
+	tokenString, err := forged.SignedString([]byte("secret"))
+	assert.Nil(t, err)
+
+	_, err = jwt.Parse(tokenString, set.keyFunc)
+	assert.NotNil(t, err)
+
+	_, err = NewKeySet("missing", key)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
@@ -0,0 +1,76 @@
+package jwtservice
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+)
+
+const (
+	SubjectEmailVerification = "email-verification"
+	SubjectPasswordReset     = "password-reset"
+	SubjectTwoFactor         = "two-factor"
+)
+
+const (
+	EmailVerificationExpiration = 24 * time.Hour
+	PasswordResetExpiration     = time.Hour
+	InviteExpiration            = 72 * time.Hour
+	TwoFactorExpiration         = 5 * time.Minute
+)
+
+var ErrTokenSubjectInvalid = errors.New("token subject invalid")
+
+// CreateUserToken signs the single purpose tokens sent by email, they carry no session.
+func CreateUserToken(user *companyentity.User, subject string, expiration time.Duration) (string, error) {
+	claims := jwt.MapClaims{
+		"user_id":    user.ID,
+		"user_email": user.Email,
+		"sub":        subject,
+		"exp":        time.Now().Add(expiration).Unix(),
+	}
+
+	if subject == SubjectPasswordReset {
+		claims["pwd"] = user.PasswordFingerprint()
+	}
+
+	return sign(claims)
+}
+
+// ValidateUserToken only accepts tokens created for the subject, so an email token never works as a session.
+func ValidateUserToken(tokenString string, subject string) (*jwt.Token, error) {
+	token, err := parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub, _ := token.Claims.(jwt.MapClaims)["sub"].(string); sub != subject {
+		return nil, ErrTokenSubjectInvalid
+	}
+
+	return token, nil
+}
+
+// CheckUserToken matches the token with the current user, reset tokens also require the same password.
+func CheckUserToken(token *jwt.Token, user *companyentity.User) error {
+	claims := token.Claims.(jwt.MapClaims)
+
+	id, _ := claims["user_id"].(string)
+	if userID, err := uuid.Parse(id); err != nil || userID != user.ID {
+		return companyentity.ErrUserTokenInvalid
+	}
+
+	if claims["sub"] == SubjectPasswordReset && claims["pwd"] != user.PasswordFingerprint() {
+		return companyentity.ErrUserTokenInvalid
+	}
+
+	return nil
+}
+
+func GetEmailFromToken(token *jwt.Token) string {
+	email, _ := token.Claims.(jwt.MapClaims)["user_email"].(string)
+	return email
+}
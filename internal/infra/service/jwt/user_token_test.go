@@ -0,0 +1,34 @@
+package jwtservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+)
+
+func TestPasswordResetTokenSingleUse(t *testing.T) {
+	loadTestSecret(t)
+	defer SetKeySet(nil)
+
+	user := companyentity.NewUser(companyentity.UserCommonAttributes{Email: "user@test.com", Hash: "old-hash"})
+
+	tokenString, err := CreateUserToken(user, SubjectPasswordReset, PasswordResetExpiration)
+	assert.Nil(t, err)
+
+	_, err = ValidateUserToken(tokenString, SubjectEmailVerification)
+	assert.Equal(t, ErrTokenSubjectInvalid, err)
+
+	token, err := ValidateUserToken(tokenString, SubjectPasswordReset)
+	assert.Nil(t, err)
+	assert.Equal(t, user.Email, GetEmailFromToken(token))
+	assert.Nil(t, CheckUserToken(token, user))
+
+	// Once the password changes the same link stops working
+	user.SetPassword("new-hash")
+	assert.Equal(t, companyentity.ErrUserTokenInvalid, CheckUserToken(token, user))
+
+	// Email tokens never carry a session, the auth middleware rejects them
+	_, err = GetSessionIDFromToken(token)
+	assert.Equal(t, ErrSessionNotFoundInToken, err)
+}
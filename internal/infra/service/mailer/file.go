@@ -0,0 +1,38 @@
+package mailerservice
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+var notFileName = regexp.MustCompile("[^a-zA-Z0-9@._-]")
+
+// FileMailer writes each message as an .eml file, it is used to test the flows locally.
+type FileMailer struct {
+	dir string
+}
+
+func NewFileMailer(dir string) (*FileMailer, error) {
+	if dir == "" {
+		dir = "emails"
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &FileMailer{dir: dir}, nil
+}
+
+func (m *FileMailer) Send(ctx context.Context, message *Message) error {
+	if err := message.validate(); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), notFileName.ReplaceAllString(message.To, "_"))
+	return os.WriteFile(filepath.Join(m.dir, name), []byte(message.String("")), 0644)
+}
@@ -0,0 +1,86 @@
+package mailerservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var (
+	ErrMailerInvalid    = errors.New("mailer must be smtp, file or log")
+	ErrRecipientMissing = errors.New("email recipient missing")
+)
+
+const (
+	MailerSMTP = "smtp"
+	MailerFile = "file"
+	MailerLog  = "log"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, message *Message) error
+}
+
+// NewMailer picks the implementation by name, smtp reads its settings from the environment.
+func NewMailer(name string, dir string) (Mailer, error) {
+	switch name {
+	case MailerSMTP:
+		return NewSMTPMailerFromEnv()
+	case MailerFile:
+		return NewFileMailer(dir)
+	case MailerLog, "":
+		return NewLogMailer(), nil
+	default:
+		return nil, ErrMailerInvalid
+	}
+}
+
+func (m *Message) validate() error {
+	if m.To == "" {
+		return ErrRecipientMissing
+	}
+
+	return nil
+}
+
+// String renders the message as a plain text email, with headers and body.
+func (m *Message) String(from string) string {
+	var sb strings.Builder
+
+	if from != "" {
+		fmt.Fprintf(&sb, "From: %s\r\n", from)
+	}
+
+	fmt.Fprintf(&sb, "To: %s\r\n", m.To)
+	fmt.Fprintf(&sb, "Subject: %s\r\n", m.Subject)
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	sb.WriteString("\r\n")
+	sb.WriteString(m.Body)
+
+	return sb.String()
+}
+
+type LogMailer struct{}
+
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(ctx context.Context, message *Message) error {
+	if err := message.validate(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, "email sent:")
+	fmt.Fprintln(os.Stdout, message.String(""))
+	return nil
+}
@@ -0,0 +1,60 @@
+package mailerservice
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/smtp"
+	"os"
+)
+
+var (
+	ErrSMTPHostRequired = errors.New("SMTP_HOST required")
+	ErrSMTPFromRequired = errors.New("SMTP_FROM required")
+)
+
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPMailer(host string, port string, username string, password string, from string) (*SMTPMailer, error) {
+	if host == "" {
+		return nil, ErrSMTPHostRequired
+	}
+
+	if from == "" {
+		return nil, ErrSMTPFromRequired
+	}
+
+	if port == "" {
+		port = "587"
+	}
+
+	m := &SMTPMailer{addr: net.JoinHostPort(host, port), from: from}
+
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return m, nil
+}
+
+func NewSMTPMailerFromEnv() (*SMTPMailer, error) {
+	return NewSMTPMailer(
+		os.Getenv("SMTP_HOST"),
+		os.Getenv("SMTP_PORT"),
+		os.Getenv("SMTP_USERNAME"),
+		os.Getenv("SMTP_PASSWORD"),
+		os.Getenv("SMTP_FROM"),
+	)
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, message *Message) error {
+	if err := message.validate(); err != nil {
+		return err
+	}
+
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{message.To}, []byte(message.String(m.from)))
+}
@@ -0,0 +1,55 @@
+package mailerservice
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Templates builds the messages of the user flows, links point to the frontend at AppURL.
+type Templates struct {
+	AppURL string
+}
+
+func NewTemplates(appURL string) *Templates {
+	return &Templates{AppURL: appURL}
+}
+
+func (t *Templates) link(path string, token string) string {
+	return fmt.Sprintf("%s/%s?token=%s", t.AppURL, path, url.QueryEscape(token))
+}
+
+func (t *Templates) EmailVerification(to string, token string) *Message {
+	return &Message{
+		To:      to,
+		Subject: "Confirme seu email",
+		Body: fmt.Sprintf("Olá,\r\n\r\nConfirme seu email acessando o link abaixo:\r\n%s\r\n\r\nSe você não criou uma conta, ignore este email.\r\n",
+			t.link("verify-email", token)),
+	}
+}
+
+func (t *Templates) PasswordReset(to string, token string) *Message {
+	return &Message{
+		To:      to,
+		Subject: "Redefinição de senha",
+		Body: fmt.Sprintf("Olá,\r\n\r\nPara redefinir sua senha acesse o link abaixo:\r\n%s\r\n\r\nSe você não pediu a redefinição, ignore este email.\r\n",
+			t.link("reset-password", token)),
+	}
+}
+
+func (t *Templates) Invite(to string, companyName string, token string) *Message {
+	return &Message{
+		To:      to,
+		Subject: fmt.Sprintf("Convite para %s", companyName),
+		Body: fmt.Sprintf("Olá,\r\n\r\nVocê foi convidado para acessar %s.\r\nCrie sua senha acessando o link abaixo:\r\n%s\r\n",
+			companyName, t.link("reset-password", token)),
+	}
+}
+
+func (t *Templates) AddedToCompany(to string, companyName string) *Message {
+	return &Message{
+		To:      to,
+		Subject: fmt.Sprintf("Acesso a %s", companyName),
+		Body: fmt.Sprintf("Olá,\r\n\r\nVocê agora tem acesso a %s.\r\nEntre com seu email e senha em:\r\n%s/login\r\n",
+			companyName, t.AppURL),
+	}
+}
@@ -0,0 +1,110 @@
+package ratelimitservice
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var ErrLocked = errors.New("too many failed attempts")
+
+// LockedError carries the time left, handlers use it on the Retry-After header.
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %d seconds", ErrLocked.Error(), int(math.Ceil(e.RetryAfter.Seconds())))
+}
+
+func (e *LockedError) Unwrap() error {
+	return ErrLocked
+}
+
+// Lockout blocks a key after repeated failures, each failure past the threshold doubles the wait.
+type Lockout struct {
+	store     Store
+	threshold int
+	base      time.Duration
+	max       time.Duration
+	window    time.Duration
+}
+
+const (
+	DefaultLockoutThreshold = 5
+	DefaultLockoutBase      = time.Minute
+	DefaultLockoutMax       = time.Hour
+	// DefaultLockoutWindow is the period the failures of a key are counted, it starts on the first failure
+	DefaultLockoutWindow = 24 * time.Hour
+	// AccountLockoutThreshold counts the failures of an account from every address, it is higher so a few
+	// addresses can't lock the owner out
+	AccountLockoutThreshold = 20
+)
+
+func NewLockout(store Store, threshold int, base time.Duration, max time.Duration, window time.Duration) *Lockout {
+	return &Lockout{
+		store:     store,
+		threshold: threshold,
+		base:      base,
+		max:       max,
+		window:    window,
+	}
+}
+
+func NewDefaultLockout(store Store) *Lockout {
+	return NewLockout(store, DefaultLockoutThreshold, DefaultLockoutBase, DefaultLockoutMax, DefaultLockoutWindow)
+}
+
+// NewAccountLockout locks the account itself, whatever the address the failures come from.
+func NewAccountLockout(store Store) *Lockout {
+	return NewLockout(store, AccountLockoutThreshold, DefaultLockoutBase, DefaultLockoutMax, DefaultLockoutWindow)
+}
+
+func (l *Lockout) key(key string) string {
+	return "lockout:" + key
+}
+
+// Check returns a LockedError while the key is locked.
+func (l *Lockout) Check(key string, now time.Time) error {
+	e := l.store.Get(l.key(key), now)
+
+	if now.Before(e.LockedUntil) {
+		return &LockedError{RetryAfter: e.LockedUntil.Sub(now)}
+	}
+
+	return nil
+}
+
+// Fail counts a failure and locks the key once the threshold is reached.
+func (l *Lockout) Fail(key string, now time.Time) {
+	e := l.store.Increment(l.key(key), l.window, now)
+
+	if e.Count < l.threshold {
+		return
+	}
+
+	l.store.Lock(l.key(key), now.Add(l.Duration(e.Count)))
+}
+
+func (l *Lockout) Reset(key string) {
+	l.store.Reset(l.key(key))
+}
+
+// Duration is the lock applied after the given number of failures.
+func (l *Lockout) Duration(failures int) time.Duration {
+	if failures < l.threshold {
+		return 0
+	}
+
+	d := l.base
+	for i := l.threshold; i < failures && d < l.max; i++ {
+		d *= 2
+	}
+
+	if d > l.max {
+		return l.max
+	}
+
+	return d
+}
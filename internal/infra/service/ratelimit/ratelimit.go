@@ -0,0 +1,80 @@
+package ratelimitservice
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+// Limiter allows a number of requests per key inside a fixed time window.
+type Limiter struct {
+	store  Store
+	limit  int
+	period time.Duration
+}
+
+func NewLimiter(limit int, period time.Duration) *Limiter {
+	return NewLimiterWithStore(NewMemoryStore(), limit, period)
+}
+
+func NewLimiterWithStore(store Store, limit int, period time.Duration) *Limiter {
+	return &Limiter{
+		store:  store,
+		limit:  limit,
+		period: period,
+	}
+}
+
+func (l *Limiter) Allow(key string) bool {
+	_, ok := l.AllowAt(key, time.Now())
+	return ok
+}
+
+// AllowAt also returns how long the client must wait when the limit is reached.
+func (l *Limiter) AllowAt(key string, now time.Time) (time.Duration, bool) {
+	e := l.store.Increment(key, l.period, now)
+
+	if e.Count > l.limit {
+		return e.ResetAt.Sub(now), false
+	}
+
+	return 0, true
+}
+
+// Middleware limits the requests by client ip.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if retryAfter, ok := l.AllowAt(ClientIP(r), time.Now()); !ok {
+			WriteTooManyRequests(w, r, retryAfter, "too many requests")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// WriteTooManyRequests answers 429 with the Retry-After header in seconds.
+func WriteTooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration, message string) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	jsonpkg.ResponseJson(w, r, http.StatusTooManyRequests, jsonpkg.Error{Message: fmt.Sprintf("%s, retry after %d seconds", message, seconds)})
+}
+
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
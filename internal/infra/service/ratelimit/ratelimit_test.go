@@ -0,0 +1,65 @@
+package ratelimitservice
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockoutProgressive(t *testing.T) {
+	lockout := NewLockout(NewMemoryStore(), 3, time.Minute, 10*time.Minute, time.Hour)
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		lockout.Fail("login:user@test.com", now)
+	}
+	assert.Nil(t, lockout.Check("login:user@test.com", now))
+
+	lockout.Fail("login:user@test.com", now)
+	err := lockout.Check("login:user@test.com", now)
+
+	lockedErr := &LockedError{}
+	assert.True(t, errors.As(err, &lockedErr))
+	assert.Equal(t, time.Minute, lockedErr.RetryAfter)
+	assert.ErrorIs(t, err, ErrLocked)
+
+	assert.Equal(t, 2*time.Minute, lockout.Duration(4))
+	assert.Equal(t, 8*time.Minute, lockout.Duration(6))
+	assert.Equal(t, 10*time.Minute, lockout.Duration(20))
+
+	lockout.Reset("login:user@test.com")
+	assert.Nil(t, lockout.Check("login:user@test.com", now))
+}
+
+func TestRouteLimiterByAccount(t *testing.T) {
+	rules := []Rule{
+		{Method: http.MethodPost, Path: "/user/login", Limit: 2, Period: time.Minute, By: KeyByAccount},
+	}
+
+	handler := NewRouteLimiter(NewMemoryStore(), rules).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The body must still be readable by the handler
+		assert.Equal(t, "user@test.com", AccountKey(r))
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	login := func(email string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/user/login", strings.NewReader(`{"email":"`+email+`"}`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, login("user@test.com").Code)
+	assert.Equal(t, http.StatusOK, login("USER@test.com").Code)
+
+	w := login("user@test.com")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "60", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), `"message"`)
+}
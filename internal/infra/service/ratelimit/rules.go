@@ -0,0 +1,205 @@
+package ratelimitservice
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+)
+
+const (
+	KeyByIP      = "ip"
+	KeyByAccount = "account"
+)
+
+var (
+	ErrRuleKeyInvalid    = errors.New("rule key must be ip or account")
+	ErrRuleLimitInvalid  = errors.New("rule limit must be greater than zero")
+	ErrRulePeriodInvalid = errors.New("rule period must be a duration greater than zero")
+	ErrRulePathRequired  = errors.New("rule path required")
+)
+
+// Rule limits the requests of a route, a path ending with * matches every route with the prefix.
+type Rule struct {
+	Method string        `json:"method"`
+	Path   string        `json:"path"`
+	Limit  int           `json:"limit"`
+	Period time.Duration `json:"-"`
+	By     string        `json:"by"`
+}
+
+func (r *Rule) UnmarshalJSON(data []byte) error {
+	type alias Rule
+	raw := struct {
+		*alias
+		Period string `json:"period"`
+	}{alias: (*alias)(r)}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	period, err := time.ParseDuration(raw.Period)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrRulePeriodInvalid, raw.Period)
+	}
+
+	r.Period = period
+	return nil
+}
+
+func (r *Rule) validate() error {
+	if r.Path == "" {
+		return ErrRulePathRequired
+	}
+
+	if r.Limit <= 0 {
+		return ErrRuleLimitInvalid
+	}
+
+	if r.Period <= 0 {
+		return ErrRulePeriodInvalid
+	}
+
+	if r.By != KeyByIP && r.By != KeyByAccount {
+		return ErrRuleKeyInvalid
+	}
+
+	return nil
+}
+
+func (r *Rule) matches(req *http.Request) bool {
+	if r.Method != "" && !strings.EqualFold(r.Method, req.Method) {
+		return false
+	}
+
+	if prefix, ok := strings.CutSuffix(r.Path, "*"); ok {
+		return strings.HasPrefix(req.URL.Path, prefix)
+	}
+
+	return req.URL.Path == r.Path
+}
+
+// DefaultRules protect the public routes, login and the company creation that calls the CNPJ api.
+func DefaultRules() []Rule {
+	return []Rule{
+		{Method: http.MethodPost, Path: "/user/login", Limit: 20, Period: time.Minute, By: KeyByIP},
+		{Method: http.MethodPost, Path: "/user/login", Limit: 10, Period: time.Minute, By: KeyByAccount},
+		{Method: http.MethodPost, Path: "/user/login/two-factor", Limit: 10, Period: time.Minute, By: KeyByIP},
+		{Method: http.MethodPost, Path: "/user/refresh", Limit: 30, Period: time.Minute, By: KeyByIP},
+		{Method: http.MethodPost, Path: "/user/forgot-password", Limit: 5, Period: 15 * time.Minute, By: KeyByIP},
+		{Method: http.MethodPost, Path: "/user/forgot-password", Limit: 3, Period: time.Hour, By: KeyByAccount},
+		{Method: http.MethodPost, Path: "/user/reset-password", Limit: 10, Period: 15 * time.Minute, By: KeyByIP},
+		{Method: http.MethodPost, Path: "/user/verify-email", Limit: 10, Period: 15 * time.Minute, By: KeyByIP},
+		{Method: http.MethodPost, Path: "/company/new", Limit: 5, Period: time.Hour, By: KeyByIP},
+	}
+}
+
+type RulesConfig struct {
+	Rules []Rule `json:"rules"`
+}
+
+// LoadRulesFromFile reads a json RulesConfig, the periods use the go duration format like "1m" or "1h30m".
+func LoadRulesFromFile(path string) ([]Rule, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &RulesConfig{}
+	if err := json.Unmarshal(file, config); err != nil {
+		return nil, err
+	}
+
+	for i := range config.Rules {
+		if err := config.Rules[i].validate(); err != nil {
+			return nil, fmt.Errorf("rule %s %s: %w", config.Rules[i].Method, config.Rules[i].Path, err)
+		}
+	}
+
+	return config.Rules, nil
+}
+
+// RouteLimiter applies every rule matching the request, the longest wait is sent on Retry-After.
+type RouteLimiter struct {
+	store Store
+	rules []Rule
+}
+
+func NewRouteLimiter(store Store, rules []Rule) *RouteLimiter {
+	return &RouteLimiter{store: store, rules: rules}
+}
+
+func (l *RouteLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if retryAfter, ok := l.allow(r, time.Now()); !ok {
+			WriteTooManyRequests(w, r, retryAfter, "too many requests")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (l *RouteLimiter) allow(r *http.Request, now time.Time) (time.Duration, bool) {
+	var retryAfter time.Duration
+	allowed := true
+
+	for i, rule := range l.rules {
+		if !rule.matches(r) {
+			continue
+		}
+
+		key := ClientIP(r)
+		if rule.By == KeyByAccount {
+			if key = AccountKey(r); key == "" {
+				continue
+			}
+		}
+
+		e := l.store.Increment(fmt.Sprintf("rule:%d:%s", i, key), rule.Period, now)
+		if e.Count > rule.Limit {
+			allowed = false
+			if wait := e.ResetAt.Sub(now); wait > retryAfter {
+				retryAfter = wait
+			}
+		}
+	}
+
+	return retryAfter, allowed
+}
+
+// AccountKey is the logged user or, on public routes like the login, the email of the body.
+func AccountKey(r *http.Request) string {
+	if user, ok := r.Context().Value(companyentity.UserValue("user")).(companyentity.User); ok {
+		return user.ID.String()
+	}
+
+	if r.Body == nil || !strings.Contains(r.Header.Get("Content-Type"), "application/json") {
+		return ""
+	}
+
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewBuffer(body))
+	if err != nil {
+		return ""
+	}
+
+	account := struct {
+		Email string `json:"email"`
+	}{}
+
+	if err := json.Unmarshal(body, &account); err != nil {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimSpace(account.Email))
+}
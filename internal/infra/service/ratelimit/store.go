@@ -0,0 +1,102 @@
+package ratelimitservice
+
+import (
+	"sync"
+	"time"
+)
+
+// Entry is the state of a key, the counter of the current window and the lockout.
+type Entry struct {
+	Count       int
+	ResetAt     time.Time
+	LockedUntil time.Time
+}
+
+// Store keeps the counters, the memory store works for one instance, a shared store is needed to scale out.
+type Store interface {
+	Get(key string, now time.Time) Entry
+	Increment(key string, period time.Duration, now time.Time) Entry
+	Lock(key string, until time.Time)
+	Reset(key string)
+}
+
+const cleanupInterval = time.Minute
+
+type MemoryStore struct {
+	mu          sync.Mutex
+	entries     map[string]*Entry
+	lastCleanup time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: map[string]*Entry{}}
+}
+
+func (s *MemoryStore) Get(key string, now time.Time) Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeExpired(now)
+
+	if e, ok := s.entries[key]; ok {
+		return *e
+	}
+
+	return Entry{}
+}
+
+func (s *MemoryStore) Increment(key string, period time.Duration, now time.Time) Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeExpired(now)
+
+	e, ok := s.entries[key]
+	if !ok {
+		e = &Entry{}
+		s.entries[key] = e
+	}
+
+	// A new window starts when the previous one ended, the lockout is kept
+	if !now.Before(e.ResetAt) {
+		e.Count = 0
+		e.ResetAt = now.Add(period)
+	}
+
+	e.Count++
+	return *e
+}
+
+func (s *MemoryStore) Lock(key string, until time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		e = &Entry{}
+		s.entries[key] = e
+	}
+
+	e.LockedUntil = until
+}
+
+func (s *MemoryStore) Reset(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+}
+
+func (s *MemoryStore) removeExpired(now time.Time) {
+	if now.Sub(s.lastCleanup) < cleanupInterval {
+		return
+	}
+
+	s.lastCleanup = now
+
+	for key, e := range s.entries {
+		if !now.Before(e.ResetAt) && !now.Before(e.LockedUntil) {
+			delete(s.entries, key)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package totpservice
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateCodeRFC6238(t *testing.T) {
+	// Test vectors of RFC 6238 appendix B for SHA1, truncated to 6 digits
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+	cases := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, expected := range cases {
+		code, err := GenerateCode(secret, Step(time.Unix(unix, 0)))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	assert.Nil(t, err)
+
+	now := time.Now()
+	previous, err := GenerateCode(secret, Step(now)-1)
+	assert.Nil(t, err)
+
+	step, ok := Validate(secret, previous, now)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now)-1, step)
+
+	old, err := GenerateCode(secret, Step(now)-3)
+	assert.Nil(t, err)
+
+	_, ok = Validate(secret, old, now)
+	assert.False(t, ok)
+
+	assert.Contains(t, URI("Sales", "user@test.com", secret), "otpauth://totp/Sales:user@test.com?")
+}
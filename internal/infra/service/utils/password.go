@@ -1,6 +1,10 @@
 package utils
 
-import "regexp"
+import (
+	"crypto/rand"
+	"math/big"
+	"regexp"
+)
 
 func IsValidPassword(password string) bool {
 	if len(password) < 8 {
@@ -49,3 +53,45 @@ func containsSymbol(s string) bool {
 	match, _ := regexp.MatchString(symbolRegex, s)
 	return match
 }
+
+const (
+	passwordUppercase = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	passwordLowercase = "abcdefghijkmnopqrstuvwxyz"
+	passwordDigits    = "23456789"
+	passwordSymbols   = "!@#$%&*?"
+	generatedLength   = 16
+)
+
+// GeneratePassword returns a random password that always passes IsValidPassword.
+func GeneratePassword() (string, error) {
+	all := passwordUppercase + passwordLowercase + passwordDigits + passwordSymbols
+	sets := []string{passwordUppercase, passwordLowercase, passwordDigits, passwordSymbols}
+
+	password := make([]byte, generatedLength)
+	for i := range password {
+		set := all
+		if i < len(sets) {
+			set = sets[i]
+		}
+
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(set))))
+		if err != nil {
+			return "", err
+		}
+
+		password[i] = set[n.Int64()]
+	}
+
+	// Shuffle so the required characters are not always at the start
+	for i := len(password) - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+
+		j := n.Int64()
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
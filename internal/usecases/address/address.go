@@ -0,0 +1,63 @@
+package addressusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	cepservice "github.com/willjrcom/sales-backend-go/internal/infra/service/cep"
+)
+
+type Service struct {
+	rc addressentity.CepRepository
+	p  cepservice.Provider
+}
+
+func NewService(rc addressentity.CepRepository, p cepservice.Provider) *Service {
+	return &Service{rc: rc, p: p}
+}
+
+// GetAddressByCep answers from the cache, the provider is only asked for unknown or expired ceps.
+func (s *Service) GetAddressByCep(ctx context.Context, cep string) (*addressentity.CepAddress, error) {
+	cep, err := addressentity.NormalizeCep(cep)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cached, err := s.rc.GetCepAddress(ctx, cep)
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	if cached != nil && err == nil && !cached.IsExpired() {
+		return cached, nil
+	}
+
+	data, err := s.p.GetCep(ctx, cep)
+
+	if err != nil {
+		// An expired cep is better than nothing when the provider is offline
+		if cached != nil && !errors.Is(err, cepservice.ErrCepNotFound) {
+			return cached, nil
+		}
+
+		return nil, err
+	}
+
+	cepAddress := addressentity.NewCepAddress(addressentity.CepAddressCommonAttributes{
+		Cep:          cep,
+		Street:       data.Street,
+		Neighborhood: data.Neighborhood,
+		City:         data.City,
+		State:        data.State,
+	})
+
+	if err := s.rc.SaveCepAddress(ctx, cepAddress); err != nil {
+		return nil, err
+	}
+
+	return cepAddress, nil
+}
@@ -0,0 +1,133 @@
+package clientusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	addressdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/address"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+func (s *Service) RegisterClientAddress(ctx context.Context, dtoId *entitydto.IdRequest, dto *addressdto.RegisterAddressInput) (uuid.UUID, error) {
+	address, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.fillFromCep(ctx, address); err != nil {
+		return uuid.Nil, err
+	}
+
+	client, err := s.rclient.GetClientById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := client.AddAddress(address); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.rclient.SaveClientAddresses(ctx, client); err != nil {
+		return uuid.Nil, err
+	}
+
+	return address.ID, nil
+}
+
+func (s *Service) UpdateClientAddress(ctx context.Context, dtoId *entitydto.IdRequest, dtoAddressId *entitydto.IdRequest, dto *addressdto.UpdateAddressInput) error {
+	client, err := s.rclient.GetClientById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	address, err := client.GetAddress(dtoAddressId.ID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(address); err != nil {
+		return err
+	}
+
+	if dto.Cep != nil {
+		if err := s.fillFromCep(ctx, address); err != nil {
+			return err
+		}
+	}
+
+	if dto.IsDefault != nil && *dto.IsDefault {
+		if err := client.SetDefaultAddress(address.ID); err != nil {
+			return err
+		}
+	}
+
+	return s.rclient.SaveClientAddresses(ctx, client)
+}
+
+func (s *Service) SetDefaultClientAddress(ctx context.Context, dtoId *entitydto.IdRequest, dtoAddressId *entitydto.IdRequest) error {
+	client, err := s.rclient.GetClientById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := client.SetDefaultAddress(dtoAddressId.ID); err != nil {
+		return err
+	}
+
+	return s.rclient.SaveClientAddresses(ctx, client)
+}
+
+func (s *Service) DeleteClientAddress(ctx context.Context, dtoId *entitydto.IdRequest, dtoAddressId *entitydto.IdRequest) error {
+	client, err := s.rclient.GetClientById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := client.RemoveAddress(dtoAddressId.ID); err != nil {
+		return err
+	}
+
+	return s.rclient.SaveClientAddresses(ctx, client)
+}
+
+func (s *Service) GetClientAddresses(ctx context.Context, dtoId *entitydto.IdRequest) ([]addressdto.AddressOutput, error) {
+	client, err := s.rclient.GetClientById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]addressdto.AddressOutput, len(client.Addresses))
+	for i := range client.Addresses {
+		dtos[i].FromModel(&client.Addresses[i])
+	}
+
+	return dtos, nil
+}
+
+// fillFromCep completes the missing fields with the cep, the lookup only fails the request when a field is missing.
+func (s *Service) fillFromCep(ctx context.Context, address *addressentity.Address) error {
+	if address.Cep == "" {
+		return nil
+	}
+
+	cepAddress, err := s.as.GetAddressByCep(ctx, address.Cep)
+
+	if err != nil {
+		if address.Validate() == nil {
+			return nil
+		}
+
+		return err
+	}
+
+	address.FillFromCep(cepAddress)
+	return nil
+}
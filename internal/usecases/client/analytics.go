@@ -0,0 +1,92 @@
+package clientusecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	clientanalyticsentity "github.com/willjrcom/sales-backend-go/internal/domain/client_analytics"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	clientdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/client"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+var (
+	ErrSegmentInvalid = errors.New("segment is invalid")
+)
+
+func (s *Service) GetClientHistory(ctx context.Context, dto *entitydto.IdRequest) (*clientdto.ClientHistoryOutput, error) {
+	client, err := s.rclient.GetClientById(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.rorder.GetOrdersByClientId(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	summary := clientanalyticsentity.NewClientSummary(client.ID, orders)
+
+	output := &clientdto.ClientHistoryOutput{}
+	output.FromModel(client, summary, orders)
+	return output, nil
+}
+
+// GetClientsRFM scores every client with purchases, filtered by segment when given.
+func (s *Service) GetClientsRFM(ctx context.Context, segment clientanalyticsentity.Segment) ([]clientdto.ClientSegmentOutput, error) {
+	if segment != "" && !segment.IsValid() {
+		return nil, ErrSegmentInvalid
+	}
+
+	clients, err := s.rclient.GetAllClients(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.rorder.GetAllClientOrders(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ordersByClient := map[uuid.UUID][]orderentity.Order{}
+	for _, order := range orders {
+		if clientID := order.GetClientID(); clientID != nil {
+			ordersByClient[*clientID] = append(ordersByClient[*clientID], order)
+		}
+	}
+
+	summaries := make([]clientanalyticsentity.ClientSummary, len(clients))
+	for i, client := range clients {
+		summaries[i] = *clientanalyticsentity.NewClientSummary(client.ID, ordersByClient[client.ID])
+	}
+
+	rfms := clientanalyticsentity.ScoreClients(summaries, time.Now())
+
+	clientsByID := map[uuid.UUID]int{}
+	for i, client := range clients {
+		clientsByID[client.ID] = i
+	}
+
+	outputs := []clientdto.ClientSegmentOutput{}
+	for _, rfm := range rfms {
+		if segment != "" && rfm.Segment != segment {
+			continue
+		}
+
+		output := clientdto.ClientSegmentOutput{}
+		output.FromModel(&clients[clientsByID[rfm.ClientID]], rfm)
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
+
+func (s *Service) GetAllSegments(ctx context.Context) []clientanalyticsentity.Segment {
+	return clientanalyticsentity.GetAllSegments()
+}
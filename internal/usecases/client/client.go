@@ -2,24 +2,36 @@ package clientusecases
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	creditaccountentity "github.com/willjrcom/sales-backend-go/internal/domain/credit_account"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
 	clientdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/client"
 	contactdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/contact"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	keysdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/keys"
+	addressusecases "github.com/willjrcom/sales-backend-go/internal/usecases/address"
 )
 
 type Service struct {
-	rclient  cliententity.Repository
-	rcontact personentity.ContactRepository
+	rclient      cliententity.Repository
+	rcontact     personentity.ContactRepository
+	rorder       orderentity.OrderRepository
+	rloyalty     loyaltyentity.LoyaltyTransactionRepository
+	rcredit      creditaccountentity.Repository
+	rreservation reservationentity.Repository
+	as           *addressusecases.Service
 }
 
-func NewService(rcliente cliententity.Repository, rcontact personentity.ContactRepository) *Service {
-	return &Service{rclient: rcliente, rcontact: rcontact}
+func NewService(rcliente cliententity.Repository, rcontact personentity.ContactRepository, rorder orderentity.OrderRepository, rloyalty loyaltyentity.LoyaltyTransactionRepository, rcredit creditaccountentity.Repository, rreservation reservationentity.Repository, as *addressusecases.Service) *Service {
+	return &Service{rclient: rcliente, rcontact: rcontact, rorder: rorder, rloyalty: rloyalty, rcredit: rcredit, rreservation: rreservation, as: as}
 }
 
 func (s *Service) RegisterClient(ctx context.Context, dto *clientdto.RegisterClientInput) (uuid.UUID, error) {
@@ -72,6 +84,18 @@ func (s *Service) GetClientById(ctx context.Context, dto *entitydto.IdRequest) (
 	} else {
 		dto := &clientdto.ClientOutput{}
 		dto.FromModel(client)
+
+		account, err := s.rcredit.GetCreditAccountByClientId(ctx, client.ID.String())
+
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		if err == nil {
+			dto.Credit = &clientdto.ClientCreditOutput{}
+			dto.Credit.FromModel(account, time.Now())
+		}
+
 		return dto, nil
 	}
 }
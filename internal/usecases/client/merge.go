@@ -0,0 +1,54 @@
+package clientusecases
+
+import (
+	"context"
+
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	clientdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/client"
+)
+
+func (s *Service) FindDuplicateClients(ctx context.Context) ([]cliententity.DuplicateCandidate, error) {
+	clients, err := s.rclient.GetAllClients(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cliententity.FindDuplicates(clients), nil
+}
+
+func (s *Service) MergeClients(ctx context.Context, dto *clientdto.MergeClientsInput) (*cliententity.ClientMerge, error) {
+	survivorID, duplicateID, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	survivor, err := s.rclient.GetClientById(ctx, survivorID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	duplicate, err := s.rclient.GetClientById(ctx, duplicateID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	merge, err := survivor.Merge(duplicate, getUserID(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rclient.MergeClients(ctx, survivor, duplicate, merge); err != nil {
+		return nil, err
+	}
+
+	return merge, nil
+}
+
+func (s *Service) GetAllClientMerges(ctx context.Context) ([]cliententity.ClientMerge, error) {
+	return s.rclient.GetAllClientMerges(ctx)
+}
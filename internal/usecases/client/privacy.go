@@ -0,0 +1,103 @@
+package clientusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	clientdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/client"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+// ExportClientData gathers everything held about the client and logs the request.
+func (s *Service) ExportClientData(ctx context.Context, dto *entitydto.IdRequest) (*clientdto.ClientDataExport, error) {
+	client, err := s.rclient.GetClientById(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.rorder.GetOrdersByClientId(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.rloyalty.GetLoyaltyTransactionsByClientId(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	merges, err := s.rclient.GetAllClientMerges(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	reservations, err := s.rreservation.GetReservationsByClientId(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.rcredit.GetCreditAccountByClientId(ctx, dto.ID.String())
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	request := cliententity.NewPrivacyRequest(client.ID, cliententity.PrivacyRequestTypeExport, getUserID(ctx), "")
+
+	if err := s.rclient.CreatePrivacyRequest(ctx, request); err != nil {
+		return nil, err
+	}
+
+	export := &clientdto.ClientDataExport{}
+	export.FromModel(client, orders, transactions, merges, reservations, account)
+	return export, nil
+}
+
+// EraseClientData anonymizes the client and the clients merged into it, the orders and payments are kept for accounting.
+func (s *Service) EraseClientData(ctx context.Context, dtoId *entitydto.IdRequest, dto *clientdto.EraseClientInput) error {
+	client, err := s.rclient.GetClientById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := client.Anonymize(); err != nil {
+		return err
+	}
+
+	merged, err := s.rclient.GetMergedClients(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	for i := range merged {
+		if err := merged[i].Anonymize(); err != nil && !errors.Is(err, cliententity.ErrClientAlreadyAnonymized) {
+			return err
+		}
+	}
+
+	request := cliententity.NewPrivacyRequest(client.ID, cliententity.PrivacyRequestTypeErasure, getUserID(ctx), dto.Observation)
+
+	return s.rclient.AnonymizeClient(ctx, client, merged, request)
+}
+
+func (s *Service) GetAllPrivacyRequests(ctx context.Context) ([]cliententity.PrivacyRequest, error) {
+	return s.rclient.GetAllPrivacyRequests(ctx)
+}
+
+func getUserID(ctx context.Context) *uuid.UUID {
+	if user, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User); ok {
+		return &user.ID
+	}
+
+	return nil
+}
@@ -2,19 +2,25 @@ package companyusecases
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
 	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	addressdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/address"
 	companydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/company"
-	userdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/user"
 	"github.com/willjrcom/sales-backend-go/internal/infra/service/cnpj"
 	schemaservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
 	userusecases "github.com/willjrcom/sales-backend-go/internal/usecases/user"
 )
 
+var (
+	ErrCompanyWithoutAddress = errors.New("company without address")
+)
+
 type Service struct {
 	r  companyentity.CompanyRepository
 	a  addressentity.Repository
@@ -59,14 +65,10 @@ func (s *Service) NewCompany(ctx context.Context, dto *companydto.CompanyInput)
 
 	ctx = context.WithValue(ctx, schemaentity.Schema("schema"), company.SchemaName)
 
-	userCommonAttributes := companyentity.UserCommonAttributes{
-		Email:    email,
-		Password: "12345",
-	}
+	user := companyentity.NewUser(companyentity.UserCommonAttributes{Email: email})
 
-	userInput := &companydto.UserInput{UserCommonAttributes: userCommonAttributes}
-
-	if err = s.AddUserToCompany(ctx, userInput); err != nil {
+	// The creator has no role yet, so the checks of AddUserToCompany don't apply
+	if err = s.addUserToCompany(ctx, user, companyentity.RoleOwner); err != nil {
 		return uuid.Nil, nil, err
 	}
 
@@ -83,39 +85,68 @@ func (s *Service) GetCompany(ctx context.Context) (*companydto.CompanyOutput, er
 	}
 }
 
+func (s *Service) UpdateCompanyAddress(ctx context.Context, dto *addressdto.UpdateAddressInput) error {
+	company, err := s.r.GetCompany(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if company.Address == nil {
+		return ErrCompanyWithoutAddress
+	}
+
+	if err := dto.UpdateModel(company.Address); err != nil {
+		return err
+	}
+
+	return s.a.UpdateAddress(ctx, company.Address)
+}
+
 func (s *Service) AddUserToCompany(ctx context.Context, dto *companydto.UserInput) error {
-	user, err := dto.ToModel()
+	user, role, err := dto.ToModel()
 
 	if err != nil {
 		return err
 	}
 
-	userID, _ := s.u.GetIDByEmail(ctx, user.Email)
+	if err := s.checkCanManage(ctx, role); err != nil {
+		return err
+	}
 
-	if userID == uuid.Nil {
-		userCommonAttributes := &companyentity.UserCommonAttributes{
-			Email: user.Email,
-		}
+	return s.addUserToCompany(ctx, user, role)
+}
 
-		createUserInput := &userdto.CreateUserInput{
-			UserCommonAttributes: *userCommonAttributes,
-			GeneratePassword:     true,
-		}
+func (s *Service) addUserToCompany(ctx context.Context, user *companyentity.User, role companyentity.UserRole) error {
+	company, err := s.r.GetCompany(ctx)
+
+	if err != nil {
+		return err
+	}
 
-		if userID, err = s.us.CreateUser(ctx, createUserInput); err != nil {
+	userID, _ := s.u.GetIDByEmail(ctx, user.Email)
+	isNewUser := userID == uuid.Nil
+
+	if isNewUser {
+		// New users receive an invite to set their own password
+		if userID, err = s.us.InviteUser(ctx, user.Email, company.TradeName); err != nil {
 			return err
 		}
 	}
 
-	if err := s.r.AddUserToPublicCompany(ctx, userID); err != nil {
+	if err := s.r.AddUserToPublicCompany(ctx, userID, role); err != nil {
 		return err
 	}
 
+	if !isNewUser {
+		return s.us.NotifyAddedToCompany(ctx, user.Email, company.TradeName)
+	}
+
 	return nil
 }
 
 func (s *Service) RemoveUserFromCompany(ctx context.Context, dto *companydto.UserInput) error {
-	user, err := dto.ToModel()
+	user, _, err := dto.ToModel()
 
 	if err != nil {
 		return err
@@ -127,9 +158,81 @@ func (s *Service) RemoveUserFromCompany(ctx context.Context, dto *companydto.Use
 		return err
 	}
 
+	role, err := s.r.GetUserRole(ctx, userID)
+
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkCanManage(ctx, role); err != nil {
+		return err
+	}
+
+	if role == companyentity.RoleOwner {
+		owners, err := s.r.CountUsersByRole(ctx, companyentity.RoleOwner)
+
+		if err != nil {
+			return err
+		}
+
+		if owners <= 1 {
+			return companyentity.ErrLastOwner
+		}
+	}
+
 	if err := s.r.RemoveUserFromPublicCompany(ctx, userID); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// checkCanManage checks that the logged user may add or remove users of the role given.
+func (s *Service) checkCanManage(ctx context.Context, role companyentity.UserRole) error {
+	userLogged, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	if !ok {
+		return userusecases.ErrUserNotFoundInContext
+	}
+
+	loggedRole, err := s.r.GetUserRole(ctx, userLogged.ID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return companyentity.ErrRoleNotAllowed
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !loggedRole.CanManage(role) {
+		return companyentity.ErrRoleNotAllowed
+	}
+
+	return nil
+}
+
+// UpdateTwoFactorPolicy sets the roles that must use the second factor to access the company.
+func (s *Service) UpdateTwoFactorPolicy(ctx context.Context, dto *companydto.TwoFactorPolicyInput) error {
+	userLogged := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	role, err := s.r.GetUserRole(ctx, userLogged.ID)
+	if err != nil {
+		return err
+	}
+
+	if role != companyentity.RoleOwner {
+		return companyentity.ErrOnlyOwnerCanChangePolicy
+	}
+
+	company, err := s.r.GetCompany(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(company); err != nil {
+		return err
+	}
+
+	return s.r.UpdateTwoFactorPolicy(ctx, company)
+}
@@ -0,0 +1,39 @@
+package companyusecases
+
+import (
+	"context"
+	"time"
+
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	companydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/company"
+)
+
+func (s *Service) GetCompanySettings(ctx context.Context) (*companyentity.CompanySettings, error) {
+	return s.r.GetCompanySettings(ctx)
+}
+
+// UpdateCompanySettings changes only the fields sent, the others keep the current values.
+func (s *Service) UpdateCompanySettings(ctx context.Context, dto *companydto.CompanySettingsInput) error {
+	userLogged := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	role, err := s.r.GetUserRole(ctx, userLogged.ID)
+	if err != nil {
+		return err
+	}
+
+	if role != companyentity.RoleOwner && role != companyentity.RoleManager {
+		return companyentity.ErrOnlyManagerCanChangeSettings
+	}
+
+	settings, err := s.r.GetCompanySettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(settings); err != nil {
+		return err
+	}
+
+	settings.UpdatedAt = time.Now()
+	return s.r.UpdateCompanySettings(ctx, settings)
+}
@@ -0,0 +1,92 @@
+package companyusecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	companydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/company"
+)
+
+type companyRepository struct {
+	companyentity.CompanyRepository
+	roles map[uuid.UUID]companyentity.UserRole
+}
+
+func (r *companyRepository) GetUserRole(_ context.Context, userID uuid.UUID) (companyentity.UserRole, error) {
+	return r.roles[userID], nil
+}
+
+func (r *companyRepository) CountUsersByRole(_ context.Context, role companyentity.UserRole) (int, error) {
+	count := 0
+	for _, userRole := range r.roles {
+		if userRole == role {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *companyRepository) RemoveUserFromPublicCompany(_ context.Context, userID uuid.UUID) error {
+	delete(r.roles, userID)
+	return nil
+}
+
+type userRepository struct {
+	companyentity.UserRepository
+	ids map[string]uuid.UUID
+}
+
+func (r *userRepository) GetIDByEmail(_ context.Context, email string) (uuid.UUID, error) {
+	return r.ids[email], nil
+}
+
+func newContext(userID uuid.UUID) context.Context {
+	user := companyentity.User{}
+	user.ID = userID
+	return context.WithValue(context.Background(), companyentity.UserValue("user"), user)
+}
+
+func newUserInput(email string, role companyentity.UserRole) *companydto.UserInput {
+	return &companydto.UserInput{UserCommonAttributes: companyentity.UserCommonAttributes{Email: email}, Role: role}
+}
+
+func TestManageCompanyUsers(t *testing.T) {
+	ownerID, managerID, employeeID := uuid.New(), uuid.New(), uuid.New()
+
+	companies := &companyRepository{roles: map[uuid.UUID]companyentity.UserRole{
+		ownerID:    companyentity.RoleOwner,
+		managerID:  companyentity.RoleManager,
+		employeeID: companyentity.RoleEmployee,
+	}}
+	users := &userRepository{ids: map[string]uuid.UUID{
+		"owner@email.com":    ownerID,
+		"manager@email.com":  managerID,
+		"employee@email.com": employeeID,
+	}}
+
+	service := &Service{r: companies, u: users}
+
+	// Managers don't grant or remove owners and managers, employees manage no one
+	err := service.AddUserToCompany(newContext(managerID), newUserInput("new@email.com", companyentity.RoleOwner))
+	assert.ErrorIs(t, err, companyentity.ErrRoleNotAllowed)
+
+	err = service.AddUserToCompany(newContext(employeeID), newUserInput("new@email.com", companyentity.RoleDriver))
+	assert.ErrorIs(t, err, companyentity.ErrRoleNotAllowed)
+
+	err = service.RemoveUserFromCompany(newContext(managerID), newUserInput("owner@email.com", ""))
+	assert.ErrorIs(t, err, companyentity.ErrRoleNotAllowed)
+
+	// The last owner is kept
+	err = service.RemoveUserFromCompany(newContext(ownerID), newUserInput("owner@email.com", ""))
+	assert.ErrorIs(t, err, companyentity.ErrLastOwner)
+
+	assert.Nil(t, service.RemoveUserFromCompany(newContext(managerID), newUserInput("employee@email.com", "")))
+	assert.NotContains(t, companies.roles, employeeID)
+
+	assert.Nil(t, service.RemoveUserFromCompany(newContext(ownerID), newUserInput("manager@email.com", "")))
+	assert.NotContains(t, companies.roles, managerID)
+}
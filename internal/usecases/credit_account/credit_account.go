@@ -0,0 +1,296 @@
+package creditaccountusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	creditaccountentity "github.com/willjrcom/sales-backend-go/internal/domain/credit_account"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	creditaccountdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/credit_account"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+var (
+	ErrOrderFromAnotherClient = errors.New("order belongs to another client")
+	ErrOrderAlreadyPaid       = errors.New("order already paid")
+	ErrOrderWithoutClient     = errors.New("order must have a client to be charged")
+)
+
+type Service struct {
+	r        creditaccountentity.Repository
+	rc       cliententity.Repository
+	ro       orderentity.OrderRepository
+	rdo      orderentity.DeliveryOrderRepository
+	rto      orderentity.TableOrderRepository
+	rcompany companyentity.CompanyRepository
+}
+
+func NewService(r creditaccountentity.Repository, rc cliententity.Repository, ro orderentity.OrderRepository, rdo orderentity.DeliveryOrderRepository, rto orderentity.TableOrderRepository, rcompany companyentity.CompanyRepository) *Service {
+	return &Service{r: r, rc: rc, ro: ro, rdo: rdo, rto: rto, rcompany: rcompany}
+}
+
+func (s *Service) CreateCreditAccount(ctx context.Context, dto *creditaccountdto.CreateCreditAccountInput) (*creditaccountdto.CreditAccountOutput, error) {
+	account, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.rc.GetClientById(ctx, account.ClientID.String()); err != nil {
+		return nil, err
+	}
+
+	if err := s.r.CreateCreditAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	output := &creditaccountdto.CreditAccountOutput{}
+	output.FromModel(account, time.Now())
+	return output, nil
+}
+
+func (s *Service) UpdateCreditAccount(ctx context.Context, dtoID *entitydto.IdRequest, dto *creditaccountdto.UpdateCreditAccountInput) error {
+	account, err := s.r.GetCreditAccountById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(account); err != nil {
+		return err
+	}
+
+	return s.r.UpdateCreditAccount(ctx, account)
+}
+
+func (s *Service) GetCreditAccountById(ctx context.Context, dtoID *entitydto.IdRequest) (*creditaccountdto.CreditAccountOutput, error) {
+	account, err := s.r.GetCreditAccountById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &creditaccountdto.CreditAccountOutput{}
+	output.FromModel(account, time.Now())
+	return output, nil
+}
+
+func (s *Service) GetCreditAccountByClientId(ctx context.Context, dtoID *entitydto.IdRequest) (*creditaccountdto.CreditAccountOutput, error) {
+	account, err := s.r.GetCreditAccountByClientId(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &creditaccountdto.CreditAccountOutput{}
+	output.FromModel(account, time.Now())
+	return output, nil
+}
+
+// ChargeOrder puts what is left to pay of the order on the credit account of the client.
+func (s *Service) ChargeOrder(ctx context.Context, dto *creditaccountdto.ChargeOrderInput) (*orderentity.PaymentOrder, error) {
+	orderID, clientID, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.rdo.GetDeliveryByOrderId(ctx, orderID.String())
+
+	if errors.Is(err, sql.ErrNoRows) {
+		delivery = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if delivery != nil && delivery.ClientID != clientID {
+		return nil, ErrOrderFromAnotherClient
+	}
+
+	if delivery == nil {
+		table, err := s.rto.GetTableOrderByOrderId(ctx, orderID.String())
+
+		if errors.Is(err, sql.ErrNoRows) {
+			table = nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		// Pickup and counter orders have no client to charge
+		if table == nil {
+			return nil, ErrOrderWithoutClient
+		}
+
+		// The table must have been opened for the client
+		if table.ClientID == nil || *table.ClientID != clientID {
+			return nil, ErrOrderFromAnotherClient
+		}
+	}
+
+	order, err := s.ro.GetOrderById(ctx, orderID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery != nil {
+		order.Delivery = delivery
+	}
+
+	if err = order.ValidatePayments(); err != nil {
+		return nil, err
+	}
+
+	amount := order.GetRemainingToPay()
+
+	if amount == 0 {
+		return nil, ErrOrderAlreadyPaid
+	}
+
+	account, err := s.r.GetCreditAccountByClientId(ctx, clientID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.rcompany.GetCompanySettings(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The due date is built in the timezone of the company
+	now := settings.Now()
+	charge, err := account.Charge(order.ID, amount, now)
+
+	if err != nil {
+		return nil, err
+	}
+
+	payment := &orderentity.PaymentOrder{
+		PaymentTimeLogs: orderentity.PaymentTimeLogs{PaidAt: &now},
+		TotalPaid:       amount,
+		Method:          orderentity.Fiado,
+		OrderID:         order.ID,
+	}
+
+	order.AddPayment(payment)
+
+	if err := s.r.ChargeOrder(ctx, account, charge, order, payment); err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// Settle receives a payment of the client, the oldest charges are paid first.
+func (s *Service) Settle(ctx context.Context, dtoID *entitydto.IdRequest, dto *creditaccountdto.SettleInput) (*creditaccountdto.CreditAccountOutput, error) {
+	amount, method, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.r.GetCreditAccountById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.rcompany.GetCompanySettings(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := settings.Now()
+	if _, err := account.Settle(amount, method, now); err != nil {
+		return nil, err
+	}
+
+	if err := s.saveEntries(ctx, account); err != nil {
+		return nil, err
+	}
+
+	output := &creditaccountdto.CreditAccountOutput{}
+	output.FromModel(account, now)
+	return output, nil
+}
+
+func (s *Service) GetStatement(ctx context.Context, dtoID *entitydto.IdRequest, dto *creditaccountdto.StatementInput) (*creditaccountentity.Statement, error) {
+	startAt, endAt, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.r.GetCreditAccountById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return account.Statement(startAt, endAt), nil
+}
+
+// GetReceivables lists the accounts with outstanding balance.
+func (s *Service) GetReceivables(ctx context.Context) (*creditaccountdto.ReceivablesOutput, error) {
+	accounts, err := s.r.GetAllCreditAccounts(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &creditaccountdto.ReceivablesOutput{}
+	output.FromModel(accounts, time.Now())
+	return output, nil
+}
+
+// ReverseOrderCharge removes the debt of a canceled order charged to a credit account.
+func (s *Service) ReverseOrderCharge(ctx context.Context, order *orderentity.Order) error {
+	if !order.HasPaymentMethod(orderentity.Fiado) {
+		return nil
+	}
+
+	account, err := s.r.GetCreditAccountByOrderId(ctx, order.ID.String())
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	settings, err := s.rcompany.GetCompanySettings(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = account.ReverseOrder(order.ID, settings.Now())
+
+	if errors.Is(err, creditaccountentity.ErrNoChargeToReverseFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return s.saveEntries(ctx, account)
+}
+
+func (s *Service) saveEntries(ctx context.Context, account *creditaccountentity.CreditAccount) error {
+	created, updated := account.Changes()
+
+	if len(created) == 0 && len(updated) == 0 {
+		return nil
+	}
+
+	return s.r.SaveCreditEntries(ctx, account, created, updated)
+}
@@ -2,11 +2,19 @@ package deliveryorderusecases
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	trackingentity "github.com/willjrcom/sales-backend-go/internal/domain/tracking"
 	deliveryorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery"
 )
 
+var (
+	ErrClientWithoutAddress = errors.New("client without address")
+	ErrSchemaNotFound       = errors.New("schema not found")
+)
+
 func (s *Service) CreateDeliveryOrder(ctx context.Context, dto *deliveryorderdto.CreateDeliveryOrderInput) (uuid.UUID, error) {
 	delivery, err := dto.ToModel()
 
@@ -14,6 +22,34 @@ func (s *Service) CreateDeliveryOrder(ctx context.Context, dto *deliveryorderdto
 		return uuid.Nil, err
 	}
 
+	// Validate client
+	client, err := s.rc.GetClientById(ctx, delivery.ClientID.String())
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	address := client.Address
+
+	if delivery.AddressID != uuid.Nil {
+		if address, err = client.GetAddress(delivery.AddressID); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	if address == nil {
+		return uuid.Nil, ErrClientWithoutAddress
+	}
+
+	delivery.AddressID = address.ID
+
+	// Calculate delivery tax
+	zone, err := s.zs.FindDeliveryZone(ctx, address)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	delivery.ApplyDeliveryZone(zone)
+
 	orderID, err := s.os.CreateDefaultOrder(ctx)
 
 	if err != nil {
@@ -22,15 +58,20 @@ func (s *Service) CreateDeliveryOrder(ctx context.Context, dto *deliveryorderdto
 
 	delivery.OrderID = orderID
 
-	// Validate client
-	client, err := s.rc.GetClientById(ctx, delivery.ClientID.String())
+	schemaName, ok := ctx.Value(schemaentity.Schema("schema")).(string)
+
+	if !ok {
+		return uuid.Nil, ErrSchemaNotFound
+	}
+
+	token, err := trackingentity.NewTrackingToken(schemaName, delivery.ID, delivery.OrderID)
+
 	if err != nil {
 		return uuid.Nil, err
 	}
 
-	delivery.AddressID = client.Address.ID
-
-	if err = s.rdo.CreateDeliveryOrder(ctx, delivery); err != nil {
+	// The token is created with the delivery, a delivery is never left without tracking
+	if err = s.rt.CreateTrackingTokenWithDelivery(ctx, delivery, token); err != nil {
 		return uuid.Nil, err
 	}
 
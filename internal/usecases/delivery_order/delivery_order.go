@@ -8,8 +8,10 @@ import (
 	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
 	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	trackingentity "github.com/willjrcom/sales-backend-go/internal/domain/tracking"
 	deliveryorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	deliveryzoneusecases "github.com/willjrcom/sales-backend-go/internal/usecases/delivery_zone"
 	orderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/order"
 )
 
@@ -30,17 +32,23 @@ type IGetService interface {
 	GetDeliveryOrderByStatus(ctx context.Context) (deliveries []orderentity.DeliveryOrder, err error)
 	GetDeliveryOrderByClientId(ctx context.Context, dto *entitydto.IdRequest) ([]orderentity.DeliveryOrder, error)
 	GetDeliveryOrderByDriverId(ctx context.Context, dto *entitydto.IdRequest) ([]orderentity.DeliveryOrder, error)
+	GetTrackingToken(ctx context.Context, dto *entitydto.IdRequest) (*trackingentity.TrackingToken, error)
 }
 
 type IUpdateService interface {
 	LaunchDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest, dtoDriver *deliveryorderdto.UpdateDriverOrder) (err error)
 	FinishDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest) (err error)
+	FailDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.FailDeliveryOrder) (err error)
+	ReturnDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest) (err error)
+	RetryDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.RetryDeliveryOrder) (err error)
+	CancelUndeliveredOrder(ctx context.Context, dtoID *entitydto.IdRequest) (err error)
 	UpdateDeliveryAddress(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.UpdateDeliveryOrder) (err error)
 	UpdateDeliveryDriver(ctx context.Context, dto *entitydto.IdRequest, deliveryOrder *deliveryorderdto.UpdateDriverOrder) (err error)
 }
 
 type IStatusService interface {
 	GetAllDeliveryOrderStatus(ctx context.Context) (deliveries []orderentity.StatusDeliveryOrder)
+	GetAllDeliveryFailureReasons(ctx context.Context) []orderentity.DeliveryFailureReason
 }
 
 type Service struct {
@@ -50,8 +58,10 @@ type Service struct {
 	ro  orderentity.OrderRepository
 	re  employeeentity.Repository
 	os  *orderusecases.Service
+	zs  *deliveryzoneusecases.Service
+	rt  trackingentity.Repository
 }
 
-func NewService(rdo orderentity.DeliveryOrderRepository, ra addressentity.Repository, rc cliententity.Repository, ro orderentity.OrderRepository, re employeeentity.Repository, os *orderusecases.Service) IService {
-	return &Service{rdo: rdo, ra: ra, rc: rc, ro: ro, re: re, os: os}
+func NewService(rdo orderentity.DeliveryOrderRepository, ra addressentity.Repository, rc cliententity.Repository, ro orderentity.OrderRepository, re employeeentity.Repository, os *orderusecases.Service, zs *deliveryzoneusecases.Service, rt trackingentity.Repository) IService {
+	return &Service{rdo: rdo, ra: ra, rc: rc, ro: ro, re: re, os: os, zs: zs, rt: rt}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	trackingentity "github.com/willjrcom/sales-backend-go/internal/domain/tracking"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 )
 
@@ -23,12 +24,20 @@ func (s *Service) GetAllDeliveries(ctx context.Context) ([]orderentity.DeliveryO
 	}
 }
 
+func (s *Service) GetTrackingToken(ctx context.Context, dto *entitydto.IdRequest) (*trackingentity.TrackingToken, error) {
+	return s.rt.GetTrackingTokenByDeliveryId(ctx, dto.ID.String())
+}
+
 func (s *Service) GetAllDeliveryOrderStatus(ctx context.Context) (deliveries []orderentity.StatusDeliveryOrder) {
 	return orderentity.GetAllDeliveryStatus()
 }
 
-func (s Service) GetDeliveryOrderByClientId(ctx context.Context, dto *entitydto.IdRequest) ([]orderentity.DeliveryOrder, error) {
-	return nil, nil
+func (s *Service) GetAllDeliveryFailureReasons(ctx context.Context) []orderentity.DeliveryFailureReason {
+	return orderentity.GetAllDeliveryFailureReasons()
+}
+
+func (s *Service) GetDeliveryOrderByClientId(ctx context.Context, dto *entitydto.IdRequest) ([]orderentity.DeliveryOrder, error) {
+	return s.rdo.GetDeliveriesByClientId(ctx, dto.ID.String())
 }
 
 func (s *Service) GetDeliveryOrderByDriverId(ctx context.Context, dto *entitydto.IdRequest) ([]orderentity.DeliveryOrder, error) {
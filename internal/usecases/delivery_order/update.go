@@ -2,15 +2,19 @@ package deliveryorderusecases
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	deliveryorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	orderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/order"
 )
 
 var (
-	ErrOrderLaunched  = errors.New("order already launched")
-	ErrOrderDelivered = errors.New("order already delivered")
+	ErrOrderLaunched        = errors.New("order already launched")
+	ErrOrderDelivered       = errors.New("order already delivered")
+	ErrAddressNotFromClient = errors.New("address does not belong to the client of the delivery")
 )
 
 func (s *Service) LaunchDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest, dtoDriver *deliveryorderdto.UpdateDriverOrder) (err error) {
@@ -28,7 +32,19 @@ func (s *Service) LaunchDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRe
 		return err
 	}
 
-	deliveryOrder.LaunchDelivery(*deliveryOrder.DriverID)
+	order, err := s.ro.GetOrderById(ctx, deliveryOrder.OrderID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err = deliveryOrder.ValidateMinOrderValue(order.GetItemsTotal()); err != nil {
+		return err
+	}
+
+	if err = deliveryOrder.LaunchDelivery(*deliveryOrder.DriverID); err != nil {
+		return err
+	}
 
 	if err = s.rdo.UpdateDeliveryOrder(ctx, deliveryOrder); err != nil {
 		return err
@@ -44,7 +60,55 @@ func (s *Service) FinishDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRe
 		return err
 	}
 
-	deliveryOrder.FinishDelivery()
+	attempt, err := deliveryOrder.FinishDelivery()
+
+	if err != nil {
+		return err
+	}
+
+	if err = s.rdo.AddDeliveryAttempt(ctx, deliveryOrder, attempt); err != nil {
+		return err
+	}
+
+	return s.expireTrackingToken(ctx, deliveryOrder)
+}
+
+func (s *Service) FailDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.FailDeliveryOrder) (err error) {
+	reason, observation, err := dto.ToModel()
+
+	if err != nil {
+		return err
+	}
+
+	deliveryOrder, err := s.rdo.GetDeliveryById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	attempt, err := deliveryOrder.FailDelivery(reason, observation)
+
+	if err != nil {
+		return err
+	}
+
+	if err = s.rdo.AddDeliveryAttempt(ctx, deliveryOrder, attempt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) ReturnDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest) (err error) {
+	deliveryOrder, err := s.rdo.GetDeliveryById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err = deliveryOrder.ReturnDelivery(); err != nil {
+		return err
+	}
 
 	if err = s.rdo.UpdateDeliveryOrder(ctx, deliveryOrder); err != nil {
 		return err
@@ -53,6 +117,69 @@ func (s *Service) FinishDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRe
 	return nil
 }
 
+// RetryDeliveryOrder puts an undelivered order back to be launched, optionally rescheduling the order.
+func (s *Service) RetryDeliveryOrder(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.RetryDeliveryOrder) (err error) {
+	deliveryOrder, err := s.rdo.GetDeliveryById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err = deliveryOrder.RetryDelivery(); err != nil {
+		return err
+	}
+
+	if startAt := dto.ToModel(); startAt != nil {
+		dtoOrderID := &entitydto.IdRequest{ID: deliveryOrder.OrderID}
+		dtoSchedule := &orderdto.UpdateScheduleOrder{ScheduledOrder: orderentity.ScheduledOrder{StartAt: startAt}}
+
+		if err = s.os.UpdateScheduleOrder(ctx, dtoOrderID, dtoSchedule); err != nil {
+			return err
+		}
+	}
+
+	if err = s.rdo.UpdateDeliveryOrder(ctx, deliveryOrder); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CancelUndeliveredOrder gives up an undelivered order, canceling the order.
+func (s *Service) CancelUndeliveredOrder(ctx context.Context, dtoID *entitydto.IdRequest) (err error) {
+	deliveryOrder, err := s.rdo.GetDeliveryById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if !deliveryOrder.IsUndelivered() {
+		return orderentity.ErrDeliveryMustBeUndelivered
+	}
+
+	if err = s.os.CancelOrder(ctx, &entitydto.IdRequest{ID: deliveryOrder.OrderID}); err != nil {
+		return err
+	}
+
+	return s.expireTrackingToken(ctx, deliveryOrder)
+}
+
+func (s *Service) expireTrackingToken(ctx context.Context, deliveryOrder *orderentity.DeliveryOrder) error {
+	token, err := s.rt.GetTrackingTokenByDeliveryId(ctx, deliveryOrder.ID.String())
+
+	// Deliveries created before the tracking have no token
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	token.ExpireAfterDelivery()
+	return s.rt.UpdateTrackingToken(ctx, token)
+}
+
 func (s *Service) UpdateDeliveryAddress(ctx context.Context, dtoID *entitydto.IdRequest, dto *deliveryorderdto.UpdateDeliveryOrder) error {
 	deliveryOrder, err := s.rdo.GetDeliveryById(ctx, dtoID.ID.String())
 
@@ -74,10 +201,22 @@ func (s *Service) UpdateDeliveryAddress(ctx context.Context, dtoID *entitydto.Id
 		return err
 	}
 
+	if address.ObjectID != deliveryOrder.ClientID {
+		return ErrAddressNotFromClient
+	}
+
 	if err := dto.UpdateModel(deliveryOrder, address); err != nil {
 		return err
 	}
 
+	zone, err := s.zs.FindDeliveryZone(ctx, address)
+
+	if err != nil {
+		return err
+	}
+
+	deliveryOrder.ApplyDeliveryZone(zone)
+
 	if err := s.rdo.UpdateDeliveryOrder(ctx, deliveryOrder); err != nil {
 		return err
 	}
@@ -0,0 +1,273 @@
+package deliveryrouteusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	deliveryroutedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery_route"
+	employeedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/employee"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+var (
+	ErrDriverIsBusy           = errors.New("driver already has a route in progress")
+	ErrEmployeeNotDriver      = errors.New("employee is not a driver")
+	ErrDeliveryNotPending     = errors.New("delivery must be pending")
+	ErrDeliveryAlreadyInRoute = errors.New("delivery already in route")
+	ErrDeliveryNotReadyToShip = errors.New("order of delivery is not ready to ship")
+)
+
+type Service struct {
+	rr  orderentity.DeliveryRouteRepository
+	rdo orderentity.DeliveryOrderRepository
+	ro  orderentity.OrderRepository
+	re  employeeentity.Repository
+	rc  companyentity.CompanyRepository
+}
+
+func NewService(rr orderentity.DeliveryRouteRepository, rdo orderentity.DeliveryOrderRepository, ro orderentity.OrderRepository, re employeeentity.Repository, rc companyentity.CompanyRepository) *Service {
+	return &Service{rr: rr, rdo: rdo, ro: ro, re: re, rc: rc}
+}
+
+func (s *Service) GetDispatchBoard(ctx context.Context) (*deliveryroutedto.DispatchBoardOutput, error) {
+	pendingDeliveries, err := s.rdo.GetDeliveriesByStatus(ctx, orderentity.DeliveryOrderStatusPending)
+
+	if err != nil {
+		return nil, err
+	}
+
+	readyDeliveries := []orderentity.DeliveryOrder{}
+	for _, delivery := range pendingDeliveries {
+		if delivery.RouteID != nil {
+			continue
+		}
+
+		order, err := s.ro.GetOrderById(ctx, delivery.OrderID.String())
+
+		if err != nil {
+			return nil, err
+		}
+
+		if order.IsReadyToShip() {
+			readyDeliveries = append(readyDeliveries, delivery)
+		}
+	}
+
+	routes, err := s.rr.GetDeliveryRoutesByStatus(ctx, orderentity.DeliveryRouteStatusInProgress)
+
+	if err != nil {
+		return nil, err
+	}
+
+	busyDrivers, err := s.getBusyDrivers(ctx, routes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	employees, err := s.re.GetAllEmployees(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	availableDrivers := []employeedto.EmployeeOutput{}
+	for i := range employees {
+		if busyDrivers[employees[i].ID] {
+			continue
+		}
+
+		isDriver, err := s.isDriver(ctx, &employees[i])
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !isDriver {
+			continue
+		}
+
+		driver := employeedto.EmployeeOutput{}
+		driver.FromModel(&employees[i])
+		availableDrivers = append(availableDrivers, driver)
+	}
+
+	return &deliveryroutedto.DispatchBoardOutput{
+		ReadyDeliveries:  readyDeliveries,
+		AvailableDrivers: availableDrivers,
+		RoutesInProgress: routes,
+	}, nil
+}
+
+func (s *Service) LaunchDeliveryRoute(ctx context.Context, dto *deliveryroutedto.LaunchDeliveryRouteInput) (uuid.UUID, error) {
+	driverID, deliveryIDs, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	driver, err := s.re.GetEmployeeById(ctx, driverID.String())
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	isDriver, err := s.isDriver(ctx, driver)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if !isDriver {
+		return uuid.Nil, ErrEmployeeNotDriver
+	}
+
+	routes, err := s.rr.GetDeliveryRoutesByStatus(ctx, orderentity.DeliveryRouteStatusInProgress)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	busyDrivers, err := s.getBusyDrivers(ctx, routes)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if busyDrivers[driverID] {
+		return uuid.Nil, ErrDriverIsBusy
+	}
+
+	deliveries := []orderentity.DeliveryOrder{}
+	for _, id := range deliveryIDs {
+		delivery, err := s.getDeliveryReadyToShip(ctx, id)
+
+		if err != nil {
+			return uuid.Nil, err
+		}
+
+		deliveries = append(deliveries, *delivery)
+	}
+
+	route, err := orderentity.NewDeliveryRoute(driverID, deliveries, s.getStoreAddress(ctx))
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.rr.CreateDeliveryRoute(ctx, route); err != nil {
+		return uuid.Nil, err
+	}
+
+	return route.ID, nil
+}
+
+func (s *Service) ReturnDeliveryRoute(ctx context.Context, dtoID *entitydto.IdRequest) error {
+	route, err := s.rr.GetDeliveryRouteById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := route.ReturnToStore(); err != nil {
+		return err
+	}
+
+	return s.rr.UpdateDeliveryRoute(ctx, route)
+}
+
+func (s *Service) GetDeliveryRouteById(ctx context.Context, dtoID *entitydto.IdRequest) (*orderentity.DeliveryRoute, error) {
+	return s.rr.GetDeliveryRouteById(ctx, dtoID.ID.String())
+}
+
+func (s *Service) GetAllDeliveryRoutes(ctx context.Context) ([]orderentity.DeliveryRoute, error) {
+	return s.rr.GetAllDeliveryRoutes(ctx)
+}
+
+func (s *Service) getDeliveryReadyToShip(ctx context.Context, id uuid.UUID) (*orderentity.DeliveryOrder, error) {
+	delivery, err := s.rdo.GetDeliveryById(ctx, id.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery.Status != orderentity.DeliveryOrderStatusPending {
+		return nil, ErrDeliveryNotPending
+	}
+
+	if delivery.RouteID != nil {
+		return nil, ErrDeliveryAlreadyInRoute
+	}
+
+	order, err := s.ro.GetOrderById(ctx, delivery.OrderID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.IsReadyToShip() {
+		return nil, ErrDeliveryNotReadyToShip
+	}
+
+	if err := delivery.ValidateMinOrderValue(order.GetItemsTotal()); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+// getBusyDrivers returns the drivers with a route in progress or a single delivery shipped.
+func (s *Service) getBusyDrivers(ctx context.Context, routes []orderentity.DeliveryRoute) (map[uuid.UUID]bool, error) {
+	busyDrivers := map[uuid.UUID]bool{}
+	for _, route := range routes {
+		busyDrivers[route.DriverID] = true
+	}
+
+	shippedDeliveries, err := s.rdo.GetDeliveriesByStatus(ctx, orderentity.DeliveryOrderStatusShipped)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delivery := range shippedDeliveries {
+		if delivery.DriverID != nil {
+			busyDrivers[*delivery.DriverID] = true
+		}
+	}
+
+	return busyDrivers, nil
+}
+
+func (s *Service) isDriver(ctx context.Context, employee *employeeentity.Employee) (bool, error) {
+	if employee.UserID == nil {
+		return false, nil
+	}
+
+	role, err := s.rc.GetUserRole(ctx, *employee.UserID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return role == companyentity.RoleDriver, nil
+}
+
+func (s *Service) getStoreAddress(ctx context.Context) *addressentity.Address {
+	company, err := s.rc.GetCompany(ctx)
+
+	if err != nil {
+		return nil
+	}
+
+	return company.Address
+}
@@ -0,0 +1,92 @@
+package deliveryzoneusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	addressentity "github.com/willjrcom/sales-backend-go/internal/domain/address"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	deliveryzoneentity "github.com/willjrcom/sales-backend-go/internal/domain/delivery_zone"
+	deliveryzonedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/delivery_zone"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+type Service struct {
+	r  deliveryzoneentity.Repository
+	ra addressentity.Repository
+	rc companyentity.CompanyRepository
+}
+
+func NewService(r deliveryzoneentity.Repository, ra addressentity.Repository, rc companyentity.CompanyRepository) *Service {
+	return &Service{r: r, ra: ra, rc: rc}
+}
+
+func (s *Service) CreateDeliveryZone(ctx context.Context, dto *deliveryzonedto.RegisterDeliveryZoneInput) (uuid.UUID, error) {
+	zone, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.r.CreateDeliveryZone(ctx, zone); err != nil {
+		return uuid.Nil, err
+	}
+
+	return zone.ID, nil
+}
+
+func (s *Service) UpdateDeliveryZone(ctx context.Context, dtoId *entitydto.IdRequest, dto *deliveryzonedto.UpdateDeliveryZoneInput) error {
+	zone, err := s.r.GetDeliveryZoneById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(zone); err != nil {
+		return err
+	}
+
+	return s.r.UpdateDeliveryZone(ctx, zone)
+}
+
+func (s *Service) DeleteDeliveryZone(ctx context.Context, dto *entitydto.IdRequest) error {
+	if _, err := s.r.GetDeliveryZoneById(ctx, dto.ID.String()); err != nil {
+		return err
+	}
+
+	return s.r.DeleteDeliveryZone(ctx, dto.ID.String())
+}
+
+func (s *Service) GetDeliveryZoneById(ctx context.Context, dto *entitydto.IdRequest) (*deliveryzoneentity.DeliveryZone, error) {
+	return s.r.GetDeliveryZoneById(ctx, dto.ID.String())
+}
+
+func (s *Service) GetAllDeliveryZones(ctx context.Context) ([]deliveryzoneentity.DeliveryZone, error) {
+	return s.r.GetAllDeliveryZones(ctx)
+}
+
+func (s *Service) GetDeliveryZoneByAddressId(ctx context.Context, dto *entitydto.IdRequest) (*deliveryzoneentity.DeliveryZone, error) {
+	address, err := s.ra.GetAddressById(ctx, dto.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.FindDeliveryZone(ctx, address)
+}
+
+// FindDeliveryZone returns the zone covering the address, using the company address as store location.
+func (s *Service) FindDeliveryZone(ctx context.Context, address *addressentity.Address) (*deliveryzoneentity.DeliveryZone, error) {
+	zones, err := s.r.GetActiveDeliveryZones(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var store *addressentity.Address
+	if company, err := s.rc.GetCompany(ctx); err == nil {
+		store = company.Address
+	}
+
+	return deliveryzoneentity.FindDeliveryZone(zones, address, store)
+}
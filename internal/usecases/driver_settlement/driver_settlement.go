@@ -0,0 +1,208 @@
+package driversettlementusecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	driversettlemententity "github.com/willjrcom/sales-backend-go/internal/domain/driver_settlement"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	shiftentity "github.com/willjrcom/sales-backend-go/internal/domain/shift"
+	driversettlementdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/driver_settlement"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+)
+
+var (
+	ErrUserNotFoundInContext = errors.New("user not found in context")
+)
+
+type Service struct {
+	r   driversettlemententity.DriverSettlementRepository
+	rr  driversettlemententity.PayoutRuleRepository
+	rdo orderentity.DeliveryOrderRepository
+	ro  orderentity.OrderRepository
+	re  employeeentity.Repository
+	rs  shiftentity.ShiftRepository
+}
+
+func NewService(r driversettlemententity.DriverSettlementRepository, rr driversettlemententity.PayoutRuleRepository, rdo orderentity.DeliveryOrderRepository, ro orderentity.OrderRepository, re employeeentity.Repository, rs shiftentity.ShiftRepository) *Service {
+	return &Service{r: r, rr: rr, rdo: rdo, ro: ro, re: re, rs: rs}
+}
+
+func (s *Service) CreatePayoutRule(ctx context.Context, dto *driversettlementdto.RegisterPayoutRuleInput) (uuid.UUID, error) {
+	rule, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.rr.CreatePayoutRule(ctx, rule); err != nil {
+		return uuid.Nil, err
+	}
+
+	return rule.ID, nil
+}
+
+func (s *Service) UpdatePayoutRule(ctx context.Context, dtoID *entitydto.IdRequest, dto *driversettlementdto.UpdatePayoutRuleInput) error {
+	rule, err := s.rr.GetPayoutRuleById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(rule); err != nil {
+		return err
+	}
+
+	return s.rr.UpdatePayoutRule(ctx, rule)
+}
+
+func (s *Service) DeletePayoutRule(ctx context.Context, dtoID *entitydto.IdRequest) error {
+	if _, err := s.rr.GetPayoutRuleById(ctx, dtoID.ID.String()); err != nil {
+		return err
+	}
+
+	return s.rr.DeletePayoutRule(ctx, dtoID.ID.String())
+}
+
+func (s *Service) GetAllPayoutRules(ctx context.Context) ([]driversettlemententity.PayoutRule, error) {
+	return s.rr.GetAllPayoutRules(ctx)
+}
+
+// PreviewDriverSettlement computes the settlement without saving it.
+func (s *Service) PreviewDriverSettlement(ctx context.Context, dto *driversettlementdto.DriverSettlementInput) (*driversettlemententity.DriverSettlement, error) {
+	return s.calculateDriverSettlement(ctx, dto)
+}
+
+func (s *Service) CreateDriverSettlement(ctx context.Context, dto *driversettlementdto.DriverSettlementInput) (uuid.UUID, error) {
+	settlement, err := s.calculateDriverSettlement(ctx, dto)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if settlement.TotalDeliveries == 0 {
+		return uuid.Nil, driversettlemententity.ErrSettlementWithoutItems
+	}
+
+	if err := s.r.CreateDriverSettlement(ctx, settlement); err != nil {
+		return uuid.Nil, err
+	}
+
+	return settlement.ID, nil
+}
+
+func (s *Service) CloseDriverSettlement(ctx context.Context, dtoID *entitydto.IdRequest, dto *driversettlementdto.CloseDriverSettlementInput) error {
+	cashReturned, observation, err := dto.ToModel()
+
+	if err != nil {
+		return err
+	}
+
+	user, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	if !ok {
+		return ErrUserNotFoundInContext
+	}
+
+	settlement, err := s.r.GetDriverSettlementById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := settlement.Close(user.ID, cashReturned, observation); err != nil {
+		return err
+	}
+
+	return s.r.UpdateDriverSettlement(ctx, settlement)
+}
+
+func (s *Service) GetDriverSettlementById(ctx context.Context, dtoID *entitydto.IdRequest) (*driversettlemententity.DriverSettlement, error) {
+	return s.r.GetDriverSettlementById(ctx, dtoID.ID.String())
+}
+
+func (s *Service) GetAllDriverSettlements(ctx context.Context) ([]driversettlemententity.DriverSettlement, error) {
+	return s.r.GetAllDriverSettlements(ctx)
+}
+
+func (s *Service) GetDriverSettlementsByDriverId(ctx context.Context, dtoID *entitydto.IdRequest) ([]driversettlemententity.DriverSettlement, error) {
+	return s.r.GetDriverSettlementsByDriverId(ctx, dtoID.ID.String())
+}
+
+func (s *Service) calculateDriverSettlement(ctx context.Context, dto *driversettlementdto.DriverSettlementInput) (*driversettlemententity.DriverSettlement, error) {
+	driverID, shiftID, startAt, endAt, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := s.re.GetEmployeeById(ctx, driverID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if shiftID != nil {
+		if startAt, endAt, err = s.getShiftPeriod(ctx, shiftID.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	rules, err := s.rr.GetAllPayoutRules(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := driversettlemententity.FindPayoutRule(rules, driverID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.rdo.GetDeliveriesToSettle(ctx, driverID.String(), *startAt, *endAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	settlement := driversettlemententity.NewDriverSettlement(driverID, shiftID, *startAt, *endAt, rule)
+	settlement.Driver = driver
+
+	for i := range deliveries {
+		order, err := s.ro.GetOrderById(ctx, deliveries[i].OrderID.String())
+
+		if err != nil {
+			return nil, err
+		}
+
+		settlement.AddDelivery(&deliveries[i], order.GetTotalPaidInCash())
+	}
+
+	return settlement, nil
+}
+
+func (s *Service) getShiftPeriod(ctx context.Context, shiftID string) (startAt *time.Time, endAt *time.Time, err error) {
+	shift, err := s.rs.GetShiftByID(ctx, shiftID)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startAt = shift.OpenedAt
+	if startAt == nil {
+		startAt = shift.Day
+	}
+
+	endAt = shift.ClosedAt
+	if endAt == nil {
+		now := time.Now()
+		endAt = &now
+	}
+
+	return startAt, endAt, nil
+}
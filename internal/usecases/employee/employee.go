@@ -4,20 +4,25 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	personentity "github.com/willjrcom/sales-backend-go/internal/domain/person"
 	contactdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/contact"
 	employeedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/employee"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	ratelimitservice "github.com/willjrcom/sales-backend-go/internal/infra/service/ratelimit"
 )
 
 type Service struct {
-	re employeeentity.Repository
-	rc personentity.ContactRepository
+	re       employeeentity.Repository
+	rc       personentity.ContactRepository
+	rcompany companyentity.CompanyRepository
+	rs       companyentity.UserSessionRepository
+	l        *ratelimitservice.Lockout
 }
 
-func NewService(repository employeeentity.Repository, repositoryContact personentity.ContactRepository) *Service {
-	return &Service{re: repository, rc: repositoryContact}
+func NewService(repository employeeentity.Repository, repositoryContact personentity.ContactRepository, repositoryCompany companyentity.CompanyRepository, repositorySession companyentity.UserSessionRepository, l *ratelimitservice.Lockout) *Service {
+	return &Service{re: repository, rc: repositoryContact, rcompany: repositoryCompany, rs: repositorySession, l: l}
 }
 
 func (s *Service) RegisterEmployee(ctx context.Context, dto *employeedto.RegisterEmployeeInput) (uuid.UUID, error) {
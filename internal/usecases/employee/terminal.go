@@ -0,0 +1,153 @@
+package employeeusecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	employeedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/employee"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	bcryptservice "github.com/willjrcom/sales-backend-go/internal/infra/service/bcrypt"
+)
+
+// SetPin can be done by owners and managers, or by the user linked to the employee.
+func (s *Service) SetPin(ctx context.Context, dtoId *entitydto.IdRequest, dto *employeedto.PinInput) error {
+	pin, err := dto.ToModel()
+	if err != nil {
+		return err
+	}
+
+	employee, err := s.re.GetEmployeeById(ctx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	userLogged := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+	if employee.UserID == nil || *employee.UserID != userLogged.ID {
+		if err := s.checkManager(ctx, userLogged.ID, employeeentity.ErrPinNotAllowed); err != nil {
+			return err
+		}
+	}
+
+	hash, err := bcryptservice.HashPassword(pin)
+	if err != nil {
+		return err
+	}
+
+	employee.PinHash = string(hash)
+	return s.re.UpdateEmployeePin(ctx, employee)
+}
+
+// BindTerminal turns the current session into a shared terminal of the company.
+func (s *Service) BindTerminal(ctx context.Context, dto *employeedto.BindTerminalInput) error {
+	name, err := dto.ToModel()
+	if err != nil {
+		return err
+	}
+
+	userLogged := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+	if err := s.checkManager(ctx, userLogged.ID, employeeentity.ErrTerminalNotAllowed); err != nil {
+		return err
+	}
+
+	session, err := s.getSession(ctx)
+	if err != nil {
+		return err
+	}
+
+	schema := ctx.Value(schemaentity.Schema("schema")).(string)
+	session.BindTerminal(name, schema)
+	return s.rs.UpdateSession(ctx, session)
+}
+
+// SwitchEmployee sets the acting employee of the terminal after checking the pin.
+func (s *Service) SwitchEmployee(ctx context.Context, dto *employeedto.SwitchEmployeeInput) (*employeedto.EmployeeOutput, error) {
+	employeeID, pin, err := dto.ToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.getSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsTerminal() {
+		return nil, companyentity.ErrSessionNotTerminal
+	}
+
+	employee, err := s.CheckPin(ctx, employeeID, pin)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := ctx.Value(schemaentity.Schema("schema")).(string)
+	if err := session.SwitchEmployee(schema, employee.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.rs.UpdateSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	output := &employeedto.EmployeeOutput{}
+	output.FromModel(employee)
+	return output, nil
+}
+
+// CheckPin returns the employee when the pin matches, it is also used by the time clock.
+func (s *Service) CheckPin(ctx context.Context, employeeID uuid.UUID, pin string) (*employeeentity.Employee, error) {
+	// Pins are short, so each employee is locked after some wrong attempts
+	lockoutKey := "pin:" + employeeID.String()
+	if err := s.l.Check(lockoutKey, time.Now()); err != nil {
+		return nil, err
+	}
+
+	employee, err := s.re.GetEmployeeById(ctx, employeeID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if !employee.HasPin() {
+		return nil, employeeentity.ErrPinNotSet
+	}
+
+	if !bcryptservice.CheckPassword(employee.PinHash, pin) {
+		s.l.Fail(lockoutKey, time.Now())
+		return nil, employeeentity.ErrPinWrong
+	}
+
+	s.l.Reset(lockoutKey)
+	return employee, nil
+}
+
+func (s *Service) ReleaseEmployee(ctx context.Context) error {
+	session, err := s.getSession(ctx)
+	if err != nil {
+		return err
+	}
+
+	session.ReleaseEmployee()
+	return s.rs.UpdateSession(ctx, session)
+}
+
+func (s *Service) getSession(ctx context.Context) (*companyentity.UserSession, error) {
+	sessionID := ctx.Value(companyentity.SessionValue("session")).(uuid.UUID)
+	return s.rs.GetSessionById(ctx, sessionID)
+}
+
+func (s *Service) checkManager(ctx context.Context, userID uuid.UUID, errNotAllowed error) error {
+	role, err := s.rcompany.GetUserRole(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if role != companyentity.RoleOwner && role != companyentity.RoleManager {
+		return errNotAllowed
+	}
+
+	return nil
+}
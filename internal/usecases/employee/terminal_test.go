@@ -0,0 +1,183 @@
+package employeeusecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	employeedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/employee"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	ratelimitservice "github.com/willjrcom/sales-backend-go/internal/infra/service/ratelimit"
+)
+
+type employeeRepository struct {
+	employeeentity.Repository
+	employees map[uuid.UUID]*employeeentity.Employee
+}
+
+func (r *employeeRepository) GetEmployeeById(_ context.Context, id string) (*employeeentity.Employee, error) {
+	return r.employees[uuid.MustParse(id)], nil
+}
+
+func (r *employeeRepository) UpdateEmployeePin(_ context.Context, employee *employeeentity.Employee) error {
+	r.employees[employee.ID] = employee
+	return nil
+}
+
+type companyRepository struct {
+	companyentity.CompanyRepository
+	roles map[uuid.UUID]companyentity.UserRole
+}
+
+func (r *companyRepository) GetUserRole(_ context.Context, userID uuid.UUID) (companyentity.UserRole, error) {
+	return r.roles[userID], nil
+}
+
+type sessionRepository struct {
+	companyentity.UserSessionRepository
+	sessions map[uuid.UUID]*companyentity.UserSession
+}
+
+func (r *sessionRepository) GetSessionById(_ context.Context, id uuid.UUID) (*companyentity.UserSession, error) {
+	return r.sessions[id], nil
+}
+
+func (r *sessionRepository) UpdateSession(_ context.Context, session *companyentity.UserSession) error {
+	r.sessions[session.ID] = session
+	return nil
+}
+
+func newEmployee(userID *uuid.UUID) *employeeentity.Employee {
+	employee := &employeeentity.Employee{UserID: userID}
+	employee.ID = uuid.New()
+	return employee
+}
+
+func newContext(userID uuid.UUID, sessionID uuid.UUID) context.Context {
+	user := companyentity.User{}
+	user.ID = userID
+
+	ctx := context.WithValue(context.Background(), companyentity.UserValue("user"), user)
+	ctx = context.WithValue(ctx, companyentity.SessionValue("session"), sessionID)
+	return context.WithValue(ctx, schemaentity.Schema("schema"), "loja_teste")
+}
+
+type terminalTest struct {
+	service   *Service
+	employees *employeeRepository
+	sessions  *sessionRepository
+	managerID uuid.UUID
+	waiterID  uuid.UUID
+	session   *companyentity.UserSession
+}
+
+func newTerminalTest() *terminalTest {
+	test := &terminalTest{
+		employees: &employeeRepository{employees: map[uuid.UUID]*employeeentity.Employee{}},
+		sessions:  &sessionRepository{sessions: map[uuid.UUID]*companyentity.UserSession{}},
+		managerID: uuid.New(),
+		waiterID:  uuid.New(),
+	}
+
+	companies := &companyRepository{roles: map[uuid.UUID]companyentity.UserRole{
+		test.managerID: companyentity.RoleManager,
+		test.waiterID:  companyentity.RoleEmployee,
+	}}
+
+	test.session = companyentity.NewUserSession(test.managerID, "terminal", "127.0.0.1")
+	test.sessions.sessions[test.session.ID] = test.session
+
+	lockout := ratelimitservice.NewDefaultLockout(ratelimitservice.NewMemoryStore())
+	test.service = NewService(test.employees, nil, companies, test.sessions, lockout)
+	return test
+}
+
+func TestSetPin(t *testing.T) {
+	test := newTerminalTest()
+	waiter := newEmployee(&test.waiterID)
+	other := newEmployee(nil)
+	test.employees.employees[waiter.ID] = waiter
+	test.employees.employees[other.ID] = other
+
+	waiterCtx := newContext(test.waiterID, uuid.New())
+
+	err := test.service.SetPin(waiterCtx, &entitydto.IdRequest{ID: waiter.ID}, &employeedto.PinInput{Pin: "12"})
+	assert.ErrorIs(t, err, employeeentity.ErrPinInvalid)
+
+	// The employee sets their own pin, but not the pin of another employee
+	assert.Nil(t, test.service.SetPin(waiterCtx, &entitydto.IdRequest{ID: waiter.ID}, &employeedto.PinInput{Pin: "1234"}))
+	assert.True(t, waiter.HasPin())
+	assert.NotEqual(t, "1234", waiter.PinHash)
+
+	err = test.service.SetPin(waiterCtx, &entitydto.IdRequest{ID: other.ID}, &employeedto.PinInput{Pin: "1234"})
+	assert.ErrorIs(t, err, employeeentity.ErrPinNotAllowed)
+
+	// Managers set the pin of any employee
+	managerCtx := newContext(test.managerID, test.session.ID)
+	assert.Nil(t, test.service.SetPin(managerCtx, &entitydto.IdRequest{ID: other.ID}, &employeedto.PinInput{Pin: "4321"}))
+	assert.True(t, other.HasPin())
+}
+
+func TestBindTerminal(t *testing.T) {
+	test := newTerminalTest()
+
+	err := test.service.BindTerminal(newContext(test.managerID, test.session.ID), &employeedto.BindTerminalInput{})
+	assert.ErrorIs(t, err, employeedto.ErrTerminalNameRequired)
+
+	waiterSession := companyentity.NewUserSession(test.waiterID, "phone", "127.0.0.1")
+	test.sessions.sessions[waiterSession.ID] = waiterSession
+
+	err = test.service.BindTerminal(newContext(test.waiterID, waiterSession.ID), &employeedto.BindTerminalInput{Name: "Caixa"})
+	assert.ErrorIs(t, err, employeeentity.ErrTerminalNotAllowed)
+	assert.False(t, waiterSession.IsTerminal())
+
+	assert.Nil(t, test.service.BindTerminal(newContext(test.managerID, test.session.ID), &employeedto.BindTerminalInput{Name: "Caixa"}))
+	assert.True(t, test.session.IsTerminal())
+	assert.Equal(t, "Caixa", test.session.TerminalName)
+	assert.Equal(t, "loja_teste", test.session.TerminalSchema)
+}
+
+func TestSwitchEmployee(t *testing.T) {
+	test := newTerminalTest()
+	ctx := newContext(test.managerID, test.session.ID)
+
+	waiter := newEmployee(&test.waiterID)
+	test.employees.employees[waiter.ID] = waiter
+
+	input := &employeedto.SwitchEmployeeInput{EmployeeID: waiter.ID, PinInput: employeedto.PinInput{Pin: "1234"}}
+
+	// Only terminals switch employees
+	_, err := test.service.SwitchEmployee(ctx, input)
+	assert.ErrorIs(t, err, companyentity.ErrSessionNotTerminal)
+
+	assert.Nil(t, test.service.BindTerminal(ctx, &employeedto.BindTerminalInput{Name: "Caixa"}))
+
+	_, err = test.service.SwitchEmployee(ctx, input)
+	assert.ErrorIs(t, err, employeeentity.ErrPinNotSet)
+
+	assert.Nil(t, test.service.SetPin(ctx, &entitydto.IdRequest{ID: waiter.ID}, &employeedto.PinInput{Pin: "1234"}))
+
+	wrong := &employeedto.SwitchEmployeeInput{EmployeeID: waiter.ID, PinInput: employeedto.PinInput{Pin: "9999"}}
+	_, err = test.service.SwitchEmployee(ctx, wrong)
+	assert.ErrorIs(t, err, employeeentity.ErrPinWrong)
+
+	output, err := test.service.SwitchEmployee(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, waiter.ID, output.ID)
+
+	employeeID, ok := test.session.ActingEmployeeFor("loja_teste")
+	assert.True(t, ok)
+	assert.Equal(t, waiter.ID, employeeID)
+
+	// The pin is locked after repeated wrong attempts
+	for i := 0; i < ratelimitservice.DefaultLockoutThreshold; i++ {
+		test.service.SwitchEmployee(ctx, wrong)
+	}
+
+	_, err = test.service.SwitchEmployee(ctx, input)
+	assert.ErrorIs(t, err, ratelimitservice.ErrLocked)
+}
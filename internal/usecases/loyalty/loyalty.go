@@ -0,0 +1,252 @@
+package loyaltyusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	loyaltydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/loyalty"
+)
+
+var (
+	ErrOrderFromAnotherClient  = errors.New("order belongs to another client")
+	ErrDiscountAboveOrderTotal = errors.New("discount is above the order total")
+)
+
+type Service struct {
+	rp  loyaltyentity.LoyaltyProgramRepository
+	rt  loyaltyentity.LoyaltyTransactionRepository
+	rc  cliententity.Repository
+	ro  orderentity.OrderRepository
+	rdo orderentity.DeliveryOrderRepository
+}
+
+func NewService(rp loyaltyentity.LoyaltyProgramRepository, rt loyaltyentity.LoyaltyTransactionRepository, rc cliententity.Repository, ro orderentity.OrderRepository, rdo orderentity.DeliveryOrderRepository) *Service {
+	return &Service{rp: rp, rt: rt, rc: rc, ro: ro, rdo: rdo}
+}
+
+// GetLoyaltyProgram returns the program of the company, creating the default one on first access.
+func (s *Service) GetLoyaltyProgram(ctx context.Context) (*loyaltyentity.LoyaltyProgram, error) {
+	program, err := s.rp.GetLoyaltyProgram(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		program = loyaltyentity.NewDefaultLoyaltyProgram()
+		err = s.rp.CreateLoyaltyProgram(ctx, program)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return program, nil
+}
+
+func (s *Service) UpdateLoyaltyProgram(ctx context.Context, dto *loyaltydto.UpdateLoyaltyProgramInput) error {
+	program, err := s.GetLoyaltyProgram(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(program); err != nil {
+		return err
+	}
+
+	return s.rp.UpdateLoyaltyProgram(ctx, program)
+}
+
+func (s *Service) GetClientLoyalty(ctx context.Context, dtoID *entitydto.IdRequest) (*loyaltydto.LoyaltyOutput, error) {
+	program, err := s.GetLoyaltyProgram(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.rc.GetClientById(ctx, dtoID.ID.String()); err != nil {
+		return nil, err
+	}
+
+	// The expirations are only saved by the next change of points, reading never writes
+	ledger, err := s.getLedger(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &loyaltydto.LoyaltyOutput{}
+	output.FromModel(ledger, program)
+	return output, nil
+}
+
+// RedeemPoints converts points of the client into a discount on one of their orders.
+func (s *Service) RedeemPoints(ctx context.Context, dtoID *entitydto.IdRequest, dto *loyaltydto.RedeemPointsInput) (discount float64, err error) {
+	orderID, points, err := dto.ToModel()
+
+	if err != nil {
+		return 0, err
+	}
+
+	program, err := s.GetLoyaltyProgram(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !program.IsActive {
+		return 0, loyaltyentity.ErrLoyaltyProgramInactive
+	}
+
+	delivery, err := s.rdo.GetDeliveryByOrderId(ctx, orderID.String())
+
+	if err != nil {
+		return 0, err
+	}
+
+	if delivery.ClientID != dtoID.ID {
+		return 0, ErrOrderFromAnotherClient
+	}
+
+	order, err := s.ro.GetOrderById(ctx, orderID.String())
+
+	if err != nil {
+		return 0, err
+	}
+
+	ledger, err := s.getLedger(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return 0, err
+	}
+
+	if err := ledger.Redeem(program, orderID, points); err != nil {
+		return 0, err
+	}
+
+	discount = program.PointsToDiscount(points)
+	applied, err := order.ApplyDiscount(discount)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if applied < discount {
+		return 0, ErrDiscountAboveOrderTotal
+	}
+
+	if err := s.saveLedger(ctx, ledger); err != nil {
+		return 0, err
+	}
+
+	if err := s.ro.UpdateOrder(ctx, order); err != nil {
+		return 0, err
+	}
+
+	return discount, nil
+}
+
+// EarnOrderPoints credits the points of a finished order, orders without client are ignored.
+func (s *Service) EarnOrderPoints(ctx context.Context, order *orderentity.Order) error {
+	program, err := s.GetLoyaltyProgram(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if !program.IsActive {
+		return nil
+	}
+
+	points := program.CalculatePoints(order)
+
+	if points == 0 {
+		return nil
+	}
+
+	delivery, err := s.rdo.GetDeliveryByOrderId(ctx, order.ID.String())
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ledger, err := s.getLedger(ctx, delivery.ClientID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := ledger.Earn(program, order.ID, points, time.Now()); err != nil {
+		return err
+	}
+
+	return s.saveLedger(ctx, ledger)
+}
+
+// ReverseOrderPoints undoes the points earned and redeemed by a canceled order.
+func (s *Service) ReverseOrderPoints(ctx context.Context, order *orderentity.Order) error {
+	delivery, err := s.rdo.GetDeliveryByOrderId(ctx, order.ID.String())
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	program, err := s.GetLoyaltyProgram(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	ledger, err := s.getLedger(ctx, delivery.ClientID.String())
+
+	if err != nil {
+		return err
+	}
+
+	err = ledger.ReverseOrder(program, order.ID, time.Now())
+
+	if errors.Is(err, loyaltyentity.ErrNoPointsToReverseFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return s.saveLedger(ctx, ledger)
+}
+
+// getLedger loads the transactions of the client and expires the old points in memory.
+func (s *Service) getLedger(ctx context.Context, clientID string) (*loyaltyentity.Ledger, error) {
+	transactions, err := s.rt.GetLoyaltyTransactionsByClientId(ctx, clientID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ledger := loyaltyentity.NewLedger(uuid.MustParse(clientID), transactions)
+	ledger.ExpirePoints(time.Now())
+	return ledger, nil
+}
+
+func (s *Service) saveLedger(ctx context.Context, ledger *loyaltyentity.Ledger) error {
+	created, updated := ledger.Changes()
+
+	if len(created) == 0 && len(updated) == 0 {
+		return nil
+	}
+
+	return s.rt.SaveLoyaltyTransactions(ctx, created, updated)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 )
 
@@ -18,7 +19,13 @@ func (s *Service) CreateDefaultOrder(ctx context.Context) (uuid.UUID, error) {
 		return uuid.Nil, err
 	}
 
-	order := orderentity.NewDefaultOrder(&shift.ID, shift.CurrentOrderNumber, shift.AttendantID)
+	// On a shared terminal the order is attended by the employee who switched in with the pin
+	attendantID := shift.AttendantID
+	if employeeID, ok := employeeentity.GetActingEmployeeID(ctx); ok {
+		attendantID = &employeeID
+	}
+
+	order := orderentity.NewDefaultOrder(&shift.ID, shift.CurrentOrderNumber, attendantID)
 
 	if err := s.ro.CreateOrder(ctx, order); err != nil {
 		return uuid.Nil, err
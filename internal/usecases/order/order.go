@@ -4,7 +4,11 @@ import (
 	"errors"
 
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
 	shiftentity "github.com/willjrcom/sales-backend-go/internal/domain/shift"
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+	creditaccountusecases "github.com/willjrcom/sales-backend-go/internal/usecases/credit_account"
+	loyaltyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/loyalty"
 )
 
 var (
@@ -12,10 +16,15 @@ var (
 )
 
 type Service struct {
-	ro orderentity.OrderRepository
-	rs shiftentity.ShiftRepository
+	ro  orderentity.OrderRepository
+	rs  shiftentity.ShiftRepository
+	ls  *loyaltyusecases.Service
+	cs  *creditaccountusecases.Service
+	rt  tableentity.TableRepository
+	rr  reservationentity.Repository
+	rto orderentity.TableOrderRepository
 }
 
-func NewService(ro orderentity.OrderRepository, rs shiftentity.ShiftRepository) *Service {
-	return &Service{ro: ro, rs: rs}
+func NewService(ro orderentity.OrderRepository, rs shiftentity.ShiftRepository, ls *loyaltyusecases.Service, cs *creditaccountusecases.Service, rt tableentity.TableRepository, rr reservationentity.Repository, rto orderentity.TableOrderRepository) *Service {
+	return &Service{ro: ro, rs: rs, ls: ls, cs: cs, rt: rt, rr: rr, rto: rto}
 }
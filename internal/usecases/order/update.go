@@ -2,6 +2,13 @@ package orderusecases
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	orderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/order"
@@ -40,6 +47,15 @@ func (s *Service) FinishOrder(ctx context.Context, dto *entitydto.IdRequest) err
 		return err
 	}
 
+	// The order is already finished, a failure on the points must not fail the request
+	if err := s.ls.EarnOrderPoints(ctx, order); err != nil {
+		log.Printf("error earning loyalty points of order %s: %v", order.ID, err)
+	}
+
+	if err := s.releaseOrderTables(ctx, order); err != nil {
+		log.Printf("error releasing reservation tables of order %s: %v", order.ID, err)
+	}
+
 	return nil
 }
 
@@ -58,7 +74,60 @@ func (s *Service) CancelOrder(ctx context.Context, dto *entitydto.IdRequest) (er
 		return err
 	}
 
-	return nil
+	// Both are reversed even if the other fails
+	return errors.Join(s.cs.ReverseOrderCharge(ctx, order), s.ls.ReverseOrderPoints(ctx, order), s.releaseOrderTables(ctx, order))
+}
+
+// ReleaseReservationTables frees the extra tables locked by the reservation seated on the table order.
+func (s *Service) ReleaseReservationTables(ctx context.Context, tableOrderID uuid.UUID) error {
+	reservation, err := s.rr.GetReservationByTableOrderId(ctx, tableOrderID.String())
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !reservation.HoldsExtraTables() {
+		return nil
+	}
+
+	for _, tableID := range reservation.ExtraTableIDs() {
+		table, err := s.rt.GetTableById(ctx, tableID.String())
+
+		if err != nil {
+			return err
+		}
+
+		table.ReleaseTable()
+
+		if err := s.rt.UpdateTable(ctx, table); err != nil {
+			return err
+		}
+	}
+
+	if err := reservation.ReleaseTables(time.Now()); err != nil {
+		return err
+	}
+
+	return s.rr.UpdateReservation(ctx, reservation)
+}
+
+func (s *Service) releaseOrderTables(ctx context.Context, order *orderentity.Order) error {
+	// The table is not loaded with the order
+	tableOrder, err := s.rto.GetTableOrderByOrderId(ctx, order.ID.String())
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return s.ReleaseReservationTables(ctx, tableOrder.ID)
 }
 
 func (s *Service) ArchiveOrder(ctx context.Context, dto *entitydto.IdRequest) (err error) {
@@ -0,0 +1,134 @@
+package orderusecases
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	loyaltyentity "github.com/willjrcom/sales-backend-go/internal/domain/loyalty"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	loyaltyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/loyalty"
+)
+
+type orderRepository struct {
+	orderentity.OrderRepository
+	orders map[uuid.UUID]*orderentity.Order
+}
+
+func (r *orderRepository) GetOrderById(_ context.Context, id string) (*orderentity.Order, error) {
+	return r.orders[uuid.MustParse(id)], nil
+}
+
+func (r *orderRepository) UpdateOrder(_ context.Context, order *orderentity.Order) error {
+	r.orders[order.ID] = order
+	return nil
+}
+
+type tableOrderRepository struct {
+	orderentity.TableOrderRepository
+	tableOrders map[uuid.UUID]*orderentity.TableOrder
+}
+
+func (r *tableOrderRepository) GetTableOrderByOrderId(_ context.Context, orderID string) (*orderentity.TableOrder, error) {
+	tableOrder, ok := r.tableOrders[uuid.MustParse(orderID)]
+
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return tableOrder, nil
+}
+
+type tableRepository struct {
+	tableentity.TableRepository
+	tables map[uuid.UUID]*tableentity.Table
+}
+
+func (r *tableRepository) GetTableById(_ context.Context, id string) (*tableentity.Table, error) {
+	return r.tables[uuid.MustParse(id)], nil
+}
+
+func (r *tableRepository) UpdateTable(_ context.Context, table *tableentity.Table) error {
+	r.tables[table.ID] = table
+	return nil
+}
+
+type reservationRepository struct {
+	reservationentity.Repository
+	reservations map[uuid.UUID]*reservationentity.Reservation
+}
+
+func (r *reservationRepository) GetReservationByTableOrderId(_ context.Context, tableOrderID string) (*reservationentity.Reservation, error) {
+	reservation, ok := r.reservations[uuid.MustParse(tableOrderID)]
+
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return reservation, nil
+}
+
+func (r *reservationRepository) UpdateReservation(_ context.Context, reservation *reservationentity.Reservation) error {
+	r.reservations[*reservation.TableOrderID] = reservation
+	return nil
+}
+
+type loyaltyProgramRepository struct {
+	loyaltyentity.LoyaltyProgramRepository
+}
+
+func (r *loyaltyProgramRepository) GetLoyaltyProgram(_ context.Context) (*loyaltyentity.LoyaltyProgram, error) {
+	return &loyaltyentity.LoyaltyProgram{}, nil
+}
+
+func newTable() *tableentity.Table {
+	return &tableentity.Table{Entity: entity.NewEntity()}
+}
+
+func TestFinishOrderReleasesReservationTables(t *testing.T) {
+	order := &orderentity.Order{Entity: entity.NewEntity()}
+	order.Status = orderentity.OrderStatusPending
+
+	tableOrder := &orderentity.TableOrder{Entity: entity.NewEntity()}
+	tableOrder.OrderID = order.ID
+
+	seated, extra := newTable(), newTable()
+
+	reservation := reservationentity.NewReservation(reservationentity.ReservationCommonAttributes{
+		Name:      "Maria",
+		PartySize: 8,
+		StartAt:   time.Now(),
+		TableIDs:  []uuid.UUID{seated.ID, extra.ID},
+	})
+	assert.Nil(t, reservation.Seat(tableOrder.ID, time.Now()))
+
+	tables := &tableRepository{tables: map[uuid.UUID]*tableentity.Table{seated.ID: seated, extra.ID: extra}}
+	reservations := &reservationRepository{reservations: map[uuid.UUID]*reservationentity.Reservation{tableOrder.ID: reservation}}
+
+	service := NewService(
+		&orderRepository{orders: map[uuid.UUID]*orderentity.Order{order.ID: order}},
+		nil,
+		loyaltyusecases.NewService(&loyaltyProgramRepository{}, nil, nil, nil, nil),
+		nil,
+		tables,
+		reservations,
+		&tableOrderRepository{tableOrders: map[uuid.UUID]*orderentity.TableOrder{order.ID: tableOrder}},
+	)
+
+	assert.Nil(t, service.FinishOrder(context.Background(), &entitydto.IdRequest{ID: order.ID}))
+	assert.Equal(t, orderentity.OrderStatusFinished, order.Status)
+
+	// Only the extra table is freed, the seated one follows the table order
+	assert.True(t, extra.IsAvailable)
+	assert.True(t, extra.NeedsClean)
+	assert.False(t, seated.IsAvailable)
+	assert.NotNil(t, reservation.ReleasedAt)
+	assert.False(t, reservation.HoldsExtraTables())
+}
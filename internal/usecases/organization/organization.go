@@ -0,0 +1,321 @@
+package organizationusecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	organizationentity "github.com/willjrcom/sales-backend-go/internal/domain/organization"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	organizationdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/organization"
+)
+
+var (
+	ErrUserNotFoundInContext = errors.New("user not found in context")
+)
+
+type Service struct {
+	r    organizationentity.OrganizationRepository
+	rt   organizationentity.CatalogTemplateRepository
+	rr   organizationentity.ReportRepository
+	rcat productentity.CategoryRepository
+	ru   companyentity.UserRepository
+}
+
+func NewService(r organizationentity.OrganizationRepository, rt organizationentity.CatalogTemplateRepository, rr organizationentity.ReportRepository, rcat productentity.CategoryRepository, ru companyentity.UserRepository) *Service {
+	return &Service{r: r, rt: rt, rr: rr, rcat: rcat, ru: ru}
+}
+
+func (s *Service) CreateOrganization(ctx context.Context, dto *organizationdto.OrganizationInput) (uuid.UUID, error) {
+	name, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	user, err := s.getUser(ctx)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	organization, err := organizationentity.NewOrganization(name, user.ID)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.r.CreateOrganization(ctx, organization); err != nil {
+		return uuid.Nil, err
+	}
+
+	return organization.ID, nil
+}
+
+func (s *Service) UpdateOrganization(ctx context.Context, dtoID *entitydto.IdRequest, dto *organizationdto.OrganizationInput) error {
+	name, err := dto.ToModel()
+
+	if err != nil {
+		return err
+	}
+
+	organization, _, err := s.getOwnedOrganization(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	organization.Name = name
+	return s.r.UpdateOrganization(ctx, organization)
+}
+
+func (s *Service) GetOrganizationById(ctx context.Context, dtoID *entitydto.IdRequest) (*organizationentity.Organization, error) {
+	user, err := s.getUser(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := s.r.GetOrganizationById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !organization.IsOwner(user.ID) {
+		if err := organization.CheckAccessToAll(user); err != nil {
+			return nil, err
+		}
+	}
+
+	return organization, nil
+}
+
+func (s *Service) GetUserOrganizations(ctx context.Context) ([]organizationentity.Organization, error) {
+	user, err := s.getUser(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.r.GetOrganizationsByUserId(ctx, user.ID)
+}
+
+func (s *Service) AddCompany(ctx context.Context, dtoID *entitydto.IdRequest, dto *organizationdto.OrganizationCompanyInput) error {
+	companyID, err := dto.ToModel()
+
+	if err != nil {
+		return err
+	}
+
+	organization, user, err := s.getOwnedOrganization(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := organizationentity.CanBeAddedBy(user, companyID); err != nil {
+		return err
+	}
+
+	return s.r.AddCompany(ctx, organization.ID, companyID)
+}
+
+func (s *Service) RemoveCompany(ctx context.Context, dtoID *entitydto.IdRequest, dto *organizationdto.OrganizationCompanyInput) error {
+	companyID, err := dto.ToModel()
+
+	if err != nil {
+		return err
+	}
+
+	organization, _, err := s.getOwnedOrganization(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := organization.FindCompany(companyID); err != nil {
+		return err
+	}
+
+	return s.r.RemoveCompany(ctx, organization.ID, companyID)
+}
+
+// CreateCatalogTemplate copies the current catalog of a branch into a template of the organization.
+func (s *Service) CreateCatalogTemplate(ctx context.Context, dtoID *entitydto.IdRequest, dto *organizationdto.CatalogTemplateInput) (uuid.UUID, error) {
+	name, sourceCompanyID, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	organization, user, err := s.getOwnedOrganization(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	company, err := organization.FindCompany(sourceCompanyID)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// The owner of the organization must also own the branch before reading its schema
+	if err := organizationentity.CanBeAddedBy(user, company.ID); err != nil {
+		return uuid.Nil, err
+	}
+
+	categories, err := s.rcat.GetAllCategories(withSchema(ctx, company.SchemaName))
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	template, err := organizationentity.NewCatalogTemplate(organization.ID, company.ID, name, categories)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.rt.CreateCatalogTemplate(ctx, template); err != nil {
+		return uuid.Nil, err
+	}
+
+	return template.ID, nil
+}
+
+func (s *Service) GetCatalogTemplates(ctx context.Context, dtoID *entitydto.IdRequest) ([]organizationentity.CatalogTemplate, error) {
+	if _, _, err := s.getOwnedOrganization(ctx, dtoID.ID.String()); err != nil {
+		return nil, err
+	}
+
+	return s.rt.GetCatalogTemplatesByOrganizationId(ctx, dtoID.ID.String())
+}
+
+// ApplyCatalogTemplate syncs the catalog of a branch with the template and returns what was written.
+func (s *Service) ApplyCatalogTemplate(ctx context.Context, dtoID *entitydto.IdRequest, dto *organizationdto.OrganizationCompanyInput) (*organizationentity.CatalogPlan, error) {
+	companyID, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.rt.GetCatalogTemplateById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	organization, user, err := s.getOwnedOrganization(ctx, template.OrganizationID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := organization.FindCompany(companyID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The owner of the organization must also own the branch before writing its schema
+	if err := organizationentity.CanBeAddedBy(user, company.ID); err != nil {
+		return nil, err
+	}
+
+	ctxBranch := withSchema(ctx, company.SchemaName)
+	categories, err := s.rcat.GetAllCategories(ctxBranch)
+
+	if err != nil {
+		return nil, err
+	}
+
+	plan := template.Plan(categories)
+
+	if err := s.rt.ApplyCatalogPlan(ctxBranch, plan); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// GetConsolidatedReport reads the sales of every branch, only for users with access to all of them.
+func (s *Service) GetConsolidatedReport(ctx context.Context, dtoID *entitydto.IdRequest, dto *organizationdto.ConsolidatedReportInput) (*organizationentity.ConsolidatedReport, error) {
+	startAt, endAt, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.getUser(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := s.r.GetOrganizationById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := organization.CheckAccessToAll(user); err != nil {
+		return nil, err
+	}
+
+	branches := []organizationentity.BranchReport{}
+	for _, company := range organization.Companies {
+		summary, err := s.rr.GetBranchSales(withSchema(ctx, company.SchemaName), startAt, endAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		branches = append(branches, organizationentity.BranchReport{
+			CompanyID:    company.ID,
+			TradeName:    company.TradeName,
+			SalesSummary: *summary,
+		})
+	}
+
+	return organizationentity.NewConsolidatedReport(organization.ID, startAt, endAt, branches), nil
+}
+
+// getUser reloads the user from the context with the companies they belong to.
+func (s *Service) getUser(ctx context.Context) (*companyentity.User, error) {
+	user, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	if !ok {
+		return nil, ErrUserNotFoundInContext
+	}
+
+	return s.ru.GetUserById(ctx, user.ID)
+}
+
+func (s *Service) getOwnedOrganization(ctx context.Context, id string) (*organizationentity.Organization, *companyentity.User, error) {
+	user, err := s.getUser(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	organization, err := s.r.GetOrganizationById(ctx, id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !organization.IsOwner(user.ID) {
+		return nil, nil, organizationentity.ErrOnlyOwnerCanManage
+	}
+
+	return organization, user, nil
+}
+
+func withSchema(ctx context.Context, schemaName string) context.Context {
+	return context.WithValue(ctx, schemaentity.Schema("schema"), schemaName)
+}
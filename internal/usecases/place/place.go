@@ -0,0 +1,213 @@
+package placeusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	placedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/place"
+)
+
+type Service struct {
+	rp  tableentity.PlaceRepository
+	rt  tableentity.TableRepository
+	rto orderentity.TableOrderRepository
+	ro  orderentity.OrderRepository
+	rr  reservationentity.Repository
+}
+
+func NewService(rp tableentity.PlaceRepository, rt tableentity.TableRepository, rto orderentity.TableOrderRepository, ro orderentity.OrderRepository, rr reservationentity.Repository) *Service {
+	return &Service{rp: rp, rt: rt, rto: rto, ro: ro, rr: rr}
+}
+
+func (s *Service) CreatePlace(ctx context.Context, dto *placedto.CreatePlaceInput) (uuid.UUID, error) {
+	place, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.rp.CreatePlace(ctx, place); err != nil {
+		return uuid.Nil, err
+	}
+
+	return place.ID, nil
+}
+
+func (s *Service) UpdatePlace(ctx context.Context, dtoId *entitydto.IdRequest, dto *placedto.UpdatePlaceInput) error {
+	place, err := s.rp.GetPlaceById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(place); err != nil {
+		return err
+	}
+
+	return s.rp.UpdatePlace(ctx, place)
+}
+
+// UpdateLayout replaces the tables of the place, a table can be in only one place.
+func (s *Service) UpdateLayout(ctx context.Context, dtoId *entitydto.IdRequest, dto *placedto.UpdateLayoutInput) error {
+	place, err := s.rp.GetPlaceById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(place); err != nil {
+		return err
+	}
+
+	places, err := s.rp.GetAllPlaces(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	usedTables := map[uuid.UUID]bool{}
+	for _, other := range places {
+		if other.ID == place.ID {
+			continue
+		}
+
+		for _, table := range other.Tables {
+			usedTables[table.TableID] = true
+		}
+	}
+
+	for _, table := range place.Tables {
+		if usedTables[table.TableID] {
+			return tableentity.ErrTableUsedInOtherPlace
+		}
+
+		_, err := s.rt.GetTableById(ctx, table.TableID.String())
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return tableentity.ErrPlaceTableNotFoundInDB
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.rp.SavePlaceTables(ctx, place)
+}
+
+func (s *Service) DeletePlace(ctx context.Context, dtoId *entitydto.IdRequest) error {
+	if _, err := s.rp.GetPlaceById(ctx, dtoId.ID.String()); err != nil {
+		return err
+	}
+
+	return s.rp.DeletePlace(ctx, dtoId.ID.String())
+}
+
+func (s *Service) GetPlaceById(ctx context.Context, dtoId *entitydto.IdRequest) (*tableentity.Place, error) {
+	return s.rp.GetPlaceById(ctx, dtoId.ID.String())
+}
+
+func (s *Service) GetAllPlaces(ctx context.Context) ([]tableentity.Place, error) {
+	return s.rp.GetAllPlaces(ctx)
+}
+
+func (s *Service) GetPlaceView(ctx context.Context, dtoId *entitydto.IdRequest) (*tableentity.PlaceView, error) {
+	place, err := s.rp.GetPlaceById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	occupancies, bookings, err := s.getTablesState(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return place.View(occupancies, bookings, time.Now()), nil
+}
+
+// GetAllPlacesView returns the live state of the tables of every place, for the floor map.
+func (s *Service) GetAllPlacesView(ctx context.Context) ([]tableentity.PlaceView, error) {
+	places, err := s.rp.GetAllPlaces(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	occupancies, bookings, err := s.getTablesState(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	views := []tableentity.PlaceView{}
+	for i := range places {
+		views = append(views, *places[i].View(occupancies, bookings, now))
+	}
+
+	return views, nil
+}
+
+// getTablesState loads the open orders and the reservations starting soon by table.
+func (s *Service) getTablesState(ctx context.Context) (map[uuid.UUID]tableentity.Occupancy, map[uuid.UUID]tableentity.Booking, error) {
+	openTableOrders, err := s.rto.GetOpenTableOrders(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	occupancies := map[uuid.UUID]tableentity.Occupancy{}
+	for _, tableOrder := range openTableOrders {
+		order, err := s.ro.GetOrderById(ctx, tableOrder.OrderID.String())
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		order.CalculateTotalPrice()
+
+		occupancies[tableOrder.TableID] = tableentity.Occupancy{
+			TableOrderID: tableOrder.ID,
+			OrderID:      order.ID,
+			OpenedAt:     tableOrder.CreatedAt,
+			OrderTotal:   order.TotalPayable,
+		}
+	}
+
+	now := time.Now()
+	reservations, err := s.rr.GetActiveReservationsByPeriod(ctx, now, now.Add(tableentity.ReservedWindow))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bookings := map[uuid.UUID]tableentity.Booking{}
+	for _, reservation := range reservations {
+		if reservation.Status != reservationentity.ReservationStatusBooked {
+			continue
+		}
+
+		for _, tableID := range reservation.TableIDs {
+			if booking, ok := bookings[tableID]; ok && booking.StartAt.Before(reservation.StartAt) {
+				continue
+			}
+
+			bookings[tableID] = tableentity.Booking{
+				ReservationID: reservation.ID,
+				Name:          reservation.Name,
+				StartAt:       reservation.StartAt,
+			}
+		}
+	}
+
+	return occupancies, bookings, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	processentity "github.com/willjrcom/sales-backend-go/internal/domain/process"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	processdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/process"
@@ -18,6 +19,10 @@ func NewService(c processentity.ProcessRepository) *Service {
 }
 
 func (s *Service) RegisterProcess(ctx context.Context, dto *processdto.CreateProcessInput) (uuid.UUID, error) {
+	if employeeID, ok := employeeentity.GetActingEmployeeID(ctx); ok {
+		dto.EmployeeID = employeeID
+	}
+
 	process, err := dto.ToModel()
 
 	if err != nil {
@@ -0,0 +1,265 @@
+package reservationusecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	cliententity "github.com/willjrcom/sales-backend-go/internal/domain/client"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	reservationentity "github.com/willjrcom/sales-backend-go/internal/domain/reservation"
+	tableentity "github.com/willjrcom/sales-backend-go/internal/domain/table"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	reservationdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/reservation"
+	tableorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/table_order"
+	tableorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/table_order"
+)
+
+type Service struct {
+	r   reservationentity.Repository
+	rt  tableentity.TableRepository
+	rto orderentity.TableOrderRepository
+	rc  cliententity.Repository
+	tos *tableorderusecases.Service
+}
+
+func NewService(r reservationentity.Repository, rt tableentity.TableRepository, rto orderentity.TableOrderRepository, rc cliententity.Repository, tos *tableorderusecases.Service) *Service {
+	return &Service{r: r, rt: rt, rto: rto, rc: rc, tos: tos}
+}
+
+func (s *Service) CreateReservation(ctx context.Context, dto *reservationdto.CreateReservationInput) (uuid.UUID, error) {
+	reservation, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.fillClient(ctx, reservation); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := reservation.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.checkTables(ctx, reservation); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.r.CreateReservation(ctx, reservation); err != nil {
+		return uuid.Nil, err
+	}
+
+	return reservation.ID, nil
+}
+
+func (s *Service) UpdateReservation(ctx context.Context, dtoId *entitydto.IdRequest, dto *reservationdto.UpdateReservationInput) error {
+	reservation, err := s.r.GetReservationById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if reservation.Status != reservationentity.ReservationStatusBooked {
+		return reservationentity.ErrReservationMustBeBooked
+	}
+
+	if err := dto.UpdateModel(reservation); err != nil {
+		return err
+	}
+
+	if err := s.checkTables(ctx, reservation); err != nil {
+		return err
+	}
+
+	return s.r.UpdateReservation(ctx, reservation)
+}
+
+func (s *Service) CancelReservation(ctx context.Context, dtoId *entitydto.IdRequest) error {
+	reservation, err := s.r.GetReservationById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := reservation.Cancel(time.Now()); err != nil {
+		return err
+	}
+
+	return s.r.UpdateReservation(ctx, reservation)
+}
+
+func (s *Service) MarkNoShow(ctx context.Context, dtoId *entitydto.IdRequest) error {
+	reservation, err := s.r.GetReservationById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := reservation.MarkNoShow(time.Now()); err != nil {
+		return err
+	}
+
+	return s.r.UpdateReservation(ctx, reservation)
+}
+
+// SeatReservation opens the table order on the first table of the reservation and locks the others.
+func (s *Service) SeatReservation(ctx context.Context, dtoId *entitydto.IdRequest, dto *reservationdto.SeatReservationInput) (uuid.UUID, error) {
+	if employeeID, ok := employeeentity.GetActingEmployeeID(ctx); ok {
+		dto.WaiterID = employeeID
+	}
+
+	waiterID, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	reservation, err := s.r.GetReservationById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if reservation.Status != reservationentity.ReservationStatusBooked {
+		return uuid.Nil, reservationentity.ErrReservationMustBeBooked
+	}
+
+	tables := []*tableentity.Table{}
+	for _, tableID := range reservation.TableIDs {
+		table, err := s.rt.GetTableById(ctx, tableID.String())
+
+		if err != nil {
+			return uuid.Nil, err
+		}
+
+		if !table.IsAvailable {
+			return uuid.Nil, reservationentity.ErrTableNotAvailable
+		}
+
+		tables = append(tables, table)
+	}
+
+	tableOrderInput := &tableorderdto.CreateTableOrderInput{
+		WaiterID: waiterID,
+		TableID:  tables[0].ID,
+		ClientID: reservation.ClientID,
+	}
+
+	tableOrderID, err := s.tos.CreateTableOrder(ctx, tableOrderInput)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	for _, table := range tables[1:] {
+		table.LockTable()
+
+		if err := s.rt.UpdateTable(ctx, table); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	if err := reservation.Seat(tableOrderID, time.Now()); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.r.UpdateReservation(ctx, reservation); err != nil {
+		return uuid.Nil, err
+	}
+
+	return tableOrderID, nil
+}
+
+func (s *Service) GetReservationById(ctx context.Context, dtoId *entitydto.IdRequest) (*reservationentity.Reservation, error) {
+	return s.r.GetReservationById(ctx, dtoId.ID.String())
+}
+
+func (s *Service) GetReservationsByPeriod(ctx context.Context, dto *reservationdto.ReservationPeriodInput) ([]reservationentity.Reservation, error) {
+	startAt, endAt, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.r.GetReservationsByPeriod(ctx, startAt, endAt)
+}
+
+// GetAvailability suggests the tables free for the party at the time asked.
+func (s *Service) GetAvailability(ctx context.Context, dto *reservationdto.AvailabilityInput) ([]reservationentity.Suggestion, error) {
+	partySize, startAt, endAt, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := s.rt.GetAllTables(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	occupations, err := s.getOccupations(ctx, startAt, endAt, uuid.Nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reservationentity.FindAvailability(tables, partySize, startAt, endAt, occupations)
+}
+
+func (s *Service) checkTables(ctx context.Context, reservation *reservationentity.Reservation) error {
+	tables, err := s.rt.GetAllTables(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	occupations, err := s.getOccupations(ctx, reservation.StartAt, reservation.EndAt(), reservation.ID)
+
+	if err != nil {
+		return err
+	}
+
+	return reservation.CheckTables(tables, occupations)
+}
+
+func (s *Service) getOccupations(ctx context.Context, startAt time.Time, endAt time.Time, ignoreID uuid.UUID) ([]reservationentity.Occupation, error) {
+	reservations, err := s.r.GetActiveReservationsByPeriod(ctx, startAt, endAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	openTableOrders, err := s.rto.GetOpenTableOrders(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reservationentity.NewOccupations(reservations, openTableOrders, ignoreID, time.Now()), nil
+}
+
+// fillClient uses the name and contact of the client when they are not informed.
+func (s *Service) fillClient(ctx context.Context, reservation *reservationentity.Reservation) error {
+	if reservation.ClientID == nil {
+		return nil
+	}
+
+	client, err := s.rc.GetClientById(ctx, reservation.ClientID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if reservation.Name == "" {
+		reservation.Name = client.Name
+	}
+
+	if reservation.Contact == "" && client.Contact != nil {
+		reservation.Contact = client.Contact.Ddd + client.Contact.Number
+	}
+
+	return nil
+}
@@ -2,9 +2,13 @@ package shiftusecases
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	shiftentity "github.com/willjrcom/sales-backend-go/internal/domain/shift"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	shiftdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/shift"
@@ -15,22 +19,48 @@ var (
 )
 
 type Service struct {
-	r shiftentity.ShiftRepository
+	r  shiftentity.ShiftRepository
+	rc companyentity.CompanyRepository
 }
 
-func NewService(c shiftentity.ShiftRepository) *Service {
-	return &Service{r: c}
+func NewService(c shiftentity.ShiftRepository, rc companyentity.CompanyRepository) *Service {
+	return &Service{r: c, rc: rc}
 }
 
 func (s *Service) OpenShift(ctx context.Context, dto *shiftdto.OpenShift) (id uuid.UUID, err error) {
+	if employeeID, ok := employeeentity.GetActingEmployeeID(ctx); ok {
+		dto.AttendantID = &employeeID
+	}
+
 	shift, err := dto.ToModel()
 
 	if err != nil {
 		return uuid.Nil, err
 	}
 
+	settings, err := s.rc.GetCompanySettings(ctx)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// The day is the date of the company timezone, today's business day when not sent
+	day := settings.BusinessDay(time.Now())
+	if shift.Day != nil {
+		day = settings.Date(*shift.Day)
+	}
+
+	shift.Day = &day
 	shift.OpenShift()
 
+	previous, err := s.r.GetLastShift(ctx)
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, err
+	}
+
+	shift.ContinueOrderNumber(previous, settings.OrderNumberReset)
+
 	if err = s.r.CreateShift(ctx, shift); err != nil {
 		return uuid.Nil, err
 	}
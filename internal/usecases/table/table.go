@@ -38,6 +38,32 @@ func (s *Service) CreateTable(ctx context.Context, dto *tabledto.CreateTableInpu
 	return table.ID, nil
 }
 
+func (s *Service) UpdateTable(ctx context.Context, dtoId *entitydto.IdRequest, dto *tabledto.UpdateTableInput) error {
+	table, err := s.r.GetTableById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(table); err != nil {
+		return err
+	}
+
+	return s.r.UpdateTable(ctx, table)
+}
+
+func (s *Service) CleanTable(ctx context.Context, dtoId *entitydto.IdRequest) error {
+	table, err := s.r.GetTableById(ctx, dtoId.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	table.CleanTable()
+
+	return s.r.UpdateTable(ctx, table)
+}
+
 func (s *Service) DeleteTable(ctx context.Context, dto *entitydto.IdRequest) error {
 	if _, err := s.r.GetTableById(ctx, dto.ID.String()); err != nil {
 		return err
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
 	tableorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/table_order"
 )
 
@@ -13,6 +14,10 @@ var (
 )
 
 func (s *Service) CreateTableOrder(ctx context.Context, dto *tableorderdto.CreateTableOrderInput) (uuid.UUID, error) {
+	if employeeID, ok := employeeentity.GetActingEmployeeID(ctx); ok {
+		dto.WaiterID = employeeID
+	}
+
 	tableOrder, err := dto.ToModel()
 
 	if err != nil {
@@ -0,0 +1,117 @@
+package tableorderusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	tableorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/table_order"
+)
+
+// MergeTableOrders moves everything from the table order to the target one and releases its table.
+func (s *Service) MergeTableOrders(ctx context.Context, dtoID *entitydto.IdRequest, dto *tableorderdto.MergeTableOrderInput) (*orderentity.TableOrderTransfer, error) {
+	targetTableOrderID, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	source, target, sourceOrder, targetOrder, err := s.getTransferOrders(ctx, dtoID.ID, targetTableOrderID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := sourceOrder.MergeInto(targetOrder, source, target)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result.Transfer.UserID = getUserID(ctx)
+
+	if err := s.rto.SaveTableOrderTransfer(ctx, result, sourceOrder, targetOrder); err != nil {
+		return nil, err
+	}
+
+	table, err := s.rt.GetTableById(ctx, source.TableID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	table.ReleaseTable()
+
+	if err := s.rt.UpdateTable(ctx, table); err != nil {
+		return nil, err
+	}
+
+	if err := s.os.ReleaseReservationTables(ctx, source.ID); err != nil {
+		return nil, err
+	}
+
+	return result.Transfer, nil
+}
+
+// TransferTableOrder moves the groups and items chosen to the target table order.
+func (s *Service) TransferTableOrder(ctx context.Context, dtoID *entitydto.IdRequest, dto *tableorderdto.TransferTableOrderInput) (*orderentity.TableOrderTransfer, error) {
+	targetTableOrderID, groupIDs, itemIDs, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	source, target, sourceOrder, targetOrder, err := s.getTransferOrders(ctx, dtoID.ID, targetTableOrderID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := sourceOrder.TransferTo(targetOrder, source, target, groupIDs, itemIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result.Transfer.UserID = getUserID(ctx)
+
+	if err := s.rto.SaveTableOrderTransfer(ctx, result, sourceOrder, targetOrder); err != nil {
+		return nil, err
+	}
+
+	return result.Transfer, nil
+}
+
+func (s *Service) GetTableOrderTransfers(ctx context.Context, dtoID *entitydto.IdRequest) ([]orderentity.TableOrderTransfer, error) {
+	return s.rto.GetTableOrderTransfers(ctx, dtoID.ID.String())
+}
+
+func (s *Service) getTransferOrders(ctx context.Context, sourceID uuid.UUID, targetID uuid.UUID) (source *orderentity.TableOrder, target *orderentity.TableOrder, sourceOrder *orderentity.Order, targetOrder *orderentity.Order, err error) {
+	if source, err = s.rto.GetTableOrderById(ctx, sourceID.String()); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if target, err = s.rto.GetTableOrderById(ctx, targetID.String()); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if sourceOrder, err = s.os.GetOrderById(ctx, &entitydto.IdRequest{ID: source.OrderID}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if targetOrder, err = s.os.GetOrderById(ctx, &entitydto.IdRequest{ID: target.OrderID}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return source, target, sourceOrder, targetOrder, nil
+}
+
+func getUserID(ctx context.Context) *uuid.UUID {
+	if user, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User); ok {
+		return &user.ID
+	}
+
+	return nil
+}
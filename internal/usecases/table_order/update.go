@@ -49,8 +49,11 @@ func (s *Service) ChangeTable(ctx context.Context, dtoTableOrder *entitydto.IdRe
 
 	tableOrder.TableID = dtoNew.TableID
 
-	return s.rto.UpdateTableOrder(ctx, tableOrder)
+	if err := s.rto.UpdateTableOrder(ctx, tableOrder); err != nil {
+		return err
+	}
 
+	return s.os.ReleaseReservationTables(ctx, tableOrder.ID)
 }
 
 func (s *Service) FinishTableOrder(ctx context.Context, dtoID *entitydto.IdRequest) error {
@@ -60,7 +63,7 @@ func (s *Service) FinishTableOrder(ctx context.Context, dtoID *entitydto.IdReque
 		return err
 	}
 
-	table.UnlockTable()
+	table.ReleaseTable()
 
 	return s.rt.UpdateTable(ctx, table)
 }
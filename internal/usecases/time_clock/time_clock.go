@@ -0,0 +1,322 @@
+package timeclockusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	timeclockentity "github.com/willjrcom/sales-backend-go/internal/domain/time_clock"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	timeclockdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/time_clock"
+	employeeusecases "github.com/willjrcom/sales-backend-go/internal/usecases/employee"
+)
+
+var (
+	ErrEmployeeNotFoundForUser = errors.New("user is not linked to an employee")
+	ErrUserNotFoundInContext   = errors.New("user not found in context")
+)
+
+type Service struct {
+	r   timeclockentity.TimeClockRepository
+	rw  timeclockentity.WorkScheduleRepository
+	re  employeeentity.Repository
+	rc  companyentity.CompanyRepository
+	ses *employeeusecases.Service
+}
+
+func NewService(r timeclockentity.TimeClockRepository, rw timeclockentity.WorkScheduleRepository, re employeeentity.Repository, rc companyentity.CompanyRepository, ses *employeeusecases.Service) *Service {
+	return &Service{r: r, rw: rw, re: re, rc: rc, ses: ses}
+}
+
+// Punch registers the punch of the employee identified by pin, by the terminal or by the user logged.
+func (s *Service) Punch(ctx context.Context, dto *timeclockdto.PunchInput) (*timeclockentity.TimeClockEntry, error) {
+	punchType, employeeID, pin, geofence, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	employee, source, err := s.getPunchEmployee(ctx, employeeID, pin)
+
+	if err != nil {
+		return nil, err
+	}
+
+	last, err := s.r.GetLastEntryByEmployeeId(ctx, employee.ID.String())
+
+	if errors.Is(err, sql.ErrNoRows) {
+		last = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := timeclockentity.ValidateNextPunch(last, punchType); err != nil {
+		return nil, err
+	}
+
+	entry, err := timeclockentity.NewTimeClockEntry(employee.ID, punchType, time.Now(), source, geofence)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.r.CreateEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (s *Service) CreateEntry(ctx context.Context, dto *timeclockdto.CreateEntryInput) (uuid.UUID, error) {
+	entry, reason, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	userID, err := s.checkManager(ctx)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if _, err := s.re.GetEmployeeById(ctx, entry.EmployeeID.String()); err != nil {
+		return uuid.Nil, err
+	}
+
+	correction, err := timeclockentity.CorrectCreate(entry, reason, userID)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.r.CreateCorrectedEntry(ctx, entry, correction); err != nil {
+		return uuid.Nil, err
+	}
+
+	return entry.ID, nil
+}
+
+func (s *Service) UpdateEntry(ctx context.Context, dtoID *entitydto.IdRequest, dto *timeclockdto.UpdateEntryInput) error {
+	userID, err := s.checkManager(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	entry, err := s.r.GetEntryById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	punchType, at, reason, err := dto.ToModel(entry)
+
+	if err != nil {
+		return err
+	}
+
+	correction, err := entry.CorrectUpdate(punchType, at, reason, userID)
+
+	if err != nil {
+		return err
+	}
+
+	return s.r.UpdateCorrectedEntry(ctx, entry, correction)
+}
+
+func (s *Service) VoidEntry(ctx context.Context, dtoID *entitydto.IdRequest, dto *timeclockdto.VoidEntryInput) error {
+	reason, err := dto.ToModel()
+
+	if err != nil {
+		return err
+	}
+
+	userID, err := s.checkManager(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	entry, err := s.r.GetEntryById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	correction, err := entry.CorrectVoid(reason, userID)
+
+	if err != nil {
+		return err
+	}
+
+	return s.r.UpdateCorrectedEntry(ctx, entry, correction)
+}
+
+func (s *Service) GetCorrectionsByEmployeeId(ctx context.Context, dtoID *entitydto.IdRequest) ([]timeclockentity.TimeClockCorrection, error) {
+	if err := s.checkManagerOrSelf(ctx, dtoID.ID); err != nil {
+		return nil, err
+	}
+
+	return s.r.GetCorrectionsByEmployeeId(ctx, dtoID.ID.String())
+}
+
+func (s *Service) GetTimesheets(ctx context.Context, dtoID *entitydto.IdRequest, dto *timeclockdto.TimesheetInput) ([]timeclockentity.DailyTimesheet, error) {
+	if err := s.checkManagerOrSelf(ctx, dtoID.ID); err != nil {
+		return nil, err
+	}
+
+	startAt, endAt, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildTimesheets(ctx, dtoID.ID, startAt, endAt)
+}
+
+// GetMonthlyAttendance returns the attendance of the month and the employee, used by the csv export.
+func (s *Service) GetMonthlyAttendance(ctx context.Context, dtoID *entitydto.IdRequest, dto *timeclockdto.AttendanceInput) (*timeclockentity.MonthlyAttendance, *employeeentity.Employee, error) {
+	if err := s.checkManagerOrSelf(ctx, dtoID.ID); err != nil {
+		return nil, nil, err
+	}
+
+	settings, err := s.rc.GetCompanySettings(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startAt, endAt, err := dto.ToModel(settings.Location())
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	employee, err := s.re.GetEmployeeById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	days, err := s.buildTimesheets(ctx, employee.ID, startAt, endAt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return timeclockentity.NewMonthlyAttendance(employee.ID, startAt, days), employee, nil
+}
+
+func (s *Service) buildTimesheets(ctx context.Context, employeeID uuid.UUID, startAt time.Time, endAt time.Time) ([]timeclockentity.DailyTimesheet, error) {
+	settings, err := s.rc.GetCompanySettings(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The days of the timesheet are the days of the company timezone
+	startAt, endAt = startAt.In(settings.Location()), endAt.In(settings.Location())
+
+	// One more day, so a shift crossing the midnight of the last day is complete
+	entries, err := s.r.GetEntriesByEmployeeId(ctx, employeeID.String(), startAt, endAt.Add(24*time.Hour))
+
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, err := s.rw.GetAllWorkSchedules(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := timeclockentity.FindWorkSchedule(schedules, employeeID)
+
+	if errors.Is(err, timeclockentity.ErrWorkScheduleNotFound) {
+		schedule = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return timeclockentity.BuildTimesheets(entries, schedule, startAt, endAt), nil
+}
+
+func (s *Service) getPunchEmployee(ctx context.Context, employeeID *uuid.UUID, pin string) (*employeeentity.Employee, timeclockentity.PunchSource, error) {
+	if employeeID != nil {
+		employee, err := s.ses.CheckPin(ctx, *employeeID, pin)
+		return employee, timeclockentity.PunchSourcePin, err
+	}
+
+	if actingID, ok := employeeentity.GetActingEmployeeID(ctx); ok {
+		employee, err := s.re.GetEmployeeById(ctx, actingID.String())
+		return employee, timeclockentity.PunchSourceTerminal, err
+	}
+
+	user, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	if !ok {
+		return nil, "", ErrUserNotFoundInContext
+	}
+
+	employee, err := s.re.GetEmployeeByUserId(ctx, user.ID.String())
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", ErrEmployeeNotFoundForUser
+	}
+
+	return employee, timeclockentity.PunchSourceUser, err
+}
+
+func (s *Service) checkManager(ctx context.Context) (uuid.UUID, error) {
+	user, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	if !ok {
+		return uuid.Nil, ErrUserNotFoundInContext
+	}
+
+	if err := s.checkRole(ctx, user.ID, timeclockentity.ErrOnlyManagerCanCorrect); err != nil {
+		return uuid.Nil, err
+	}
+
+	return user.ID, nil
+}
+
+// checkManagerOrSelf lets the employee see their own time clock, the others need a manager.
+func (s *Service) checkManagerOrSelf(ctx context.Context, employeeID uuid.UUID) error {
+	user, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	if !ok {
+		return ErrUserNotFoundInContext
+	}
+
+	employee, err := s.re.GetEmployeeByUserId(ctx, user.ID.String())
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if employee != nil && employee.ID == employeeID {
+		return nil
+	}
+
+	return s.checkRole(ctx, user.ID, timeclockentity.ErrOnlyManagerOrSelf)
+}
+
+func (s *Service) checkRole(ctx context.Context, userID uuid.UUID, errNotAllowed error) error {
+	role, err := s.rc.GetUserRole(ctx, userID)
+
+	if err != nil {
+		return err
+	}
+
+	if role != companyentity.RoleOwner && role != companyentity.RoleManager {
+		return errNotAllowed
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package timeclockusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	timeclockentity "github.com/willjrcom/sales-backend-go/internal/domain/time_clock"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	timeclockdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/time_clock"
+)
+
+func (s *Service) CreateWorkSchedule(ctx context.Context, dto *timeclockdto.RegisterWorkScheduleInput) (uuid.UUID, error) {
+	schedule, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.rw.CreateWorkSchedule(ctx, schedule); err != nil {
+		return uuid.Nil, err
+	}
+
+	return schedule.ID, nil
+}
+
+func (s *Service) UpdateWorkSchedule(ctx context.Context, dtoID *entitydto.IdRequest, dto *timeclockdto.UpdateWorkScheduleInput) error {
+	schedule, err := s.rw.GetWorkScheduleById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(schedule); err != nil {
+		return err
+	}
+
+	return s.rw.UpdateWorkSchedule(ctx, schedule)
+}
+
+func (s *Service) DeleteWorkSchedule(ctx context.Context, dtoID *entitydto.IdRequest) error {
+	if _, err := s.rw.GetWorkScheduleById(ctx, dtoID.ID.String()); err != nil {
+		return err
+	}
+
+	return s.rw.DeleteWorkSchedule(ctx, dtoID.ID.String())
+}
+
+func (s *Service) GetAllWorkSchedules(ctx context.Context) ([]timeclockentity.WorkSchedule, error) {
+	return s.rw.GetAllWorkSchedules(ctx)
+}
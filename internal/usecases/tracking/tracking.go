@@ -0,0 +1,51 @@
+package trackingusecases
+
+import (
+	"context"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	trackingentity "github.com/willjrcom/sales-backend-go/internal/domain/tracking"
+	trackingdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/tracking"
+)
+
+type Service struct {
+	rt  trackingentity.Repository
+	rdo orderentity.DeliveryOrderRepository
+	ro  orderentity.OrderRepository
+}
+
+func NewService(rt trackingentity.Repository, rdo orderentity.DeliveryOrderRepository, ro orderentity.OrderRepository) *Service {
+	return &Service{rt: rt, rdo: rdo, ro: ro}
+}
+
+// GetTracking is called without authentication, the schema comes from the token.
+func (s *Service) GetTracking(ctx context.Context, token string) (*trackingdto.TrackingOutput, error) {
+	trackingToken, err := s.rt.GetTrackingTokenByToken(ctx, token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if trackingToken.IsExpired() {
+		return nil, trackingentity.ErrTrackingTokenExpired
+	}
+
+	ctx = context.WithValue(ctx, schemaentity.Schema("schema"), trackingToken.SchemaName)
+
+	delivery, err := s.rdo.GetDeliveryById(ctx, trackingToken.DeliveryID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.ro.GetOrderById(ctx, trackingToken.OrderID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &trackingdto.TrackingOutput{}
+	output.FromModel(order, delivery)
+	return output, nil
+}
@@ -0,0 +1,80 @@
+package trackingusecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	schemaentity "github.com/willjrcom/sales-backend-go/internal/domain/schema"
+	trackingentity "github.com/willjrcom/sales-backend-go/internal/domain/tracking"
+	trackingrepositorylocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/tracking"
+)
+
+type deliveryRepository struct {
+	orderentity.DeliveryOrderRepository
+	deliveries map[uuid.UUID]*orderentity.DeliveryOrder
+	schemas    []string
+}
+
+func (r *deliveryRepository) GetDeliveryById(ctx context.Context, id string) (*orderentity.DeliveryOrder, error) {
+	r.schemas = append(r.schemas, ctx.Value(schemaentity.Schema("schema")).(string))
+	return r.deliveries[uuid.MustParse(id)], nil
+}
+
+type orderRepository struct {
+	orderentity.OrderRepository
+	order *orderentity.Order
+}
+
+func (r *orderRepository) GetOrderById(_ context.Context, _ string) (*orderentity.Order, error) {
+	return r.order, nil
+}
+
+func TestTrackingTokenCreateAndLookup(t *testing.T) {
+	ctx := context.Background()
+	rt := trackingrepositorylocal.NewTrackingTokenRepositoryLocal()
+
+	order := &orderentity.Order{Entity: entity.NewEntity()}
+	order.OrderNumber = 7
+
+	delivery := &orderentity.DeliveryOrder{Entity: entity.NewEntity()}
+	delivery.OrderID = order.ID
+	delivery.Status = orderentity.DeliveryOrderStatusShipped
+
+	token, err := trackingentity.NewTrackingToken("loja_teste", delivery.ID, delivery.OrderID)
+	assert.Nil(t, err)
+	assert.Len(t, token.Token, 64)
+
+	other, err := trackingentity.NewTrackingToken("loja_teste", delivery.ID, delivery.OrderID)
+	assert.Nil(t, err)
+	assert.NotEqual(t, token.Token, other.Token)
+
+	assert.Nil(t, rt.CreateTrackingTokenWithDelivery(ctx, delivery, token))
+	assert.Equal(t, delivery, rt.Deliveries[delivery.ID])
+
+	rdo := &deliveryRepository{deliveries: rt.Deliveries}
+	service := NewService(rt, rdo, &orderRepository{order: order})
+
+	// The schema of the company comes from the token
+	output, err := service.GetTracking(ctx, token.Token)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"loja_teste"}, rdo.schemas)
+	assert.Equal(t, 7, output.OrderNumber)
+	assert.Equal(t, trackingentity.StageOnTheWay, output.Stage)
+
+	byDelivery, err := rt.GetTrackingTokenByDeliveryId(ctx, delivery.ID.String())
+	assert.Nil(t, err)
+	assert.Equal(t, token.ID, byDelivery.ID)
+
+	_, err = service.GetTracking(ctx, "unknown")
+	assert.NotNil(t, err)
+
+	token.ExpiresAt = &time.Time{}
+	*token.ExpiresAt = time.Now().Add(-time.Minute)
+	_, err = service.GetTracking(ctx, token.Token)
+	assert.ErrorIs(t, err, trackingentity.ErrTrackingTokenExpired)
+}
@@ -0,0 +1,252 @@
+package userusecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	userdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/user"
+	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+	totpservice "github.com/willjrcom/sales-backend-go/internal/infra/service/totp"
+)
+
+// TwoFactorIssuer is the name shown by the authenticator apps.
+const TwoFactorIssuer = "Sales"
+
+// LoginTwoFactor is the second step of the login, it accepts a TOTP code or a recovery code.
+func (s *Service) LoginTwoFactor(ctx context.Context, dto *userdto.TwoFactorLoginInput, userAgent string, ipAddress string) (*userdto.TokenAndSchemasOutput, error) {
+	tokenString, code, recoveryCode, err := dto.ToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := jwtservice.ValidateUserToken(tokenString, jwtservice.SubjectTwoFactor)
+	if err != nil {
+		return nil, companyentity.ErrUserTokenInvalid
+	}
+
+	userID, err := jwtservice.GetUserIDFromToken(token)
+	if err != nil {
+		return nil, companyentity.ErrUserTokenInvalid
+	}
+
+	lockoutKey := "two-factor:" + userID.String()
+	if err := s.l.Check(lockoutKey, time.Now()); err != nil {
+		return nil, err
+	}
+
+	user, err := s.r.GetUserById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSecondFactor(ctx, user, code, recoveryCode); err != nil {
+		if isInvalidSecondFactor(err) {
+			s.l.Fail(lockoutKey, time.Now())
+		}
+
+		return nil, err
+	}
+
+	s.l.Reset(lockoutKey)
+
+	now := time.Now()
+	return s.startSession(ctx, user, &now, userAgent, ipAddress)
+}
+
+func isInvalidSecondFactor(err error) bool {
+	return errors.Is(err, companyentity.ErrTwoFactorCodeInvalid) ||
+		errors.Is(err, companyentity.ErrTwoFactorCodeReused) ||
+		errors.Is(err, companyentity.ErrRecoveryCodeInvalid)
+}
+
+// checkSecondFactor validates the code and saves the used step or the consumed recovery code.
+func (s *Service) checkSecondFactor(ctx context.Context, user *companyentity.User, code string, recoveryCode string) error {
+	if !user.IsTwoFactorEnabled() {
+		return companyentity.ErrTwoFactorNotEnabled
+	}
+
+	if recoveryCode != "" {
+		if err := user.UseRecoveryCode(recoveryCode); err != nil {
+			return err
+		}
+	} else {
+		step, ok := totpservice.Validate(user.TwoFactorSecret, code, time.Now())
+		if !ok {
+			return companyentity.ErrTwoFactorCodeInvalid
+		}
+
+		if err := user.UseTwoFactorStep(step); err != nil {
+			return err
+		}
+	}
+
+	return s.r.UpdateUserTwoFactor(ctx, user)
+}
+
+// StartTwoFactorEnrollment generates a new secret, it is only enabled after ConfirmTwoFactorEnrollment.
+func (s *Service) StartTwoFactorEnrollment(ctx context.Context, accessToken *jwt.Token) (*userdto.TwoFactorEnrollmentOutput, error) {
+	user, _, err := s.getUserFromAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totpservice.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := user.StartTwoFactorEnrollment(secret); err != nil {
+		return nil, err
+	}
+
+	if err := s.r.UpdateUserTwoFactor(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &userdto.TwoFactorEnrollmentOutput{
+		Secret: secret,
+		URI:    totpservice.URI(TwoFactorIssuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTwoFactorEnrollment enables the factor, the current session counts as verified and gets a new access token.
+func (s *Service) ConfirmTwoFactorEnrollment(ctx context.Context, dto *userdto.TwoFactorCodeInput, accessToken *jwt.Token) (*userdto.TwoFactorRecoveryCodesOutput, error) {
+	code, _, err := dto.ToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	user, session, err := s.getUserFromAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.TwoFactorSecret == "" {
+		return nil, companyentity.ErrTwoFactorNotEnrolling
+	}
+
+	step, ok := totpservice.Validate(user.TwoFactorSecret, code, time.Now())
+	if !ok {
+		return nil, companyentity.ErrTwoFactorCodeInvalid
+	}
+
+	recoveryCodes, err := user.ConfirmTwoFactor(step)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.r.UpdateUserTwoFactor(ctx, user); err != nil {
+		return nil, err
+	}
+
+	session.VerifyTwoFactor()
+	if err := s.rs.UpdateSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	newAccessToken, err := jwtservice.CreateAccessToken(user, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdto.TwoFactorRecoveryCodesOutput{
+		RecoveryCodes: recoveryCodes,
+		AccessToken:   newAccessToken,
+	}, nil
+}
+
+// DisableTwoFactor is refused while a company of the user requires it for their role.
+func (s *Service) DisableTwoFactor(ctx context.Context, dto *userdto.TwoFactorCodeInput, accessToken *jwt.Token) error {
+	code, recoveryCode, err := dto.ToModel()
+	if err != nil {
+		return err
+	}
+
+	user, _, err := s.getUserFromAccessToken(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+
+	if user.RequiresTwoFactor() {
+		return companyentity.ErrTwoFactorRequiredByRole
+	}
+
+	if err := s.checkSecondFactor(ctx, user, code, recoveryCode); err != nil {
+		return err
+	}
+
+	user.DisableTwoFactor()
+	return s.r.UpdateUserTwoFactor(ctx, user)
+}
+
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, dto *userdto.TwoFactorCodeInput, accessToken *jwt.Token) (*userdto.TwoFactorRecoveryCodesOutput, error) {
+	code, recoveryCode, err := dto.ToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	user, _, err := s.getUserFromAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSecondFactor(ctx, user, code, recoveryCode); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := user.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.r.UpdateUserTwoFactor(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &userdto.TwoFactorRecoveryCodesOutput{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *Service) checkTwoFactorPolicy(ctx context.Context, accessToken *jwt.Token, schema string) error {
+	userID, err := jwtservice.GetUserIDFromToken(accessToken)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.r.GetUserById(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.RequiresTwoFactorFor(schema) {
+		return companyentity.ErrTwoFactorRequired
+	}
+
+	return nil
+}
+
+// getUserFromAccessToken is used by the routes called before choosing a company, they receive the access token.
+func (s *Service) getUserFromAccessToken(ctx context.Context, accessToken *jwt.Token) (*companyentity.User, *companyentity.UserSession, error) {
+	sessionID, err := jwtservice.GetSessionIDFromToken(accessToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session, err := s.rs.GetSessionById(ctx, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !session.IsActive() {
+		return nil, nil, companyentity.ErrSessionRevoked
+	}
+
+	user, err := s.r.GetUserById(ctx, session.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, session, nil
+}
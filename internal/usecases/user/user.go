@@ -2,8 +2,10 @@ package userusecases
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/google/uuid"
@@ -11,31 +13,61 @@ import (
 	userdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/user"
 	bcryptservice "github.com/willjrcom/sales-backend-go/internal/infra/service/bcrypt"
 	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+	mailerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/mailer"
+	ratelimitservice "github.com/willjrcom/sales-backend-go/internal/infra/service/ratelimit"
 )
 
 type Service struct {
-	r companyentity.UserRepository
+	r  companyentity.UserRepository
+	rs companyentity.UserSessionRepository
+	m  mailerservice.Mailer
+	t  *mailerservice.Templates
+	l  *ratelimitservice.Lockout
+	la *ratelimitservice.Lockout
 }
 
-func NewService(r companyentity.UserRepository) *Service {
-	return &Service{r: r}
+func NewService(r companyentity.UserRepository, rs companyentity.UserSessionRepository, m mailerservice.Mailer, t *mailerservice.Templates, l *ratelimitservice.Lockout, la *ratelimitservice.Lockout) *Service {
+	return &Service{r: r, rs: rs, m: m, t: t, l: l, la: la}
 }
 
 func (s *Service) CreateUser(ctx context.Context, dto *userdto.CreateUserInput) (uuid.UUID, error) {
+	return s.createUser(ctx, dto, "")
+}
+
+// InviteUser creates the user with a generated password and sends the link to set their own.
+func (s *Service) InviteUser(ctx context.Context, email string, companyName string) (uuid.UUID, error) {
+	dto := &userdto.CreateUserInput{
+		UserCommonAttributes: companyentity.UserCommonAttributes{Email: email},
+		GeneratePassword:     true,
+	}
+
+	return s.createUser(ctx, dto, companyName)
+}
+
+func (s *Service) createUser(ctx context.Context, dto *userdto.CreateUserInput, companyName string) (uuid.UUID, error) {
 	user, err := dto.ToModel()
 
 	if err != nil {
 		return uuid.Nil, err
 	}
 
-	hash, err := bcryptservice.HashPassword(dto.Password)
+	hash, err := bcryptservice.HashPassword(user.Password)
 	if err != nil {
 		return uuid.Nil, err
 	}
 
 	user.Hash = string(hash)
 
-	return user.ID, s.r.CreateUser(ctx, user)
+	if err := s.r.CreateUser(ctx, user); err != nil {
+		return uuid.Nil, err
+	}
+
+	// The user is already created, the id is returned with the error of the email
+	if user.MustChangePassword {
+		return user.ID, s.sendInvite(ctx, user, companyName)
+	}
+
+	return user.ID, s.sendEmailVerification(ctx, user)
 }
 
 func (s *Service) UpdateUser(ctx context.Context, dto *userdto.UpdatePasswordInput) error {
@@ -55,33 +87,84 @@ func (s *Service) UpdateUser(ctx context.Context, dto *userdto.UpdatePasswordInp
 		return err
 	}
 
-	userLoggedIn.Hash = string(hash)
+	userLoggedIn.SetPassword(string(hash))
 
-	return s.r.UpdateUser(ctx, user)
+	return s.r.UpdateUserPassword(ctx, userLoggedIn)
 }
 
-func (s *Service) LoginUser(ctx context.Context, dto *userdto.LoginUserInput) (data *userdto.TokenAndSchemasOutput, err error) {
+func (s *Service) LoginUser(ctx context.Context, dto *userdto.LoginUserInput, userAgent string, ipAddress string) (data *userdto.TokenAndSchemasOutput, err error) {
 	user, err := dto.ToModel()
 
 	if err != nil {
 		return nil, err
 	}
 
+	// The lockout by account and ip stops a single address, the one by account with a higher threshold
+	// stops the attempts spread over many addresses
+	accountKey := "login:" + strings.ToLower(user.Email)
+	lockoutKey := accountKey + ":" + ipAddress
+	if err := s.l.Check(lockoutKey, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.la.Check(accountKey, time.Now()); err != nil {
+		return nil, err
+	}
+
 	userLoggedIn, err := s.r.LoginUser(ctx, user)
 
+	if errors.Is(err, sql.ErrNoRows) {
+		s.l.Fail(lockoutKey, time.Now())
+		s.la.Fail(accountKey, time.Now())
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	s.l.Reset(lockoutKey)
+	s.la.Reset(accountKey)
+
+	// With the second factor enabled the tokens are only issued by LoginTwoFactor
+	if userLoggedIn.IsTwoFactorEnabled() {
+		twoFactorToken, err := jwtservice.CreateUserToken(userLoggedIn, jwtservice.SubjectTwoFactor, jwtservice.TwoFactorExpiration)
+		if err != nil {
+			return nil, err
+		}
+
+		return &userdto.TokenAndSchemasOutput{
+			TwoFactorRequired: true,
+			TwoFactorToken:    twoFactorToken,
+		}, nil
+	}
+
+	return s.startSession(ctx, userLoggedIn, nil, userAgent, ipAddress)
+}
+
+// startSession creates the session with its first refresh token, twoFactorAt is set when the login used the second factor.
+func (s *Service) startSession(ctx context.Context, user *companyentity.User, twoFactorAt *time.Time, userAgent string, ipAddress string) (*userdto.TokenAndSchemasOutput, error) {
+	session := companyentity.NewUserSession(user.ID, userAgent, ipAddress)
+	session.TwoFactorAt = twoFactorAt
+	refreshToken, plainRefreshToken, err := session.NewRefreshToken()
+
 	if err != nil {
 		return nil, err
 	}
 
-	accessToken, err := jwtservice.CreateAccessToken(userLoggedIn)
+	if err := s.rs.CreateSession(ctx, session, refreshToken); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := jwtservice.CreateAccessToken(user, session)
 
 	if err != nil {
 		return nil, err
 	}
 
-	data = &userdto.TokenAndSchemasOutput{
-		AccessToken: accessToken,
-		Companies:   userLoggedIn.Companies,
+	data := &userdto.TokenAndSchemasOutput{
+		AccessToken:        accessToken,
+		RefreshToken:       plainRefreshToken,
+		MustChangePassword: user.MustChangePassword,
+		Companies:          user.Companies,
 	}
 	return data, nil
 }
@@ -93,6 +176,19 @@ func (s *Service) Access(ctx context.Context, dto *userdto.AccessCompanyInput, a
 		return "", err
 	}
 
+	sessionID, err := jwtservice.GetSessionIDFromToken(accessToken)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := s.ValidateSession(ctx, sessionID); err != nil {
+		return "", err
+	}
+
+	if jwtservice.MustChangePasswordFromToken(accessToken) {
+		return "", companyentity.ErrPasswordChangeRequired
+	}
+
 	schemasInterface := jwtservice.GetSchemasFromToken(accessToken)
 
 	if len(schemasInterface) == 0 {
@@ -103,6 +199,12 @@ func (s *Service) Access(ctx context.Context, dto *userdto.AccessCompanyInput, a
 		return "", errors.New("schema not found in schemas in token")
 	}
 
+	if !jwtservice.IsTwoFactorVerifiedFromToken(accessToken) {
+		if err := s.checkTwoFactorPolicy(ctx, accessToken, *schema); err != nil {
+			return "", err
+		}
+	}
+
 	return jwtservice.CreateIDToken(accessToken, *schema)
 }
 
@@ -0,0 +1,147 @@
+package userusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/dgrijalva/jwt-go"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	userdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/user"
+	bcryptservice "github.com/willjrcom/sales-backend-go/internal/infra/service/bcrypt"
+	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+)
+
+func (s *Service) sendEmailVerification(ctx context.Context, user *companyentity.User) error {
+	token, err := jwtservice.CreateUserToken(user, jwtservice.SubjectEmailVerification, jwtservice.EmailVerificationExpiration)
+	if err != nil {
+		return err
+	}
+
+	return s.m.Send(ctx, s.t.EmailVerification(user.Email, token))
+}
+
+// sendInvite uses a reset token with a longer expiration, the link sets the first password.
+func (s *Service) sendInvite(ctx context.Context, user *companyentity.User, companyName string) error {
+	token, err := jwtservice.CreateUserToken(user, jwtservice.SubjectPasswordReset, jwtservice.InviteExpiration)
+	if err != nil {
+		return err
+	}
+
+	return s.m.Send(ctx, s.t.Invite(user.Email, companyName, token))
+}
+
+func (s *Service) NotifyAddedToCompany(ctx context.Context, email string, companyName string) error {
+	return s.m.Send(ctx, s.t.AddedToCompany(email, companyName))
+}
+
+func (s *Service) SendEmailVerification(ctx context.Context) error {
+	userLogged, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	if !ok {
+		return ErrUserNotFoundInContext
+	}
+
+	user, err := s.r.GetUserById(ctx, userLogged.ID)
+	if err != nil {
+		return err
+	}
+
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	return s.sendEmailVerification(ctx, user)
+}
+
+func (s *Service) VerifyEmail(ctx context.Context, dto *userdto.VerifyEmailInput) error {
+	tokenString, err := dto.ToModel()
+	if err != nil {
+		return err
+	}
+
+	token, user, err := s.getUserFromToken(ctx, tokenString, jwtservice.SubjectEmailVerification)
+	if err != nil {
+		return err
+	}
+
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	if err := jwtservice.CheckUserToken(token, user); err != nil {
+		return err
+	}
+
+	user.VerifyEmail()
+	return s.r.UpdateUserEmailVerified(ctx, user)
+}
+
+// ForgotPassword answers the same way when the email does not exist, so emails can't be discovered.
+func (s *Service) ForgotPassword(ctx context.Context, dto *userdto.ForgotPasswordInput) error {
+	email, err := dto.ToModel()
+	if err != nil {
+		return err
+	}
+
+	user, err := s.r.GetUserByEmail(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	token, err := jwtservice.CreateUserToken(user, jwtservice.SubjectPasswordReset, jwtservice.PasswordResetExpiration)
+	if err != nil {
+		return err
+	}
+
+	return s.m.Send(ctx, s.t.PasswordReset(user.Email, token))
+}
+
+// ResetPassword also confirms the email, the link was opened from the inbox, and ends every session.
+func (s *Service) ResetPassword(ctx context.Context, dto *userdto.ResetPasswordInput) error {
+	tokenString, newPassword, err := dto.ToModel()
+	if err != nil {
+		return err
+	}
+
+	token, user, err := s.getUserFromToken(ctx, tokenString, jwtservice.SubjectPasswordReset)
+	if err != nil {
+		return err
+	}
+
+	if err := jwtservice.CheckUserToken(token, user); err != nil {
+		return err
+	}
+
+	hash, err := bcryptservice.HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+
+	user.SetPassword(string(hash))
+	user.VerifyEmail()
+
+	if err := s.r.UpdateUserPassword(ctx, user); err != nil {
+		return err
+	}
+
+	return s.rs.RevokeUserSessions(ctx, user.ID, companyentity.RevokedByReset)
+}
+
+func (s *Service) getUserFromToken(ctx context.Context, tokenString string, subject string) (*jwt.Token, *companyentity.User, error) {
+	token, err := jwtservice.ValidateUserToken(tokenString, subject)
+	if err != nil {
+		return nil, nil, companyentity.ErrUserTokenInvalid
+	}
+
+	user, err := s.r.GetUserByEmail(ctx, jwtservice.GetEmailFromToken(token))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, companyentity.ErrUserTokenInvalid
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	return token, user, nil
+}
@@ -0,0 +1,164 @@
+package userusecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	userdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/user"
+	jwtservice "github.com/willjrcom/sales-backend-go/internal/infra/service/jwt"
+)
+
+var (
+	ErrUserNotFoundInContext    = errors.New("user not found in context")
+	ErrSessionNotFoundInContext = errors.New("session not found in context")
+)
+
+// RefreshToken rotates the refresh token and issues a new access token for the same session.
+func (s *Service) RefreshToken(ctx context.Context, dto *userdto.RefreshTokenInput) (*userdto.TokenAndSchemasOutput, error) {
+	plain, err := dto.ToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	used, err := s.rs.GetRefreshTokenByHash(ctx, companyentity.HashRefreshToken(plain))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, companyentity.ErrRefreshTokenInvalid
+	} else if err != nil {
+		return nil, err
+	}
+
+	session, err := s.rs.GetSessionById(ctx, used.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	next, plainNext, err := session.Rotate(used)
+	if errors.Is(err, companyentity.ErrRefreshTokenReused) {
+		return nil, s.revokeReusedSession(ctx, session)
+	} else if err != nil {
+		return nil, err
+	}
+
+	user, err := s.r.GetUserById(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rs.RotateRefreshToken(ctx, session, used, next); errors.Is(err, companyentity.ErrRefreshTokenReused) {
+		return nil, s.revokeReusedSession(ctx, session)
+	} else if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := jwtservice.CreateAccessToken(user, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userdto.TokenAndSchemasOutput{
+		AccessToken:        accessToken,
+		RefreshToken:       plainNext,
+		MustChangePassword: user.MustChangePassword,
+		Companies:          user.Companies,
+	}, nil
+}
+
+func (s *Service) revokeReusedSession(ctx context.Context, session *companyentity.UserSession) error {
+	session.Revoke(companyentity.RevokedByReuse)
+
+	if err := s.rs.UpdateSession(ctx, session); err != nil {
+		return err
+	}
+
+	return companyentity.ErrRefreshTokenReused
+}
+
+// ValidateSession is used by the auth middleware to reject tokens of revoked sessions.
+func (s *Service) ValidateSession(ctx context.Context, sessionID uuid.UUID) (*companyentity.UserSession, error) {
+	session, err := s.rs.GetSessionById(ctx, sessionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, companyentity.ErrSessionRevoked
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !session.IsActive() {
+		return nil, companyentity.ErrSessionRevoked
+	}
+
+	return session, nil
+}
+
+func (s *Service) Logout(ctx context.Context) error {
+	sessionID, ok := ctx.Value(companyentity.SessionValue("session")).(uuid.UUID)
+
+	if !ok {
+		return ErrSessionNotFoundInContext
+	}
+
+	session, err := s.rs.GetSessionById(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	session.Revoke(companyentity.RevokedByLogout)
+	return s.rs.UpdateSession(ctx, session)
+}
+
+func (s *Service) LogoutAll(ctx context.Context) error {
+	user, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	if !ok {
+		return ErrUserNotFoundInContext
+	}
+
+	return s.rs.RevokeUserSessions(ctx, user.ID, companyentity.RevokedByLogoutAll)
+}
+
+func (s *Service) GetSessions(ctx context.Context) ([]userdto.UserSessionOutput, error) {
+	user, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	if !ok {
+		return nil, ErrUserNotFoundInContext
+	}
+
+	currentSessionID, _ := ctx.Value(companyentity.SessionValue("session")).(uuid.UUID)
+
+	sessions, err := s.rs.GetActiveSessionsByUserId(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []userdto.UserSessionOutput{}
+	for _, session := range sessions {
+		output := userdto.UserSessionOutput{}
+		output.FromModel(&session, currentSessionID)
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
+
+func (s *Service) RevokeSession(ctx context.Context, dtoId *entitydto.IdRequest) error {
+	user, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	if !ok {
+		return ErrUserNotFoundInContext
+	}
+
+	session, err := s.rs.GetSessionById(ctx, dtoId.ID)
+	if err != nil {
+		return err
+	}
+
+	if session.UserID != user.ID {
+		return companyentity.ErrSessionNotFromUser
+	}
+
+	session.Revoke(companyentity.RevokedByUser)
+	return s.rs.UpdateSession(ctx, session)
+}
@@ -0,0 +1,49 @@
+package userusecases
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	userdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/user"
+	ratelimitservice "github.com/willjrcom/sales-backend-go/internal/infra/service/ratelimit"
+)
+
+type userRepository struct {
+	companyentity.UserRepository
+}
+
+func (r *userRepository) LoginUser(_ context.Context, _ *companyentity.User) (*companyentity.User, error) {
+	return nil, sql.ErrNoRows
+}
+
+func TestLoginUserLockout(t *testing.T) {
+	store := ratelimitservice.NewMemoryStore()
+	service := NewService(&userRepository{}, nil, nil, nil, ratelimitservice.NewDefaultLockout(store), ratelimitservice.NewAccountLockout(store))
+
+	input := &userdto.LoginUserInput{UserCommonAttributes: companyentity.UserCommonAttributes{Email: "owner@email.com", Password: "wrong"}}
+
+	// A single address is locked first
+	for i := 0; i < ratelimitservice.DefaultLockoutThreshold; i++ {
+		_, err := service.LoginUser(context.Background(), input, "test", "10.0.0.1")
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	}
+
+	_, err := service.LoginUser(context.Background(), input, "test", "10.0.0.1")
+	assert.ErrorIs(t, err, ratelimitservice.ErrLocked)
+
+	_, err = service.LoginUser(context.Background(), input, "test", "10.0.0.2")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// The failures spread over many addresses lock the account
+	for i := ratelimitservice.DefaultLockoutThreshold + 1; i < ratelimitservice.AccountLockoutThreshold; i++ {
+		_, err := service.LoginUser(context.Background(), input, "test", fmt.Sprintf("10.0.1.%d", i))
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	}
+
+	_, err = service.LoginUser(context.Background(), input, "test", "10.0.2.1")
+	assert.ErrorIs(t, err, ratelimitservice.ErrLocked)
+}
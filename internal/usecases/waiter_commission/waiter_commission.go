@@ -0,0 +1,258 @@
+package waitercommissionusecases
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	employeeentity "github.com/willjrcom/sales-backend-go/internal/domain/employee"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	waitercommissionentity "github.com/willjrcom/sales-backend-go/internal/domain/waiter_commission"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	waitercommissiondto "github.com/willjrcom/sales-backend-go/internal/infra/dto/waiter_commission"
+)
+
+var (
+	ErrUserNotFoundInContext = errors.New("user not found in context")
+)
+
+type Service struct {
+	r   waitercommissionentity.WaiterCommissionRepository
+	rr  waitercommissionentity.CommissionRuleRepository
+	rto orderentity.TableOrderRepository
+	ro  orderentity.OrderRepository
+	re  employeeentity.Repository
+	rc  companyentity.CompanyRepository
+}
+
+func NewService(r waitercommissionentity.WaiterCommissionRepository, rr waitercommissionentity.CommissionRuleRepository, rto orderentity.TableOrderRepository, ro orderentity.OrderRepository, re employeeentity.Repository, rc companyentity.CompanyRepository) *Service {
+	return &Service{r: r, rr: rr, rto: rto, ro: ro, re: re, rc: rc}
+}
+
+func (s *Service) CreateCommissionRule(ctx context.Context, dto *waitercommissiondto.RegisterCommissionRuleInput) (uuid.UUID, error) {
+	// A service charge rule without rate uses the default service charge of the company
+	if dto.Type == waitercommissionentity.CommissionTypeServiceCharge && dto.ServiceChargeRate == 0 {
+		settings, err := s.rc.GetCompanySettings(ctx)
+
+		if err != nil {
+			return uuid.Nil, err
+		}
+
+		dto.ServiceChargeRate = settings.ServiceCharge
+	}
+
+	rule, err := dto.ToModel()
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.rr.CreateCommissionRule(ctx, rule); err != nil {
+		return uuid.Nil, err
+	}
+
+	return rule.ID, nil
+}
+
+func (s *Service) UpdateCommissionRule(ctx context.Context, dtoID *entitydto.IdRequest, dto *waitercommissiondto.UpdateCommissionRuleInput) error {
+	rule, err := s.rr.GetCommissionRuleById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := dto.UpdateModel(rule); err != nil {
+		return err
+	}
+
+	return s.rr.UpdateCommissionRule(ctx, rule)
+}
+
+func (s *Service) DeleteCommissionRule(ctx context.Context, dtoID *entitydto.IdRequest) error {
+	if _, err := s.rr.GetCommissionRuleById(ctx, dtoID.ID.String()); err != nil {
+		return err
+	}
+
+	return s.rr.DeleteCommissionRule(ctx, dtoID.ID.String())
+}
+
+func (s *Service) GetAllCommissionRules(ctx context.Context) ([]waitercommissionentity.CommissionRule, error) {
+	return s.rr.GetAllCommissionRules(ctx)
+}
+
+// PreviewWaiterCommission computes the commission without saving it.
+func (s *Service) PreviewWaiterCommission(ctx context.Context, dto *waitercommissiondto.WaiterCommissionInput) (*waitercommissionentity.WaiterCommission, error) {
+	return s.calculateWaiterCommission(ctx, dto)
+}
+
+func (s *Service) CreateWaiterCommission(ctx context.Context, dto *waitercommissiondto.WaiterCommissionInput) (uuid.UUID, error) {
+	commission, err := s.calculateWaiterCommission(ctx, dto)
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if commission.TotalTables == 0 {
+		return uuid.Nil, waitercommissionentity.ErrCommissionWithoutTables
+	}
+
+	if err := s.r.CreateWaiterCommission(ctx, commission); err != nil {
+		return uuid.Nil, err
+	}
+
+	return commission.ID, nil
+}
+
+func (s *Service) CloseWaiterCommission(ctx context.Context, dtoID *entitydto.IdRequest, dto *waitercommissiondto.CloseWaiterCommissionInput) error {
+	observation := dto.ToModel()
+
+	user, ok := ctx.Value(companyentity.UserValue("user")).(companyentity.User)
+
+	if !ok {
+		return ErrUserNotFoundInContext
+	}
+
+	commission, err := s.r.GetWaiterCommissionById(ctx, dtoID.ID.String())
+
+	if err != nil {
+		return err
+	}
+
+	if err := commission.Close(user.ID, observation); err != nil {
+		return err
+	}
+
+	return s.r.UpdateWaiterCommission(ctx, commission)
+}
+
+func (s *Service) GetWaiterCommissionById(ctx context.Context, dtoID *entitydto.IdRequest) (*waitercommissionentity.WaiterCommission, error) {
+	return s.r.GetWaiterCommissionById(ctx, dtoID.ID.String())
+}
+
+func (s *Service) GetAllWaiterCommissions(ctx context.Context) ([]waitercommissionentity.WaiterCommission, error) {
+	return s.r.GetAllWaiterCommissions(ctx)
+}
+
+func (s *Service) GetWaiterCommissionsByWaiterId(ctx context.Context, dtoID *entitydto.IdRequest) ([]waitercommissionentity.WaiterCommission, error) {
+	return s.r.GetWaiterCommissionsByWaiterId(ctx, dtoID.ID.String())
+}
+
+// GetWaiterReport returns the performance of each waiter with tables finished in the period.
+func (s *Service) GetWaiterReport(ctx context.Context, dto *waitercommissiondto.WaiterReportInput) ([]waitercommissionentity.WaiterReport, error) {
+	startAt, endAt, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := s.rto.GetFinishedTableOrders(ctx, startAt, endAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.rr.GetAllCommissionRules(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	reports := map[uuid.UUID]*waitercommissionentity.WaiterReport{}
+
+	for i := range tables {
+		order, err := s.ro.GetOrderById(ctx, tables[i].OrderID.String())
+
+		if err != nil {
+			return nil, err
+		}
+
+		report, ok := reports[tables[i].WaiterID]
+		if !ok {
+			report = waitercommissionentity.NewWaiterReport(tables[i].WaiterID, tables[i].Waiter)
+			reports[tables[i].WaiterID] = report
+		}
+
+		// Waiters without rule still have the performance, only without commission
+		rule, _ := waitercommissionentity.FindCommissionRule(rules, tables[i].WaiterID)
+		report.AddTable(&tables[i], order, rule)
+	}
+
+	output := []waitercommissionentity.WaiterReport{}
+	for _, report := range reports {
+		output = append(output, *report)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].TotalSales > output[j].TotalSales
+	})
+
+	return output, nil
+}
+
+func (s *Service) calculateWaiterCommission(ctx context.Context, dto *waitercommissiondto.WaiterCommissionInput) (*waitercommissionentity.WaiterCommission, error) {
+	waiterID, startAt, endAt, err := dto.ToModel()
+
+	if err != nil {
+		return nil, err
+	}
+
+	waiter, err := s.re.GetEmployeeById(ctx, waiterID.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.rr.GetAllCommissionRules(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := waitercommissionentity.FindCommissionRule(rules, waiterID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := s.getTablesToCommission(ctx, waiterID, startAt, endAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	commission := waitercommissionentity.NewWaiterCommission(waiterID, startAt, endAt, rule)
+	commission.Waiter = waiter
+
+	for i := range tables {
+		order, err := s.ro.GetOrderById(ctx, tables[i].OrderID.String())
+
+		if err != nil {
+			return nil, err
+		}
+
+		commission.AddTable(&tables[i], order)
+	}
+
+	return commission, nil
+}
+
+// getTablesToCommission returns the tables of the waiter not paid by another commission.
+func (s *Service) getTablesToCommission(ctx context.Context, waiterID uuid.UUID, startAt time.Time, endAt time.Time) ([]orderentity.TableOrder, error) {
+	tables, err := s.rto.GetFinishedTableOrders(ctx, startAt, endAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	toCommission := []orderentity.TableOrder{}
+	for _, table := range tables {
+		if table.WaiterID == waiterID && table.CommissionID == nil {
+			toCommission = append(toCommission, table)
+		}
+	}
+
+	return toCommission, nil
+}
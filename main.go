@@ -17,6 +17,12 @@ var rootCmd = &cobra.Command{
 
 func main() {
 	rootCmd.PersistentFlags().StringP("port", "p", ":8080", "the port to connect to server")
+	rootCmd.PersistentFlags().String("cep-fixture", "", "json file with ceps to use instead of viacep")
+	rootCmd.PersistentFlags().String("jwt-keys", "", "json file with the jwt signing keys and the active kid")
+	rootCmd.PersistentFlags().String("mailer", "log", "how emails are sent: smtp, file or log")
+	rootCmd.PersistentFlags().String("mailer-dir", "emails", "directory of the emails written by the file mailer")
+	rootCmd.PersistentFlags().String("app-url", "http://localhost:3000", "frontend url used on the email links")
+	rootCmd.PersistentFlags().String("rate-limits", "", "json file with the rate limit rules, replaces the default ones")
 	rootCmd.AddCommand(cmd.HttpserverCmd)
 
 	ctx := context.Background()